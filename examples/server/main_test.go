@@ -34,11 +34,19 @@ func init() {
 			AK:    os.Getenv("TENCENT_ACCESS_KEY"),
 			SK:    os.Getenv("TENCENT_SECRET_KEY"),
 		},
+		{
+			Name:  "aliyun",
+			Cloud: model.ALIYUN,
+			AK:    os.Getenv("ALIYUN_ACCESS_KEY_ID"),
+			SK:    os.Getenv("ALIYUN_ACCESS_KEY_SECRET"),
+		},
 	}
 	cloudIo := io.NewCloudClient(profiles)
-	serverTencent := io.NewTencentClient(cloudIo)
-	serverAws := io.NewAwsClient(cloudIo)
-	serverS = server.NewCommonService(profiles, serverAws, serverTencent)
+	registry := server.NewRegistry()
+	registry.Register(model.AWS, io.NewAwsClient(cloudIo))
+	registry.Register(model.TENCENT, io.NewTencentClient(cloudIo))
+	registry.Register(model.ALIYUN, io.NewAliyunClient(cloudIo))
+	serverS = server.NewCommonServiceWithRegistry(profiles, registry)
 }
 
 func TestDescribeServers(t *testing.T) {
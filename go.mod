@@ -3,11 +3,14 @@ module github.com/xops-infra/multi-cloud-sdk
 go 1.21
 
 require (
+	github.com/alibabacloud-go/darabonba-openapi/v2 v2.0.4
 	github.com/alibabacloud-go/tea v1.2.1
+	github.com/alibabacloud-go/tea-utils/v2 v2.0.1
+	github.com/aliyun/aliyun-oss-go-sdk v3.0.2+incompatible
 	github.com/aws/aws-sdk-go v1.45.6
 	github.com/joho/godotenv v1.5.1
 	github.com/spf13/cast v1.5.1
-	github.com/stretchr/testify v1.3.0
+	github.com/stretchr/testify v1.5.1
 	github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common v1.0.872
 	github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm v1.0.745
 	github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dnspod v1.0.762
@@ -18,11 +21,19 @@ require (
 	github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tiia v1.0.759
 	github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc v1.0.753
 	github.com/tencentyun/cos-go-sdk-v5 v0.7.47
+	golang.org/x/net v0.11.0
+	gopkg.in/yaml.v2 v2.2.8
 )
 
 require (
+	github.com/alibabacloud-go/alibabacloud-gateway-spi v0.0.4 // indirect
 	github.com/alibabacloud-go/debug v0.0.0-20190504072949-9472017b5c68 // indirect
+	github.com/alibabacloud-go/openapi-util v0.0.11 // indirect
+	github.com/alibabacloud-go/tea-utils v1.3.1 // indirect
+	github.com/alibabacloud-go/tea-xml v1.1.2 // indirect
+	github.com/aliyun/credentials-go v1.1.2 // indirect
 	github.com/clbanning/mxj v1.8.4 // indirect
+	github.com/clbanning/mxj/v2 v2.5.5 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/google/go-querystring v1.0.0 // indirect
 	github.com/jmespath/go-jmespath v0.4.0 // indirect
@@ -32,5 +43,7 @@ require (
 	github.com/modern-go/reflect2 v1.0.2 // indirect
 	github.com/mozillazg/go-httpheader v0.2.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	golang.org/x/net v0.11.0 // indirect
+	github.com/tjfoc/gmsm v1.3.2 // indirect
+	golang.org/x/time v0.3.0 // indirect
+	gopkg.in/ini.v1 v1.57.0 // indirect
 )
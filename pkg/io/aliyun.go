@@ -0,0 +1,269 @@
+package io
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/spf13/cast"
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+const (
+	aliyunEcsVersion = "2014-05-26"
+	aliyunVpcVersion = "2016-04-28"
+	aliyunDnsVersion = "2015-01-09"
+)
+
+type aliyunClient struct {
+	io model.ClientIo
+}
+
+func NewAliyunClient(io model.ClientIo) model.CloudIO {
+	return &aliyunClient{
+		io: io,
+	}
+}
+
+// NotImplemented 阿里云目前只接入了 ECS、VPC 查询、云解析和 OSS
+func (c *aliyunClient) NotImplemented() []string {
+	return []string{
+		"CreateTags",
+		"AddTagsToResource",
+		"RemoveTagsFromResource",
+		"ModifyTagsForResource",
+		"CreateSecurityGroupWithPolicies",
+		"CreateSecurityGroupPolicies",
+		"QueryEmrCluster",
+		"DescribeEmrCluster",
+		"CreateEmrCluster",
+		"DescribePrivateDomainList",
+		"DescribePrivateRecordList",
+		"CreatePrivateRecord",
+		"ModifyPrivateRecord",
+		"DeletePrivateRecord",
+		"DescribePrivateRecordListWithPages",
+		"ChangeRecordSet",
+		"ChangePrivateRecordSet",
+		"CreatePrivateZone",
+		"DeletePrivateZone",
+		"AddZoneVpcAssociation",
+		"RemoveZoneVpcAssociation",
+		"GetRecordChange",
+		"DescribeHealthChecks",
+		"CreateHealthCheck",
+		"ModifyHealthCheck",
+		"DeleteHealthCheck",
+		"DescribeHealthCheckStatus",
+		"DescribeRegisteredDomains",
+		"DescribeRegisteredDomain",
+		"DescribeInstanceTypes",
+		"DescribeImages",
+		"CreateImage",
+		"CopyImage",
+		"ShareImage",
+		"DeleteImage",
+		"DescribeKeyPairs",
+		"CreateKeyPair",
+		"ImportKeyPair",
+		"DeleteKeyPair",
+		"AssociateKeyPairs",
+		"DisassociateKeyPairs",
+		"DescribeVolumes",
+		"CreateVolume",
+		"ResizeVolume",
+		"AttachVolume",
+		"DetachVolume",
+		"DeleteVolume",
+		"CommonOCR",
+		"CreatePicture",
+		"GetPictureByName",
+		"QueryPicture",
+		"DeletePicture",
+		"UpdatePicture",
+		"SearchPicture",
+	}
+}
+
+// callAliyunApi 调用阿里云 RPC 风格的接口，返回 body 原始 json，out 不为空时反序列化到 out
+// tea 的客户端不支持 context：有 deadline 时按剩余时间设置超时，ctx 结束时不再等待请求返回，直接返回 ctx.Err()
+func callAliyunApi(ctx context.Context, client *openapi.Client, action, version string, query map[string]*string, out any) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	// 可选参数为空时不传，复制一份，ctx 结束后调用方修改 query 不影响还没返回的请求
+	request := make(map[string]*string, len(query))
+	for key, value := range query {
+		if value != nil {
+			request[key] = value
+		}
+	}
+	params := &openapi.Params{
+		Action:      tea.String(action),
+		Version:     tea.String(version),
+		Protocol:    tea.String("HTTPS"),
+		Pathname:    tea.String("/"),
+		Method:      tea.String("POST"),
+		AuthType:    tea.String("AK"),
+		Style:       tea.String("RPC"),
+		ReqBodyType: tea.String("formData"),
+		BodyType:    tea.String("json"),
+	}
+	runtime := &util.RuntimeOptions{}
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout := tea.Int(max(int(time.Until(deadline).Milliseconds()), 1))
+		runtime.ConnectTimeout, runtime.ReadTimeout = timeout, timeout
+	}
+	type result struct {
+		resp map[string]interface{}
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		resp, err := client.CallApi(params, &openapi.OpenApiRequest{Query: request}, runtime)
+		done <- result{resp, err}
+	}()
+	var resp map[string]interface{}
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case r := <-done:
+		if r.err != nil {
+			return "", model.WrapCloudError(model.ALIYUN, r.err)
+		}
+		resp = r.resp
+	}
+	body, err := json.Marshal(resp["body"])
+	if err != nil {
+		return "", err
+	}
+	if out != nil {
+		if err := json.Unmarshal(body, out); err != nil {
+			return "", fmt.Errorf("unmarshal %s response failed: %v", action, err)
+		}
+	}
+	return string(body), nil
+}
+
+// describeAliyunPages 按 PageNumber 翻页，handle 返回当页条数，不足 pageSize 说明已经是最后一页
+func describeAliyunPages(ctx context.Context, client *openapi.Client, action, version string, query map[string]*string, pageSize int, handle func(body []byte) (int, error)) error {
+	query["PageSize"] = tea.String(cast.ToString(pageSize))
+	for page := 1; ; page++ {
+		query["PageNumber"] = tea.String(cast.ToString(page))
+		body, err := callAliyunApi(ctx, client, action, version, query, nil)
+		if err != nil {
+			return err
+		}
+		count, err := handle([]byte(body))
+		if err != nil {
+			return err
+		}
+		if count < pageSize {
+			return nil
+		}
+	}
+}
+
+// 阿里云 ECS 的标签字段是 TagKey/TagValue，VPC 系列的是 Key/Value
+type aliyunTags struct {
+	Tag []struct {
+		Key      string `json:"Key"`
+		Value    string `json:"Value"`
+		TagKey   string `json:"TagKey"`
+		TagValue string `json:"TagValue"`
+	} `json:"Tag"`
+}
+
+func (t aliyunTags) toModelTags() *model.Tags {
+	var modelTags model.Tags
+	for _, tag := range t.Tag {
+		if tag.TagKey != "" {
+			modelTags = append(modelTags, model.Tag{Key: tag.TagKey, Value: tag.TagValue})
+			continue
+		}
+		modelTags = append(modelTags, model.Tag{Key: tag.Key, Value: tag.Value})
+	}
+	return &modelTags
+}
+
+// 阿里云 RPC 接口的列表参数格式为 Name.1 Name.2 ...
+func setAliyunRepeatList(query map[string]*string, name string, values []*string) {
+	for i, value := range values {
+		query[fmt.Sprintf("%s.%d", name, i+1)] = value
+	}
+}
+
+func setAliyunTags(query map[string]*string, tags model.Tags) {
+	for i, tag := range tags {
+		query[fmt.Sprintf("Tag.%d.Key", i+1)] = tea.String(tag.Key)
+		query[fmt.Sprintf("Tag.%d.Value", i+1)] = tea.String(tag.Value)
+	}
+}
+
+// 部分接口的列表参数是 json 数组字符串，比如 DescribeInstances 的 InstanceIds
+func toAliyunJsonArray(values []*string) *string {
+	data, _ := json.Marshal(tea.StringSliceValue(values))
+	return tea.String(string(data))
+}
+
+func (c *aliyunClient) CreateTags(ctx context.Context, profile, region string, input model.CreateTagsInput) error {
+	return model.NewNotImplementedError(model.ALIYUN, "CreateTags")
+}
+
+func (c *aliyunClient) AddTagsToResource(ctx context.Context, profile, region string, input model.AddTagsInput) error {
+	return model.NewNotImplementedError(model.ALIYUN, "AddTagsToResource")
+}
+
+func (c *aliyunClient) RemoveTagsFromResource(ctx context.Context, profile, region string, input model.RemoveTagsInput) error {
+	return model.NewNotImplementedError(model.ALIYUN, "RemoveTagsFromResource")
+}
+
+func (c *aliyunClient) ModifyTagsForResource(ctx context.Context, profile, region string, input model.ModifyTagsInput) error {
+	return model.NewNotImplementedError(model.ALIYUN, "ModifyTagsForResource")
+}
+
+// EMR
+func (c *aliyunClient) QueryEmrCluster(ctx context.Context, filter model.EmrFilter) (model.FilterEmrResponse, error) {
+	return model.FilterEmrResponse{}, model.NewNotImplementedError(model.ALIYUN, "QueryEmrCluster")
+}
+
+func (c *aliyunClient) DescribeEmrCluster(ctx context.Context, input model.DescribeInput) ([]model.DescribeEmrCluster, error) {
+	return nil, model.NewNotImplementedError(model.ALIYUN, "DescribeEmrCluster")
+}
+
+func (c *aliyunClient) CreateEmrCluster(ctx context.Context, profile, region string, input model.CreateEmrClusterInput) (model.CreateEmrClusterResponse, error) {
+	return model.CreateEmrClusterResponse{}, model.NewNotImplementedError(model.ALIYUN, "CreateEmrCluster")
+}
+
+// OCR
+func (c *aliyunClient) CommonOCR(ctx context.Context, profile, region string, input model.OcrRequest) (model.OcrResponse, error) {
+	return model.OcrResponse{}, model.NewNotImplementedError(model.ALIYUN, "CommonOCR")
+}
+
+func (c *aliyunClient) CreatePicture(ctx context.Context, profile, region string, input model.CreatePictureRequest) (model.CreatePictureResponse, error) {
+	return model.CreatePictureResponse{}, model.NewNotImplementedError(model.ALIYUN, "CreatePicture")
+}
+
+func (c *aliyunClient) GetPictureByName(ctx context.Context, profile, region string, input model.CommonPictureRequest) (model.GetPictureByNameResponse, error) {
+	return model.GetPictureByNameResponse{}, model.NewNotImplementedError(model.ALIYUN, "GetPictureByName")
+}
+
+func (c *aliyunClient) QueryPicture(ctx context.Context, profile, region string, input model.QueryPictureRequest) (model.QueryPictureResponse, error) {
+	return model.QueryPictureResponse{}, model.NewNotImplementedError(model.ALIYUN, "QueryPicture")
+}
+
+func (c *aliyunClient) DeletePicture(ctx context.Context, profile, region string, input model.CommonPictureRequest) (model.CommonPictureResponse, error) {
+	return model.CommonPictureResponse{}, model.NewNotImplementedError(model.ALIYUN, "DeletePicture")
+}
+
+func (c *aliyunClient) UpdatePicture(ctx context.Context, profile, region string, input model.UpdatePictureRequest) (model.CommonPictureResponse, error) {
+	return model.CommonPictureResponse{}, model.NewNotImplementedError(model.ALIYUN, "UpdatePicture")
+}
+
+func (c *aliyunClient) SearchPicture(ctx context.Context, profile, region string, input model.SearchPictureRequest) (model.SearchPictureResponse, error) {
+	return model.SearchPictureResponse{}, model.NewNotImplementedError(model.ALIYUN, "SearchPicture")
+}
@@ -0,0 +1,565 @@
+package io
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/spf13/cast"
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// 云解析没有 aws 的别名记录
+var aliyunAliasUnsupported = model.NewCloudError(model.ALIYUN, model.ErrorCategoryUnsupported, "UnsupportedAliasTarget", "alias record is not supported, use CNAME instead")
+
+type aliyunDomainRecord struct {
+	RecordId string `json:"RecordId"`
+	RR       string `json:"RR"`
+	Type     string `json:"Type"`
+	Value    string `json:"Value"`
+	TTL      uint64 `json:"TTL"`
+	Line     string `json:"Line"`
+	Status   string `json:"Status"`
+	Weight   uint64 `json:"Weight"`
+	Remark   string `json:"Remark"`
+}
+
+type aliyunDescribeDomainRecordsResponse struct {
+	TotalCount    int64 `json:"TotalCount"`
+	DomainRecords struct {
+		Record []aliyunDomainRecord `json:"Record"`
+	} `json:"DomainRecords"`
+}
+
+func (r aliyunDomainRecord) toModelRecord() model.Record {
+	record := model.Record{
+		RecordId:   tea.String(r.RecordId),
+		SubDomain:  tea.String(r.RR),
+		RecordType: tea.String(r.Type),
+		Value:      tea.String(r.Value),
+		Values:     []*string{tea.String(r.Value)},
+		Status:     tea.String(r.Status),
+		TTL:        tea.Uint64(r.TTL),
+		RecordLine: tea.String(r.Line),
+		// 阿里云的线路没有单独的 ID，线路代码即 ID
+		RecordLineId: tea.String(r.Line),
+	}
+	if r.Weight != 0 {
+		record.Weight = tea.Uint64(r.Weight)
+	}
+	if r.Remark != "" {
+		record.Remark = tea.String(r.Remark)
+	}
+	return record
+}
+
+// DescribeDomainList
+func (c *aliyunClient) DescribeDomainList(ctx context.Context, profile, region string, input model.DescribeDomainListRequest) (model.DescribeDomainListResponse, error) {
+	client, err := c.io.GetAliyunDnsClient(profile)
+	if err != nil {
+		return model.DescribeDomainListResponse{}, err
+	}
+	query := map[string]*string{}
+	if input.DomainKeyword != nil {
+		query["KeyWord"] = input.DomainKeyword
+	}
+	var requestId *string
+	var domains []model.Domain
+	err = describeAliyunPages(ctx, client, "DescribeDomains", aliyunDnsVersion, query, 100, func(body []byte) (int, error) {
+		var resp struct {
+			RequestId string `json:"RequestId"`
+			Domains   struct {
+				Domain []map[string]any `json:"Domain"`
+			} `json:"Domains"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return 0, err
+		}
+		requestId = tea.String(resp.RequestId)
+		for _, domain := range resp.Domains.Domain {
+			domains = append(domains, model.Domain{
+				DomainId: tea.String(cast.ToString(domain["DomainId"])),
+				Name:     tea.String(cast.ToString(domain["DomainName"])),
+				Meta:     domain,
+			})
+		}
+		return len(resp.Domains.Domain), nil
+	})
+	if err != nil {
+		return model.DescribeDomainListResponse{}, err
+	}
+	return model.DescribeDomainListResponse{
+		RequestId:  requestId,
+		DomainList: domains,
+		DomainCountInfo: &model.DomainCountInfo{
+			Total: tea.Int64(cast.ToInt64(len(domains))),
+		},
+	}, nil
+}
+
+func (c *aliyunClient) DescribeRecordListWithPages(ctx context.Context, profile, region string, input model.DescribeRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
+	if input.Domain == nil {
+		return model.ListRecordsPageResponse{}, fmt.Errorf("domain is required")
+	}
+	client, err := c.io.GetAliyunDnsClient(profile)
+	if err != nil {
+		return model.ListRecordsPageResponse{}, err
+	}
+	var limit, page int64 = 100, 1
+	if input.Limit != nil {
+		limit = *input.Limit
+	}
+	if input.Page != nil {
+		page = *input.Page
+	}
+	// 游标为偏移量，阿里云按页码查询，需要和 Limit 对齐
+	if input.NextMarker != nil {
+		offset, err := model.ParseOffsetMarker(input.NextMarker)
+		if err != nil {
+			return model.ListRecordsPageResponse{}, err
+		}
+		if offset%limit != 0 {
+			return model.ListRecordsPageResponse{}, fmt.Errorf("%w: next marker does not match limit %d", model.ErrInvalidInput, limit)
+		}
+		page = offset/limit + 1
+	}
+	var resp aliyunDescribeDomainRecordsResponse
+	_, err = callAliyunApi(ctx, client, "DescribeDomainRecords", aliyunDnsVersion, map[string]*string{
+		"DomainName": input.Domain,
+		"PageNumber": tea.String(cast.ToString(page)),
+		"PageSize":   tea.String(cast.ToString(limit)),
+	}, &resp)
+	if err != nil {
+		return model.ListRecordsPageResponse{}, err
+	}
+	var records []model.Record
+	for _, record := range resp.DomainRecords.Record {
+		records = append(records, record.toModelRecord())
+	}
+	var nextPage, prePage *int64
+	nextMarker := model.NextOffsetMarker((page-1)*limit, len(records), resp.TotalCount)
+	if nextMarker != nil {
+		nextPage = tea.Int64(page + 1)
+	}
+	if page > 1 {
+		prePage = tea.Int64(page - 1)
+	}
+	return model.ListRecordsPageResponse{
+		PrePage:    prePage,
+		NextPage:   nextPage,
+		NextMarker: nextMarker,
+		RecordList: records,
+	}, nil
+}
+
+// DescribeRecordList Keyword 按主机记录模糊匹配
+func (c *aliyunClient) DescribeRecordList(ctx context.Context, profile, region string, input model.DescribeRecordListRequest) (model.DescribeRecordListResponse, error) {
+	if input.Domain == nil {
+		return model.DescribeRecordListResponse{}, fmt.Errorf("domain is required")
+	}
+	client, err := c.io.GetAliyunDnsClient(profile)
+	if err != nil {
+		return model.DescribeRecordListResponse{}, err
+	}
+	query := map[string]*string{"DomainName": input.Domain}
+	if input.Keyword != nil && *input.Keyword != "" {
+		query["RRKeyWord"] = input.Keyword
+	}
+	var records []model.Record
+	err = describeAliyunPages(ctx, client, "DescribeDomainRecords", aliyunDnsVersion, query, 500, func(body []byte) (int, error) {
+		var resp aliyunDescribeDomainRecordsResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return 0, err
+		}
+		for _, record := range resp.DomainRecords.Record {
+			records = append(records, record.toModelRecord())
+		}
+		return len(resp.DomainRecords.Record), nil
+	})
+	if err != nil {
+		return model.DescribeRecordListResponse{}, err
+	}
+	return model.DescribeRecordListResponse{
+		Total:      cast.ToInt64(len(records)),
+		RecordList: records,
+	}, nil
+}
+
+// DescribeRecord
+func (c *aliyunClient) DescribeRecord(ctx context.Context, profile, region string, input model.DescribeRecordRequest) (model.Record, error) {
+	if input.SubDomain == nil {
+		return model.Record{}, fmt.Errorf("SubDomain is required")
+	}
+	resp, err := c.DescribeRecordList(ctx, profile, region, model.DescribeRecordListRequest{
+		Domain:  input.Domain,
+		Keyword: input.SubDomain,
+	})
+	if err != nil {
+		return model.Record{}, err
+	}
+	for _, record := range resp.RecordList {
+		if *record.SubDomain != *input.SubDomain {
+			continue
+		}
+		if input.RecordType != nil && *input.RecordType != "" && *input.RecordType != *record.RecordType {
+			continue
+		}
+		return record, nil
+	}
+	return model.Record{}, model.NewRecordNotFoundError(model.ALIYUN)
+}
+
+// CreateRecord 默认线路 default，TTL 默认 600；多个值时每个值新增一条记录，RecordId 为第一条记录的 ID
+func (c *aliyunClient) CreateRecord(ctx context.Context, profile, region string, input model.CreateRecordRequest) (model.CreateRecordResponse, error) {
+	if input.AliasTarget != nil {
+		return model.CreateRecordResponse{}, aliyunAliasUnsupported
+	}
+	client, err := c.io.GetAliyunDnsClient(profile)
+	if err != nil {
+		return model.CreateRecordResponse{}, err
+	}
+	var recordIds []string
+	var metas []interface{}
+	for _, value := range input.ToRecord().GetValues() {
+		query := map[string]*string{
+			"DomainName": input.Domain,
+			"RR":         input.SubDomain,
+			"Type":       input.RecordType,
+			"Value":      value,
+			"TTL":        tea.String("600"),
+			"Line":       tea.String("default"),
+		}
+		if input.TTL != nil {
+			query["TTL"] = tea.String(cast.ToString(*input.TTL))
+		}
+		if input.RecordLine != nil {
+			query["Line"] = input.RecordLine
+		}
+		if input.RecordLineId != nil {
+			query["Line"] = input.RecordLineId
+		}
+		var resp struct {
+			RecordId string `json:"RecordId"`
+		}
+		meta, err := callAliyunApi(ctx, client, "AddDomainRecord", aliyunDnsVersion, query, &resp)
+		if err != nil {
+			return model.CreateRecordResponse{}, err
+		}
+		// 新增接口不支持备注，需要单独设置
+		if input.Info != nil {
+			err = c.updateRecordRemark(ctx, client, resp.RecordId, *input.Info)
+			if err != nil {
+				return model.CreateRecordResponse{}, fmt.Errorf("create record success. update remark failed: %w", err)
+			}
+		}
+		recordIds = append(recordIds, resp.RecordId)
+		metas = append(metas, meta)
+	}
+	if len(recordIds) == 0 {
+		return model.CreateRecordResponse{}, fmt.Errorf("value is required")
+	}
+	if len(metas) == 1 {
+		return model.CreateRecordResponse{
+			RecordId: tea.String(recordIds[0]),
+			Meta:     metas[0],
+		}, nil
+	}
+	return model.CreateRecordResponse{
+		RecordId: tea.String(recordIds[0]),
+		Meta:     metas,
+	}, nil
+}
+
+func (c *aliyunClient) updateRecordRemark(ctx context.Context, client *openapi.Client, recordId, remark string) error {
+	_, err := callAliyunApi(ctx, client, "UpdateDomainRecordRemark", aliyunDnsVersion, map[string]*string{
+		"RecordId": tea.String(recordId),
+		"Remark":   tea.String(remark),
+	}, nil)
+	return err
+}
+
+// ModifyRecord
+// ignoreType 是否开启忽略 recordType,
+// true 注意这里会删除所有相同 subDomain 的记录，然后创建新的记录
+// false 如果 recordType 不同，会报没找到记录
+func (c *aliyunClient) ModifyRecord(ctx context.Context, profile, region string, ignoreType bool, input model.ModifyRecordRequest) error {
+	if input.AliasTarget != nil {
+		return aliyunAliasUnsupported
+	}
+	if input.Domain == nil {
+		return fmt.Errorf("domain is required")
+	}
+	if input.SubDomain == nil {
+		return fmt.Errorf("subDomain is required")
+	}
+	client, err := c.io.GetAliyunDnsClient(profile)
+	if err != nil {
+		return err
+	}
+	if ignoreType {
+		resp, err := c.DescribeRecordList(ctx, profile, region, model.DescribeRecordListRequest{
+			Domain:  input.Domain,
+			Keyword: input.SubDomain,
+		})
+		if err != nil {
+			return err
+		}
+		var deleted int
+		for _, record := range resp.RecordList {
+			if *record.SubDomain != *input.SubDomain {
+				continue
+			}
+			if err := c.deleteRecordById(ctx, client, *record.RecordId); err != nil {
+				return fmt.Errorf("delete record error: %w", err)
+			}
+			deleted++
+		}
+		if deleted == 0 {
+			return model.NewRecordNotFoundError(model.ALIYUN)
+		}
+		createInput := model.CreateRecordRequest{
+			Domain:       input.Domain,
+			SubDomain:    input.SubDomain,
+			RecordType:   input.RecordType,
+			Value:        input.Value,
+			Values:       input.Values,
+			TTL:          tea.Uint64(60),
+			Info:         input.Info,
+			RecordLine:   input.RecordLine,
+			RecordLineId: input.RecordLineId,
+		}
+		if input.TTL != nil {
+			createInput.TTL = input.TTL
+		}
+		_, err = c.CreateRecord(ctx, profile, region, createInput)
+		if err != nil {
+			return fmt.Errorf("create record error: %w", err)
+		}
+		return nil
+	}
+
+	if input.RecordType == nil {
+		return fmt.Errorf("recordType is required")
+	}
+	values := input.ToRecord().GetValues()
+	if len(values) != 1 {
+		return model.NewCloudError(model.ALIYUN, model.ErrorCategoryInvalidInput, "InvalidParameter.Values", "aliyun record has exactly one value")
+	}
+	records, err := c.findRecords(ctx, profile, region, *input.Domain, *input.SubDomain, *input.RecordType, input.RecordLine, input.RecordLineId)
+	if err != nil {
+		return err
+	}
+	record := records[0]
+	query := map[string]*string{
+		"RecordId": record.RecordId,
+		"RR":       input.SubDomain,
+		"Type":     input.RecordType,
+		"Value":    values[0],
+		// 不传线路时保持原来的线路
+		"Line": record.RecordLine,
+	}
+	if input.TTL != nil {
+		query["TTL"] = tea.String(cast.ToString(*input.TTL))
+	}
+	_, err = callAliyunApi(ctx, client, "UpdateDomainRecord", aliyunDnsVersion, query, nil)
+	if err != nil {
+		return err
+	}
+	if input.Status != nil {
+		status := "Disable"
+		if *input.Status {
+			status = "Enable"
+		}
+		_, err = callAliyunApi(ctx, client, "SetDomainRecordStatus", aliyunDnsVersion, map[string]*string{
+			"RecordId": record.RecordId,
+			"Status":   tea.String(status),
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	if input.Info != nil {
+		return c.updateRecordRemark(ctx, client, *record.RecordId, *input.Info)
+	}
+	return nil
+}
+
+func (c *aliyunClient) deleteRecordById(ctx context.Context, client *openapi.Client, recordId string) error {
+	_, err := callAliyunApi(ctx, client, "DeleteDomainRecord", aliyunDnsVersion, map[string]*string{
+		"RecordId": tea.String(recordId),
+	}, nil)
+	return err
+}
+
+// findRecords 查找同名同类型的记录，recordLine 为空时不按线路过滤
+func (c *aliyunClient) findRecords(ctx context.Context, profile, region, domain, subDomain, recordType string, recordLine, recordLineId *string) ([]model.Record, error) {
+	resp, err := c.DescribeRecordList(ctx, profile, region, model.DescribeRecordListRequest{
+		Domain:  &domain,
+		Keyword: &subDomain,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var records []model.Record
+	for _, record := range resp.RecordList {
+		if *record.SubDomain != subDomain || *record.RecordType != recordType {
+			continue
+		}
+		if !record.MatchRecordLine(recordLine, recordLineId) {
+			continue
+		}
+		records = append(records, record)
+	}
+	if len(records) == 0 {
+		return nil, model.NewRecordNotFoundError(model.ALIYUN)
+	}
+	return records, nil
+}
+
+// DeleteRecord 删除同名同类型的全部记录，可以用 RecordLine 限定线路
+func (c *aliyunClient) DeleteRecord(ctx context.Context, profile, region string, input model.DeleteRecordRequest) (model.CommonDnsResponse, error) {
+	if input.SubDomain == nil || input.Domain == nil || input.RecordType == nil {
+		return model.CommonDnsResponse{}, fmt.Errorf("SubDomain, Domain and RecordType are required")
+	}
+	client, err := c.io.GetAliyunDnsClient(profile)
+	if err != nil {
+		return model.CommonDnsResponse{}, err
+	}
+	records, err := c.findRecords(ctx, profile, region, *input.Domain, *input.SubDomain, *input.RecordType, input.RecordLine, input.RecordLineId)
+	if err != nil {
+		return model.CommonDnsResponse{}, err
+	}
+	var metas []interface{}
+	for _, record := range records {
+		meta, err := callAliyunApi(ctx, client, "DeleteDomainRecord", aliyunDnsVersion, map[string]*string{
+			"RecordId": record.RecordId,
+		}, nil)
+		if err != nil {
+			return model.CommonDnsResponse{Meta: metas}, err
+		}
+		metas = append(metas, meta)
+	}
+	if len(metas) == 1 {
+		return model.CommonDnsResponse{
+			Meta: metas[0],
+		}, nil
+	}
+	return model.CommonDnsResponse{
+		Meta: metas,
+	}, nil
+}
+
+// 阿里云内网解析（PrivateZone）暂未接入
+func (c *aliyunClient) DescribePrivateDomainList(ctx context.Context, profile string, input model.DescribeDomainListRequest) (model.DescribePrivateDomainListResponse, error) {
+	return model.DescribePrivateDomainListResponse{}, model.NewNotImplementedError(model.ALIYUN, "DescribePrivateDomainList")
+}
+
+func (c *aliyunClient) CreatePrivateRecord(ctx context.Context, profile string, input model.CreateRecordRequest) (model.CreateRecordResponse, error) {
+	return model.CreateRecordResponse{}, model.NewNotImplementedError(model.ALIYUN, "CreatePrivateRecord")
+}
+
+func (c *aliyunClient) DeletePrivateRecord(ctx context.Context, profile string, input model.DeletePrivateRecordRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "DeletePrivateRecord")
+}
+
+func (c *aliyunClient) ModifyPrivateRecord(ctx context.Context, profile string, input model.ModifyRecordRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "ModifyPrivateRecord")
+}
+
+func (c *aliyunClient) DescribePrivateRecordList(ctx context.Context, profile string, input model.DescribePrivateRecordListRequest) (model.DescribePrivateRecordListResponse, error) {
+	return model.DescribePrivateRecordListResponse{}, model.NewNotImplementedError(model.ALIYUN, "DescribePrivateRecordList")
+}
+
+func (c *aliyunClient) DescribePrivateRecordListWithPages(ctx context.Context, profile string, input model.DescribePrivateDnsRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
+	return model.ListRecordsPageResponse{}, model.NewNotImplementedError(model.ALIYUN, "DescribePrivateRecordListWithPages")
+}
+
+// 云解析没有批量且可回滚的接口，暂不支持
+func (c *aliyunClient) ChangeRecordSet(ctx context.Context, profile, region string, input model.ChangeRecordSetRequest) (model.ChangeRecordSetResponse, error) {
+	return model.ChangeRecordSetResponse{}, model.NewNotImplementedError(model.ALIYUN, "ChangeRecordSet")
+}
+
+func (c *aliyunClient) ChangePrivateRecordSet(ctx context.Context, profile string, input model.ChangeRecordSetRequest) (model.ChangeRecordSetResponse, error) {
+	return model.ChangeRecordSetResponse{}, model.NewNotImplementedError(model.ALIYUN, "ChangePrivateRecordSet")
+}
+
+func (c *aliyunClient) CreatePrivateZone(ctx context.Context, profile string, input model.CreatePrivateZoneRequest) (model.CreatePrivateZoneResponse, error) {
+	return model.CreatePrivateZoneResponse{}, model.NewNotImplementedError(model.ALIYUN, "CreatePrivateZone")
+}
+
+func (c *aliyunClient) DeletePrivateZone(ctx context.Context, profile string, input model.DeletePrivateZoneRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "DeletePrivateZone")
+}
+
+func (c *aliyunClient) AddZoneVpcAssociation(ctx context.Context, profile string, input model.ZoneVpcAssociationRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "AddZoneVpcAssociation")
+}
+
+func (c *aliyunClient) RemoveZoneVpcAssociation(ctx context.Context, profile string, input model.ZoneVpcAssociationRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "RemoveZoneVpcAssociation")
+}
+
+func (c *aliyunClient) GetRecordChange(ctx context.Context, profile, changeId string) (model.RecordChangeStatus, error) {
+	return "", model.NewNotImplementedError(model.ALIYUN, "GetRecordChange")
+}
+
+func (c *aliyunClient) DescribeHealthChecks(ctx context.Context, profile string) ([]model.HealthCheck, error) {
+	return nil, model.NewNotImplementedError(model.ALIYUN, "DescribeHealthChecks")
+}
+
+func (c *aliyunClient) CreateHealthCheck(ctx context.Context, profile string, input model.CreateHealthCheckRequest) (model.CreateHealthCheckResponse, error) {
+	return model.CreateHealthCheckResponse{}, model.NewNotImplementedError(model.ALIYUN, "CreateHealthCheck")
+}
+
+func (c *aliyunClient) ModifyHealthCheck(ctx context.Context, profile string, input model.ModifyHealthCheckRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "ModifyHealthCheck")
+}
+
+func (c *aliyunClient) DeleteHealthCheck(ctx context.Context, profile, id string) error {
+	return model.NewNotImplementedError(model.ALIYUN, "DeleteHealthCheck")
+}
+
+func (c *aliyunClient) DescribeHealthCheckStatus(ctx context.Context, profile, id string) (model.HealthCheckStatus, error) {
+	return model.HealthCheckStatus{}, model.NewNotImplementedError(model.ALIYUN, "DescribeHealthCheckStatus")
+}
+
+// DescribeRecordLineList 阿里云的线路和域名的版本有关，DomainGrade 不生效
+func (c *aliyunClient) DescribeRecordLineList(ctx context.Context, profile, region string, input model.DescribeRecordLineListRequest) (model.DescribeRecordLineListResponse, error) {
+	client, err := c.io.GetAliyunDnsClient(profile)
+	if err != nil {
+		return model.DescribeRecordLineListResponse{}, err
+	}
+	var resp struct {
+		RecordLines struct {
+			RecordLine []struct {
+				LineCode        string `json:"LineCode"`
+				LineName        string `json:"LineName"`
+				LineDisplayName string `json:"LineDisplayName"`
+			} `json:"RecordLine"`
+		} `json:"RecordLines"`
+	}
+	body, err := callAliyunApi(ctx, client, "DescribeSupportLines", aliyunDnsVersion, map[string]*string{"DomainName": input.Domain}, &resp)
+	if err != nil {
+		return model.DescribeRecordLineListResponse{}, err
+	}
+	var lines []model.RecordLineInfo
+	for _, line := range resp.RecordLines.RecordLine {
+		name := line.LineDisplayName
+		if name == "" {
+			name = line.LineName
+		}
+		lines = append(lines, model.RecordLineInfo{Name: tea.String(name), LineId: tea.String(line.LineCode)})
+	}
+	return model.DescribeRecordLineListResponse{Lines: lines, Meta: body}, nil
+}
+
+// 域名注册是单独的 Domain 服务，还没有接入
+func (c *aliyunClient) DescribeRegisteredDomains(ctx context.Context, profile string, input model.DescribeRegisteredDomainsRequest) ([]model.RegisteredDomain, error) {
+	return nil, model.NewNotImplementedError(model.ALIYUN, "DescribeRegisteredDomains")
+}
+
+func (c *aliyunClient) DescribeRegisteredDomain(ctx context.Context, profile, domain string) (model.RegisteredDomain, error) {
+	return model.RegisteredDomain{}, model.NewNotImplementedError(model.ALIYUN, "DescribeRegisteredDomain")
+}
@@ -0,0 +1,331 @@
+package io
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/spf13/cast"
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+type aliyunIpAddress struct {
+	IpAddress []string `json:"IpAddress"`
+}
+
+type aliyunInstance struct {
+	InstanceId      string          `json:"InstanceId"`
+	InstanceName    string          `json:"InstanceName"`
+	ZoneId          string          `json:"ZoneId"`
+	Status          string          `json:"Status"`
+	OSName          string          `json:"OSName"`
+	KeyPairName     string          `json:"KeyPairName"`
+	PublicIpAddress aliyunIpAddress `json:"PublicIpAddress"`
+	InnerIpAddress  aliyunIpAddress `json:"InnerIpAddress"`
+	EipAddress      struct {
+		IpAddress string `json:"IpAddress"`
+	} `json:"EipAddress"`
+	VpcAttributes struct {
+		PrivateIpAddress aliyunIpAddress `json:"PrivateIpAddress"`
+	} `json:"VpcAttributes"`
+	Tags aliyunTags `json:"Tags"`
+}
+
+type aliyunDescribeInstancesResponse struct {
+	NextToken string `json:"NextToken"`
+	Instances struct {
+		Instance []aliyunInstance `json:"Instance"`
+	} `json:"Instances"`
+}
+
+func (i aliyunInstance) toModelInstance(profile string) model.Instance {
+	tags := i.Tags.toModelTags()
+	var keyIds []*string
+	if i.KeyPairName != "" {
+		keyIds = append(keyIds, tea.String(i.KeyPairName))
+	}
+	publicIps := tea.StringSlice(i.PublicIpAddress.IpAddress)
+	if i.EipAddress.IpAddress != "" {
+		publicIps = append(publicIps, tea.String(i.EipAddress.IpAddress))
+	}
+	// 经典网络的内网 IP 在 InnerIpAddress
+	privateIps := tea.StringSlice(i.VpcAttributes.PrivateIpAddress.IpAddress)
+	privateIps = append(privateIps, tea.StringSlice(i.InnerIpAddress.IpAddress)...)
+	return model.Instance{
+		Profile:    profile,
+		KeyIDs:     keyIds,
+		InstanceID: tea.String(i.InstanceId),
+		Name:       tea.String(i.InstanceName),
+		Region:     tea.String(i.ZoneId),
+		Status:     model.ToInstanceStatus(strings.ToUpper(i.Status)),
+		PublicIP:   publicIps,
+		PrivateIP:  privateIps,
+		Tags:       tags,
+		Owner:      tags.GetOwner(),
+		Platform:   tea.String(i.OSName),
+	}
+}
+
+// DescribeInstances 指定了 Size 或者 NextMarker 时只返回一页，通过 NextMarker 继续查询，否则返回全部
+func (c *aliyunClient) DescribeInstances(ctx context.Context, profile, region string, input model.DescribeInstancesInput) (model.InstanceResponse, error) {
+	client, err := c.io.GetAliyunEcsClient(profile, region)
+	if err != nil {
+		return model.InstanceResponse{}, err
+	}
+	query := map[string]*string{
+		"RegionId":   tea.String(region),
+		"MaxResults": tea.String("100"),
+	}
+	if len(input.InstanceIds) > 0 {
+		query["InstanceIds"] = toAliyunJsonArray(input.InstanceIds)
+	}
+	tagIndex := 1
+	for _, filter := range input.Filters {
+		if filter.Name == nil || len(filter.Values) == 0 {
+			continue
+		}
+		switch name := *filter.Name; {
+		case strings.HasPrefix(name, "tag:"):
+			query[fmt.Sprintf("Tag.%d.Key", tagIndex)] = tea.String(strings.TrimPrefix(name, "tag:"))
+			query[fmt.Sprintf("Tag.%d.Value", tagIndex)] = filter.Values[0]
+			tagIndex++
+		case name == "PrivateIpAddresses" || name == "PublicIpAddresses":
+			query[name] = toAliyunJsonArray(filter.Values)
+		default:
+			query[name] = filter.Values[0]
+		}
+	}
+	if input.Size != nil {
+		if *input.Size <= 0 {
+			return model.InstanceResponse{}, fmt.Errorf("%w: invalid size %d", model.ErrInvalidInput, *input.Size)
+		}
+		query["MaxResults"] = tea.String(cast.ToString(*input.Size))
+	}
+	if input.NextMarker != nil {
+		query["NextToken"] = input.NextMarker
+	}
+
+	var instances []model.Instance
+	for {
+		var resp aliyunDescribeInstancesResponse
+		_, err := callAliyunApi(ctx, client, "DescribeInstances", aliyunEcsVersion, query, &resp)
+		if err != nil {
+			return model.InstanceResponse{}, err
+		}
+		for _, instance := range resp.Instances.Instance {
+			instances = append(instances, instance.toModelInstance(profile))
+		}
+		if input.Size != nil || input.NextMarker != nil {
+			var nextMarker *string
+			if resp.NextToken != "" {
+				nextMarker = tea.String(resp.NextToken)
+			}
+			return model.InstanceResponse{Instances: instances, NextMarker: nextMarker}, nil
+		}
+		if resp.NextToken == "" {
+			break
+		}
+		query["NextToken"] = tea.String(resp.NextToken)
+	}
+	return model.InstanceResponse{Instances: instances}, nil
+}
+
+func (c *aliyunClient) CreateInstance(ctx context.Context, profile, region string, input model.CreateInstanceInput) (model.CreateInstanceResponse, error) {
+	client, err := c.io.GetAliyunEcsClient(profile, region)
+	if err != nil {
+		return model.CreateInstanceResponse{}, err
+	}
+	var resp struct {
+		InstanceIdSets struct {
+			InstanceIdSet []string `json:"InstanceIdSet"`
+		} `json:"InstanceIdSets"`
+	}
+	meta, err := callAliyunApi(ctx, client, "RunInstances", aliyunEcsVersion, input.ToAliyunRunInstancesRequest(region), &resp)
+	if err != nil {
+		return model.CreateInstanceResponse{}, err
+	}
+	return model.CreateInstanceResponse{
+		Meta:        meta,
+		InstanceIds: tea.StringSlice(resp.InstanceIdSets.InstanceIdSet),
+	}, nil
+}
+
+func (c *aliyunClient) ModifyInstance(ctx context.Context, profile, region string, input model.ModifyInstanceInput) (model.ModifyInstanceResponse, error) {
+	switch input.Action {
+	case model.StartInstance:
+		return c.batchInstanceAction(ctx, profile, region, "StartInstances", input.InstanceIDs)
+	case model.StopInstance:
+		return c.batchInstanceAction(ctx, profile, region, "StopInstances", input.InstanceIDs)
+	case model.RebootInstance:
+		return c.batchInstanceAction(ctx, profile, region, "RebootInstances", input.InstanceIDs)
+	case model.ChangeInstanceType:
+		if input.InstanceType == nil {
+			return model.ModifyInstanceResponse{}, fmt.Errorf("instance type is required")
+		}
+		return c.changeInstanceType(ctx, profile, region, input.InstanceIDs, input.InstanceType)
+	case model.ChangeInstanceTags:
+		if len(input.Tags) == 0 {
+			return model.ModifyInstanceResponse{}, fmt.Errorf("tags is required")
+		}
+		return c.changeInstanceTags(ctx, profile, region, input.InstanceIDs, input.Tags)
+	default:
+		return model.ModifyInstanceResponse{}, fmt.Errorf("unsupported action: %s", input.Action)
+	}
+}
+
+// StartInstances/StopInstances/RebootInstances 参数一致，都是 InstanceId.N
+func (c *aliyunClient) batchInstanceAction(ctx context.Context, profile, region, action string, instances []*string) (model.ModifyInstanceResponse, error) {
+	client, err := c.io.GetAliyunEcsClient(profile, region)
+	if err != nil {
+		return model.ModifyInstanceResponse{}, err
+	}
+	query := map[string]*string{"RegionId": tea.String(region)}
+	setAliyunRepeatList(query, "InstanceId", instances)
+	meta, err := callAliyunApi(ctx, client, action, aliyunEcsVersion, query, nil)
+	if err != nil {
+		return model.ModifyInstanceResponse{}, err
+	}
+	return model.ModifyInstanceResponse{
+		Meta: meta,
+	}, nil
+}
+
+// TagResources 已有的标签键修改为新的值，每次最多 50 个实例、20 个标签
+func (c *aliyunClient) changeInstanceTags(ctx context.Context, profile, region string, instances []*string, tags model.Tags) (model.ModifyInstanceResponse, error) {
+	if len(tags) > 20 {
+		return model.ModifyInstanceResponse{}, fmt.Errorf("%w: aliyun supports at most 20 tags", model.ErrInvalidInput)
+	}
+	client, err := c.io.GetAliyunEcsClient(profile, region)
+	if err != nil {
+		return model.ModifyInstanceResponse{}, err
+	}
+	var metas []string
+	for start := 0; start < len(instances); start += 50 {
+		query := map[string]*string{"RegionId": tea.String(region), "ResourceType": tea.String("instance")}
+		setAliyunRepeatList(query, "ResourceId", instances[start:min(start+50, len(instances))])
+		setAliyunTags(query, tags)
+		meta, err := callAliyunApi(ctx, client, "TagResources", aliyunEcsVersion, query, nil)
+		if err != nil {
+			return model.ModifyInstanceResponse{Meta: metas}, err
+		}
+		metas = append(metas, meta)
+	}
+	return model.ModifyInstanceResponse{
+		Meta: metas,
+	}, nil
+}
+
+// ModifyInstanceSpec 只支持单个实例，实例需要先停机
+func (c *aliyunClient) changeInstanceType(ctx context.Context, profile, region string, instances []*string, instanceType *string) (model.ModifyInstanceResponse, error) {
+	client, err := c.io.GetAliyunEcsClient(profile, region)
+	if err != nil {
+		return model.ModifyInstanceResponse{}, err
+	}
+	var metas []string
+	for _, instance := range instances {
+		meta, err := callAliyunApi(ctx, client, "ModifyInstanceSpec", aliyunEcsVersion, map[string]*string{
+			"InstanceId":   instance,
+			"InstanceType": instanceType,
+		}, nil)
+		if err != nil {
+			return model.ModifyInstanceResponse{Meta: metas}, err
+		}
+		metas = append(metas, meta)
+	}
+	return model.ModifyInstanceResponse{
+		Meta: metas,
+	}, nil
+}
+
+// 阿里云随实例创建的云盘默认随实例释放，ReleaseDisk 不生效
+func (c *aliyunClient) DeleteInstance(ctx context.Context, profile, region string, input model.DeleteInstanceInput) (model.DeleteInstanceResponse, error) {
+	client, err := c.io.GetAliyunEcsClient(profile, region)
+	if err != nil {
+		return model.DeleteInstanceResponse{}, err
+	}
+	query := map[string]*string{
+		"RegionId": tea.String(region),
+		"Force":    tea.String("true"),
+	}
+	setAliyunRepeatList(query, "InstanceId", input.InstanceIds)
+	meta, err := callAliyunApi(ctx, client, "DeleteInstances", aliyunEcsVersion, query, nil)
+	if err != nil {
+		return model.DeleteInstanceResponse{}, err
+	}
+	return model.DeleteInstanceResponse{
+		Meta: meta,
+	}, nil
+}
+
+func (c *aliyunClient) DescribeInstanceTypes(ctx context.Context, profile, region string, filter model.InstanceTypeFilter) ([]model.InstanceType, error) {
+	return nil, model.NewNotImplementedError(model.ALIYUN, "DescribeInstanceTypes")
+}
+
+func (c *aliyunClient) DescribeImages(ctx context.Context, profile, region string, input model.DescribeImagesRequest) ([]model.Image, error) {
+	return nil, model.NewNotImplementedError(model.ALIYUN, "DescribeImages")
+}
+
+func (c *aliyunClient) CreateImage(ctx context.Context, profile, region string, input model.CreateImageRequest) (model.CreateImageResponse, error) {
+	return model.CreateImageResponse{}, model.NewNotImplementedError(model.ALIYUN, "CreateImage")
+}
+
+func (c *aliyunClient) CopyImage(ctx context.Context, profile, region string, input model.CopyImageRequest) (model.CopyImageResponse, error) {
+	return model.CopyImageResponse{}, model.NewNotImplementedError(model.ALIYUN, "CopyImage")
+}
+
+func (c *aliyunClient) ShareImage(ctx context.Context, profile, region string, input model.ShareImageRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "ShareImage")
+}
+
+func (c *aliyunClient) DeleteImage(ctx context.Context, profile, region string, input model.DeleteImageRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "DeleteImage")
+}
+
+func (c *aliyunClient) DescribeKeyPairs(ctx context.Context, profile, region string, input model.DescribeKeyPairsRequest) ([]model.KeyPair, error) {
+	return nil, model.NewNotImplementedError(model.ALIYUN, "DescribeKeyPairs")
+}
+
+func (c *aliyunClient) CreateKeyPair(ctx context.Context, profile, region string, input model.CreateKeyPairRequest) (model.CreateKeyPairResponse, error) {
+	return model.CreateKeyPairResponse{}, model.NewNotImplementedError(model.ALIYUN, "CreateKeyPair")
+}
+
+func (c *aliyunClient) ImportKeyPair(ctx context.Context, profile, region string, input model.ImportKeyPairRequest) (model.KeyPair, error) {
+	return model.KeyPair{}, model.NewNotImplementedError(model.ALIYUN, "ImportKeyPair")
+}
+
+func (c *aliyunClient) DeleteKeyPair(ctx context.Context, profile, region string, input model.DeleteKeyPairRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "DeleteKeyPair")
+}
+
+func (c *aliyunClient) AssociateKeyPairs(ctx context.Context, profile, region string, input model.KeyPairAssociationRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "AssociateKeyPairs")
+}
+
+func (c *aliyunClient) DisassociateKeyPairs(ctx context.Context, profile, region string, input model.KeyPairAssociationRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "DisassociateKeyPairs")
+}
+
+func (c *aliyunClient) DescribeVolumes(ctx context.Context, profile, region string, input model.DescribeVolumesRequest) ([]model.Volume, error) {
+	return nil, model.NewNotImplementedError(model.ALIYUN, "DescribeVolumes")
+}
+
+func (c *aliyunClient) CreateVolume(ctx context.Context, profile, region string, input model.CreateVolumeRequest) (model.CreateVolumeResponse, error) {
+	return model.CreateVolumeResponse{}, model.NewNotImplementedError(model.ALIYUN, "CreateVolume")
+}
+
+func (c *aliyunClient) ResizeVolume(ctx context.Context, profile, region string, input model.ResizeVolumeRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "ResizeVolume")
+}
+
+func (c *aliyunClient) AttachVolume(ctx context.Context, profile, region string, input model.AttachVolumeRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "AttachVolume")
+}
+
+func (c *aliyunClient) DetachVolume(ctx context.Context, profile, region string, input model.DetachVolumeRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "DetachVolume")
+}
+
+func (c *aliyunClient) DeleteVolume(ctx context.Context, profile, region string, input model.DeleteVolumeRequest) error {
+	return model.NewNotImplementedError(model.ALIYUN, "DeleteVolume")
+}
@@ -0,0 +1,185 @@
+package io
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// oss sdk 的存储桶接口不支持 context，只在请求前检查是否已经取消
+
+func (c *aliyunClient) CreateBucket(ctx context.Context, profile, region string, input model.CreateBucketRequest) error {
+	if input.BucketName == nil || region == "" {
+		return fmt.Errorf("bucket name or region is empty")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	client, err := c.io.GetAliyunOssClient(profile, region)
+	if err != nil {
+		return err
+	}
+	err = client.CreateBucket(*input.BucketName, oss.ACL(oss.ACLPrivate))
+	if err != nil {
+		return model.WrapCloudError(model.ALIYUN, err)
+	}
+	// add tags
+	if len(input.Tags) > 0 {
+		err = client.SetBucketTagging(*input.BucketName, oss.Tagging{Tags: input.Tags.ToAliyunOssTags()})
+		if err != nil {
+			return fmt.Errorf("create bucket success. put bucket tags failed: %w", model.WrapCloudError(model.ALIYUN, err))
+		}
+	}
+	return nil
+}
+
+func (c *aliyunClient) CreateBucketLifecycle(ctx context.Context, profile, region string, input model.CreateBucketLifecycleRequest) error {
+	if input.Bucket == nil || region == "" {
+		return fmt.Errorf("bucket name or region is empty")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	client, err := c.io.GetAliyunOssClient(profile, region)
+	if err != nil {
+		return err
+	}
+	rules, err := input.ToAliyunOssLifecycle()
+	if err != nil {
+		return err
+	}
+	return model.WrapCloudError(model.ALIYUN, client.SetBucketLifecycle(*input.Bucket, rules))
+}
+
+func (c *aliyunClient) GetBucketLifecycle(ctx context.Context, profile, region string, input model.GetBucketLifecycleRequest) (model.GetBucketLifecycleResponse, error) {
+	if input.Bucket == nil || region == "" {
+		return model.GetBucketLifecycleResponse{}, fmt.Errorf("bucket name or region is empty")
+	}
+	if err := ctx.Err(); err != nil {
+		return model.GetBucketLifecycleResponse{}, err
+	}
+	client, err := c.io.GetAliyunOssClient(profile, region)
+	if err != nil {
+		return model.GetBucketLifecycleResponse{}, err
+	}
+	result, err := client.GetBucketLifecycle(*input.Bucket)
+	if err != nil {
+		return model.GetBucketLifecycleResponse{}, model.WrapCloudError(model.ALIYUN, err)
+	}
+	return model.GetBucketLifecycleResponse{
+		Lifecycle: result.Rules,
+	}, nil
+}
+
+// 存储桶不为空时删除会失败
+func (c *aliyunClient) DeleteBucket(ctx context.Context, profile, region string, input model.DeleteBucketRequest) (model.DeleteBucketResponse, error) {
+	if input.BucketName == nil || region == "" {
+		return model.DeleteBucketResponse{}, fmt.Errorf("bucket name or region is empty")
+	}
+	if err := ctx.Err(); err != nil {
+		return model.DeleteBucketResponse{}, err
+	}
+	client, err := c.io.GetAliyunOssClient(profile, region)
+	if err != nil {
+		return model.DeleteBucketResponse{}, err
+	}
+	err = client.DeleteBucket(*input.BucketName)
+	if err != nil {
+		return model.DeleteBucketResponse{}, model.WrapCloudError(model.ALIYUN, err)
+	}
+	return model.DeleteBucketResponse{}, nil
+}
+
+// ListBucket 列举账号下全部存储桶，标签需要到存储桶所在地域查询，查询失败忽略
+func (c *aliyunClient) ListBucket(ctx context.Context, profile, region string, input model.ListBucketRequest) (model.ListBucketResponse, error) {
+	client, err := c.io.GetAliyunOssClient(profile, region)
+	if err != nil {
+		return model.ListBucketResponse{}, err
+	}
+	var buckets []*model.Bucket
+	marker := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return model.ListBucketResponse{}, err
+		}
+		result, err := client.ListBuckets(oss.Marker(marker), oss.MaxKeys(100))
+		if err != nil {
+			return model.ListBucketResponse{}, model.WrapCloudError(model.ALIYUN, err)
+		}
+		for _, bucket := range result.Buckets {
+			if input.KeyWord != nil && *input.KeyWord != "" && !strings.Contains(bucket.Name, *input.KeyWord) {
+				continue
+			}
+			location := bucket.Region
+			if location == "" {
+				location = strings.TrimPrefix(bucket.Location, "oss-")
+			}
+			newBucket := &model.Bucket{
+				Name:       bucket.Name,
+				CreateTime: bucket.CreationDate.Local().Format(time.DateTime),
+				Location:   location,
+			}
+			if bucketClient, err := c.io.GetAliyunOssClient(profile, location); err == nil {
+				if tagging, err := bucketClient.GetBucketTagging(bucket.Name); err == nil {
+					newBucket.Tags = model.NewTagsFromAliyunOssTags(tagging.Tags)
+				}
+			}
+			buckets = append(buckets, newBucket)
+		}
+		if !result.IsTruncated {
+			break
+		}
+		marker = result.NextMarker
+	}
+	return model.ListBucketResponse{
+		Buckets: buckets,
+		Total:   int64(len(buckets)),
+	}, nil
+}
+
+func (c *aliyunClient) GetObjectPregisn(ctx context.Context, profile, region string, input model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
+	client, err := c.io.GetAliyunOssClient(profile, region)
+	if err != nil {
+		return model.ObjectPregisnResponse{}, err
+	}
+	return c.getObjectPregisn(ctx, client, input)
+}
+
+func (c *aliyunClient) GetObjectPregisnWithAKSK(ctx context.Context, ak, sk, region string, input model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
+	client, err := newAliyunOssClient(ak, sk, region)
+	if err != nil {
+		return model.ObjectPregisnResponse{}, err
+	}
+	return c.getObjectPregisn(ctx, client, input)
+}
+
+func (c *aliyunClient) getObjectPregisn(ctx context.Context, client *oss.Client, input model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
+	if input.Bucket == nil || input.Key == nil {
+		return model.ObjectPregisnResponse{}, fmt.Errorf("bucket or key is empty")
+	}
+	bucket, err := client.Bucket(*input.Bucket)
+	if err != nil {
+		return model.ObjectPregisnResponse{}, model.WrapCloudError(model.ALIYUN, err)
+	}
+	// check object exist
+	exist, err := bucket.IsObjectExist(*input.Key, oss.WithContext(ctx))
+	if err != nil {
+		return model.ObjectPregisnResponse{}, model.WrapCloudError(model.ALIYUN, err)
+	}
+	if !exist {
+		return model.ObjectPregisnResponse{}, model.NewCloudError(model.ALIYUN, model.ErrorCategoryNotFound, "NoSuchKey", fmt.Sprintf("object %s not found", *input.Key))
+	}
+	var expire int64 = 3600
+	if input.Expire != nil {
+		expire = *input.Expire
+	}
+	url, err := bucket.SignURL(*input.Key, oss.HTTPGet, expire)
+	if err != nil {
+		return model.ObjectPregisnResponse{}, model.WrapCloudError(model.ALIYUN, err)
+	}
+	return model.ObjectPregisnResponse{Url: url}, nil
+}
@@ -0,0 +1,82 @@
+package io
+
+import (
+	"context"
+	"strings"
+
+	"github.com/alibabacloud-go/tea/tea"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// ListRegions ECS 支持的地域，region 为调用接口的地域，为空时使用 cn-hangzhou
+func (c *aliyunClient) ListRegions(ctx context.Context, profile, region string) ([]model.Region, error) {
+	if region == "" {
+		region = "cn-hangzhou"
+	}
+	client, err := c.io.GetAliyunEcsClient(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Regions struct {
+			Region []struct {
+				RegionId  string `json:"RegionId"`
+				LocalName string `json:"LocalName"`
+				Status    string `json:"Status"` // available 或 soldOut
+			} `json:"Region"`
+		} `json:"Regions"`
+	}
+	if _, err := callAliyunApi(ctx, client, "DescribeRegions", aliyunEcsVersion, map[string]*string{}, &resp); err != nil {
+		return nil, err
+	}
+	regions := make([]model.Region, 0, len(resp.Regions.Region))
+	for _, r := range resp.Regions.Region {
+		item := model.Region{
+			ID:        r.RegionId,
+			Name:      r.LocalName,
+			State:     model.AvailabilityAvailable,
+			Partition: model.SiteInternational,
+			Meta:      r,
+		}
+		if r.Status != "" && r.Status != "available" {
+			item.State = model.AvailabilityUnavailable
+		}
+		if strings.HasPrefix(r.RegionId, "cn-") && r.RegionId != "cn-hongkong" {
+			item.Partition = model.SiteChina
+		}
+		regions = append(regions, item)
+	}
+	return regions, nil
+}
+
+// ListZones 阿里云只返回可以使用的可用区
+func (c *aliyunClient) ListZones(ctx context.Context, profile, region string) ([]model.Zone, error) {
+	client, err := c.io.GetAliyunEcsClient(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Zones struct {
+			Zone []struct {
+				ZoneId    string `json:"ZoneId"`
+				LocalName string `json:"LocalName"`
+			} `json:"Zone"`
+		} `json:"Zones"`
+	}
+	query := map[string]*string{"RegionId": tea.String(region), "Verbose": tea.String("false")}
+	if _, err := callAliyunApi(ctx, client, "DescribeZones", aliyunEcsVersion, query, &resp); err != nil {
+		return nil, err
+	}
+	zones := make([]model.Zone, 0, len(resp.Zones.Zone))
+	for _, z := range resp.Zones.Zone {
+		zones = append(zones, model.Zone{
+			ID:     z.ZoneId,
+			Name:   z.LocalName,
+			Region: region,
+			State:  model.AvailabilityAvailable,
+			Meta:   z,
+		})
+	}
+	return zones, nil
+}
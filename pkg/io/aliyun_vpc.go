@@ -0,0 +1,242 @@
+package io
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/spf13/cast"
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+func (c *aliyunClient) QueryVPC(ctx context.Context, profile, region string, input model.CommonFilter) ([]model.VPC, error) {
+	client, err := c.io.GetAliyunVpcClient(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	query := map[string]*string{"RegionId": tea.String(region)}
+	if input.ID != "" {
+		query["VpcId"] = tea.String(input.ID)
+	}
+	var vpcs []model.VPC
+	err = describeAliyunPages(ctx, client, "DescribeVpcs", aliyunVpcVersion, query, 50, func(body []byte) (int, error) {
+		var resp struct {
+			Vpcs struct {
+				Vpc []struct {
+					VpcId     string     `json:"VpcId"`
+					CidrBlock string     `json:"CidrBlock"`
+					IsDefault bool       `json:"IsDefault"`
+					Tags      aliyunTags `json:"Tags"`
+				} `json:"Vpc"`
+			} `json:"Vpcs"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return 0, err
+		}
+		for _, vpc := range resp.Vpcs.Vpc {
+			vpcs = append(vpcs, model.VPC{
+				ID:            vpc.VpcId,
+				Region:        region,
+				Account:       profile,
+				CloudProvider: model.ALIYUN,
+				Tags:          vpc.Tags.toModelTags(),
+				IsDefault:     vpc.IsDefault,
+				CidrBlock:     vpc.CidrBlock,
+			})
+		}
+		return len(resp.Vpcs.Vpc), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vpcs, nil
+}
+
+// QuerySubnet 阿里云的子网是交换机 VSwitch
+func (c *aliyunClient) QuerySubnet(ctx context.Context, profile, region string, input model.CommonFilter) ([]model.Subnet, error) {
+	client, err := c.io.GetAliyunVpcClient(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	query := map[string]*string{"RegionId": tea.String(region)}
+	if input.ID != "" {
+		query["VSwitchId"] = tea.String(input.ID)
+	}
+	var subnets []model.Subnet
+	err = describeAliyunPages(ctx, client, "DescribeVSwitches", aliyunVpcVersion, query, 50, func(body []byte) (int, error) {
+		var resp struct {
+			VSwitches struct {
+				VSwitch []struct {
+					VSwitchId               string     `json:"VSwitchId"`
+					VSwitchName             string     `json:"VSwitchName"`
+					VpcId                   string     `json:"VpcId"`
+					ZoneId                  string     `json:"ZoneId"`
+					CidrBlock               string     `json:"CidrBlock"`
+					IsDefault               bool       `json:"IsDefault"`
+					AvailableIpAddressCount int64      `json:"AvailableIpAddressCount"`
+					CreationTime            string     `json:"CreationTime"`
+					NetworkAclId            string     `json:"NetworkAclId"`
+					Tags                    aliyunTags `json:"Tags"`
+					RouteTable              struct {
+						RouteTableId string `json:"RouteTableId"`
+					} `json:"RouteTable"`
+				} `json:"VSwitch"`
+			} `json:"VSwitches"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return 0, err
+		}
+		for _, vsw := range resp.VSwitches.VSwitch {
+			createTime, _ := model.TimeParse(vsw.CreationTime)
+			subnets = append(subnets, model.Subnet{
+				ID:                      tea.String(vsw.VSwitchId),
+				Region:                  region,
+				Account:                 profile,
+				CloudProvider:           model.ALIYUN,
+				Tags:                    vsw.Tags.toModelTags(),
+				VpcID:                   tea.String(vsw.VpcId),
+				Name:                    tea.String(vsw.VSwitchName),
+				CidrBlock:               tea.String(vsw.CidrBlock),
+				IsDefault:               tea.Bool(vsw.IsDefault),
+				Zone:                    tea.String(vsw.ZoneId),
+				RouteTableId:            tea.String(vsw.RouteTable.RouteTableId),
+				CreatedTime:             &createTime,
+				AvailableIpAddressCount: vsw.AvailableIpAddressCount,
+				NetworkAclId:            tea.String(vsw.NetworkAclId),
+			})
+		}
+		return len(resp.VSwitches.VSwitch), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return subnets, nil
+}
+
+func (c *aliyunClient) QueryEIP(ctx context.Context, profile, region string, input model.CommonFilter) ([]model.EIP, error) {
+	client, err := c.io.GetAliyunVpcClient(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	query := map[string]*string{"RegionId": tea.String(region)}
+	if input.ID != "" {
+		query["AllocationId"] = tea.String(input.ID)
+	}
+	var eips []model.EIP
+	err = describeAliyunPages(ctx, client, "DescribeEipAddresses", aliyunVpcVersion, query, 100, func(body []byte) (int, error) {
+		var resp struct {
+			EipAddresses struct {
+				EipAddress []struct {
+					AllocationId       string     `json:"AllocationId"`
+					Name               string     `json:"Name"`
+					IpAddress          string     `json:"IpAddress"`
+					Status             string     `json:"Status"`
+					InstanceId         string     `json:"InstanceId"`
+					AllocationTime     string     `json:"AllocationTime"`
+					PrivateIpAddress   string     `json:"PrivateIpAddress"`
+					Bandwidth          string     `json:"Bandwidth"`
+					InternetChargeType string     `json:"InternetChargeType"`
+					Tags               aliyunTags `json:"Tags"`
+				} `json:"EipAddress"`
+			} `json:"EipAddresses"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return 0, err
+		}
+		for _, eip := range resp.EipAddresses.EipAddress {
+			createTime, _ := model.TimeParse(eip.AllocationTime)
+			eips = append(eips, model.EIP{
+				ID:                 tea.String(eip.AllocationId),
+				Region:             region,
+				Account:            profile,
+				CloudProvider:      model.ALIYUN,
+				Tags:               eip.Tags.toModelTags(),
+				Name:               tea.String(eip.Name),
+				Status:             tea.String(eip.Status),
+				AddressIp:          tea.String(eip.IpAddress),
+				InstanceId:         tea.String(eip.InstanceId),
+				CreatedTime:        &createTime,
+				PrivateAddressIp:   eip.PrivateIpAddress,
+				Bandwidth:          tea.Int64(cast.ToInt64(eip.Bandwidth)),
+				InternetChargeType: tea.String(eip.InternetChargeType),
+			})
+		}
+		return len(resp.EipAddresses.EipAddress), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return eips, nil
+}
+
+func (c *aliyunClient) QueryNAT(ctx context.Context, profile, region string, input model.CommonFilter) ([]model.NAT, error) {
+	client, err := c.io.GetAliyunVpcClient(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	query := map[string]*string{"RegionId": tea.String(region)}
+	if input.ID != "" {
+		query["NatGatewayId"] = tea.String(input.ID)
+	}
+	var nats []model.NAT
+	err = describeAliyunPages(ctx, client, "DescribeNatGateways", aliyunVpcVersion, query, 50, func(body []byte) (int, error) {
+		var resp struct {
+			NatGateways struct {
+				NatGateway []struct {
+					NatGatewayId string     `json:"NatGatewayId"`
+					Name         string     `json:"Name"`
+					Status       string     `json:"Status"`
+					VpcId        string     `json:"VpcId"`
+					CreationTime string     `json:"CreationTime"`
+					Tags         aliyunTags `json:"Tags"`
+					IpLists      struct {
+						IpList []struct {
+							IpAddress string `json:"IpAddress"`
+						} `json:"IpList"`
+					} `json:"IpLists"`
+					NatGatewayPrivateInfo struct {
+						VswitchId string `json:"VswitchId"`
+						IzNo      string `json:"IzNo"`
+					} `json:"NatGatewayPrivateInfo"`
+				} `json:"NatGateway"`
+			} `json:"NatGateways"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return 0, err
+		}
+		for _, nat := range resp.NatGateways.NatGateway {
+			createTime, _ := model.TimeParse(nat.CreationTime)
+			var ips []string
+			for _, ip := range nat.IpLists.IpList {
+				ips = append(ips, ip.IpAddress)
+			}
+			nats = append(nats, model.NAT{
+				ID:            nat.NatGatewayId,
+				Region:        region,
+				Account:       profile,
+				CloudProvider: model.ALIYUN,
+				Tags:          nat.Tags.toModelTags(),
+				Name:          nat.Name,
+				CreatedTime:   createTime,
+				Status:        nat.Status,
+				AddressIps:    ips,
+				VpcID:         nat.VpcId,
+				Zone:          tea.String(nat.NatGatewayPrivateInfo.IzNo),
+				SubnetID:      nat.NatGatewayPrivateInfo.VswitchId,
+			})
+		}
+		return len(resp.NatGateways.NatGateway), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nats, nil
+}
+
+func (c *aliyunClient) CreateSecurityGroupWithPolicies(ctx context.Context, profile, region string, input model.CreateSecurityGroupWithPoliciesInput) (model.CreateSecurityGroupWithPoliciesResponse, error) {
+	return model.CreateSecurityGroupWithPoliciesResponse{}, model.NewNotImplementedError(model.ALIYUN, "CreateSecurityGroupWithPolicies")
+}
+
+func (c *aliyunClient) CreateSecurityGroupPolicies(ctx context.Context, profile, region string, input model.CreateSecurityGroupPoliciesInput) (model.CreateSecurityGroupPoliciesResponse, error) {
+	return model.CreateSecurityGroupPoliciesResponse{}, model.NewNotImplementedError(model.ALIYUN, "CreateSecurityGroupPolicies")
+}
@@ -1,7 +1,7 @@
 package io
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
@@ -16,53 +16,75 @@ func NewAwsClient(io model.ClientIo) model.CloudIO {
 	}
 }
 
-func (c *awsClient) CreateTags(profile, region string, input model.CreateTagsInput) error {
-	return fmt.Errorf("not support for aws")
+// NotImplemented 未实现的操作调用时返回 model.ErrNotImplemented，新增实现后需要从这里移除
+func (c *awsClient) NotImplemented() []string {
+	return []string{
+		"CreateTags",
+		"AddTagsToResource",
+		"RemoveTagsFromResource",
+		"ModifyTagsForResource",
+		"CreateSecurityGroupWithPolicies",
+		"CreateSecurityGroupPolicies",
+		"CommonOCR",
+		"CreatePicture",
+		"GetPictureByName",
+		"QueryPicture",
+		"DeletePicture",
+		"UpdatePicture",
+		"SearchPicture",
+		"DeleteBucket",
+		"AssociateKeyPairs",
+		"DisassociateKeyPairs",
+	}
+}
+
+func (c *awsClient) CreateTags(ctx context.Context, profile, region string, input model.CreateTagsInput) error {
+	return model.NewNotImplementedError(model.AWS, "CreateTags")
 }
 
-func (c *awsClient) AddTagsToResource(profile, region string, input model.AddTagsInput) error {
-	return fmt.Errorf("not support for aws")
+func (c *awsClient) AddTagsToResource(ctx context.Context, profile, region string, input model.AddTagsInput) error {
+	return model.NewNotImplementedError(model.AWS, "AddTagsToResource")
 }
 
-func (c *awsClient) RemoveTagsFromResource(profile, region string, input model.RemoveTagsInput) error {
-	return fmt.Errorf("not support for aws")
+func (c *awsClient) RemoveTagsFromResource(ctx context.Context, profile, region string, input model.RemoveTagsInput) error {
+	return model.NewNotImplementedError(model.AWS, "RemoveTagsFromResource")
 }
 
-func (c *awsClient) ModifyTagsForResource(profile, region string, input model.ModifyTagsInput) error {
-	return fmt.Errorf("not support for aws")
+func (c *awsClient) ModifyTagsForResource(ctx context.Context, profile, region string, input model.ModifyTagsInput) error {
+	return model.NewNotImplementedError(model.AWS, "ModifyTagsForResource")
 }
 
 // CommonOCR
-func (c *awsClient) CommonOCR(profile, region string, input model.OcrRequest) (model.OcrResponse, error) {
-	return model.OcrResponse{}, nil
+func (c *awsClient) CommonOCR(ctx context.Context, profile, region string, input model.OcrRequest) (model.OcrResponse, error) {
+	return model.OcrResponse{}, model.NewNotImplementedError(model.AWS, "CommonOCR")
 }
 
 // CreatePicture
-func (c *awsClient) CreatePicture(profile, region string, input model.CreatePictureRequest) (model.CreatePictureResponse, error) {
-	return model.CreatePictureResponse{}, nil
+func (c *awsClient) CreatePicture(ctx context.Context, profile, region string, input model.CreatePictureRequest) (model.CreatePictureResponse, error) {
+	return model.CreatePictureResponse{}, model.NewNotImplementedError(model.AWS, "CreatePicture")
 }
 
 // GetPictureByName
-func (c *awsClient) GetPictureByName(profile, region string, input model.CommonPictureRequest) (model.GetPictureByNameResponse, error) {
-	return model.GetPictureByNameResponse{}, nil
+func (c *awsClient) GetPictureByName(ctx context.Context, profile, region string, input model.CommonPictureRequest) (model.GetPictureByNameResponse, error) {
+	return model.GetPictureByNameResponse{}, model.NewNotImplementedError(model.AWS, "GetPictureByName")
 }
 
 // QueryPicture
-func (c *awsClient) QueryPicture(profile, region string, input model.QueryPictureRequest) (model.QueryPictureResponse, error) {
-	return model.QueryPictureResponse{}, nil
+func (c *awsClient) QueryPicture(ctx context.Context, profile, region string, input model.QueryPictureRequest) (model.QueryPictureResponse, error) {
+	return model.QueryPictureResponse{}, model.NewNotImplementedError(model.AWS, "QueryPicture")
 }
 
 // DeletePicture
-func (c *awsClient) DeletePicture(profile, region string, input model.CommonPictureRequest) (model.CommonPictureResponse, error) {
-	return model.CommonPictureResponse{}, nil
+func (c *awsClient) DeletePicture(ctx context.Context, profile, region string, input model.CommonPictureRequest) (model.CommonPictureResponse, error) {
+	return model.CommonPictureResponse{}, model.NewNotImplementedError(model.AWS, "DeletePicture")
 }
 
 // UpdatePicture
-func (c *awsClient) UpdatePicture(profile, region string, input model.UpdatePictureRequest) (model.CommonPictureResponse, error) {
-	return model.CommonPictureResponse{}, nil
+func (c *awsClient) UpdatePicture(ctx context.Context, profile, region string, input model.UpdatePictureRequest) (model.CommonPictureResponse, error) {
+	return model.CommonPictureResponse{}, model.NewNotImplementedError(model.AWS, "UpdatePicture")
 }
 
 // SearchPicture
-func (c *awsClient) SearchPicture(profile, region string, input model.SearchPictureRequest) (model.SearchPictureResponse, error) {
-	return model.SearchPictureResponse{}, nil
+func (c *awsClient) SearchPicture(ctx context.Context, profile, region string, input model.SearchPictureRequest) (model.SearchPictureResponse, error) {
+	return model.SearchPictureResponse{}, model.NewNotImplementedError(model.AWS, "SearchPicture")
 }
@@ -1,6 +1,7 @@
 package io
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 )
 
 // DescribeDomainList
-func (c *awsClient) DescribeDomainList(profile, region string, input model.DescribeDomainListRequest) (model.DescribeDomainListResponse, error) {
+func (c *awsClient) DescribeDomainList(ctx context.Context, profile, region string, input model.DescribeDomainListRequest) (model.DescribeDomainListResponse, error) {
 	client, err := c.io.GetAwsRoute53Client(profile, region)
 	if err != nil {
 		return model.DescribeDomainListResponse{}, err
@@ -22,9 +23,9 @@ func (c *awsClient) DescribeDomainList(profile, region string, input model.Descr
 	var domains []model.Domain
 
 	for {
-		resp, err := client.ListHostedZones(params)
+		resp, err := client.ListHostedZonesWithContext(ctx, params)
 		if err != nil {
-			return model.DescribeDomainListResponse{}, err
+			return model.DescribeDomainListResponse{}, model.WrapCloudError(model.AWS, err)
 		}
 		for _, domain := range resp.HostedZones {
 			if input.DomainKeyword != nil && *input.DomainKeyword != "" {
@@ -52,7 +53,8 @@ func (c *awsClient) DescribeDomainList(profile, region string, input model.Descr
 	}, nil
 }
 
-func (c *awsClient) DescribeRecordListWithPages(profile, region string, input model.DescribeRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
+// DescribeRecordListWithPages 有 NextMarker 时从游标开始查询，否则按 Limit 从头逐页翻到 Page
+func (c *awsClient) DescribeRecordListWithPages(ctx context.Context, profile, region string, input model.DescribeRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
 	if input.Domain == nil {
 		return model.ListRecordsPageResponse{}, fmt.Errorf("domain,region is required")
 	}
@@ -60,76 +62,105 @@ func (c *awsClient) DescribeRecordListWithPages(profile, region string, input mo
 	if err != nil {
 		return model.ListRecordsPageResponse{}, err
 	}
-
-	params := &route53.ListResourceRecordSetsInput{
-		HostedZoneId: input.Domain,
-		MaxItems:     tea.String("100"),
+	domain, err := c.getHostedZoneIdByDomain(ctx, profile, region, input.Domain)
+	if err != nil {
+		return model.ListRecordsPageResponse{}, err
 	}
-	domain, err := c.getHostedZoneIdByDomain(profile, region, input.Domain)
+	recordSets, resp, err := listAwsRecordSetsPage(ctx, client, domain.DomainId, input.Limit, input.Page, input.NextMarker)
 	if err != nil {
 		return model.ListRecordsPageResponse{}, err
 	}
-	params.HostedZoneId = domain.DomainId
-
-	if input.Limit != nil {
-		params.MaxItems = tea.String(cast.ToString(input.Limit))
+	for _, record := range recordSets {
+		resp.RecordList = append(resp.RecordList, awsRecordSetToRecord(record, *domain.Name))
 	}
-	var pageNum int64
+	return resp, nil
+}
+
+// awsRecordMarker ListResourceRecordSets 从哪条记录开始查询
+type awsRecordMarker struct {
+	Name       *string `json:"n"`
+	Type       *string `json:"t"`
+	Identifier *string `json:"i,omitempty"`
+}
+
+// listAwsRecordSetsPage route53 只支持游标分页，没有 NextMarker 时按 limit 逐页翻到 page，返回的 resp 只设置了分页字段
+func listAwsRecordSetsPage(ctx context.Context, client *route53.Route53, zoneId *string, limit, page *int64, nextMarker *string) ([]*route53.ResourceRecordSet, model.ListRecordsPageResponse, error) {
 	var resp model.ListRecordsPageResponse
+	params := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: zoneId,
+		MaxItems:     tea.String("100"),
+	}
+	if limit != nil {
+		params.MaxItems = tea.String(cast.ToString(*limit))
+	}
+	var marker awsRecordMarker
+	if err := model.DecodeMarker(nextMarker, &marker); err != nil {
+		return nil, resp, err
+	}
+	params.StartRecordName, params.StartRecordType, params.StartRecordIdentifier = marker.Name, marker.Type, marker.Identifier
 
-	err = client.ListResourceRecordSetsPages(params,
-		func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
-			// fmt.Printf("---%d---\n %s", pageNum, tea.Prettify(page))
-			if input.Page == nil {
-				input.Page = tea.Int64(1)
+	pageNum := int64(1)
+	if nextMarker == nil && page != nil && *page > 1 {
+		pageNum = *page
+	}
+	for i := int64(1); ; i++ {
+		out, err := client.ListResourceRecordSetsWithContext(ctx, params)
+		if err != nil {
+			return nil, resp, model.WrapCloudError(model.AWS, err)
+		}
+		if i < pageNum {
+			// 页码超出范围
+			if !aws.BoolValue(out.IsTruncated) {
+				return nil, resp, nil
 			}
-			if pageNum == *input.Page-1 {
-				for _, record := range page.ResourceRecordSets {
-					var recordValue *string
-					if record.ResourceRecords == nil {
-						recordValue = record.AliasTarget.DNSName
-					} else {
-						// TODO: 有多个值的情况
-						for _, value := range record.ResourceRecords {
-							recordValue = value.Value
-						}
-					}
-					// 解决httpDecode问题，比如 * -> \\052 @ -> \\100 # -> \\043
-					record.Name = aws.String(strings.ReplaceAll(aws.StringValue(record.Name), "\\052", "*"))
-					record.Name = aws.String(strings.ReplaceAll(*record.Name, "\\100", "@"))
-					record.Name = aws.String(strings.ReplaceAll(*record.Name, "\\043", "#"))
-					subDomain := strings.TrimSuffix(*record.Name, fmt.Sprintf("%s.", *domain.Name))
-					resp.RecordList = append(resp.RecordList, model.Record{
-						SubDomain:  aws.String(strings.TrimSuffix(subDomain, ".")),
-						TTL:        tea.Uint64(cast.ToUint64(record.TTL)),
-						Weight:     tea.Uint64(cast.ToUint64(record.Weight)),
-						RecordType: record.Type,
-						Value:      recordValue,
-						Status:     record.SetIdentifier,
-						RecordId:   record.Name,
-					})
-				}
-				if len(resp.RecordList) == cast.ToInt(params.MaxItems) {
-					resp.NextPage = tea.Int64(pageNum + 2)
-					resp.PrePage = tea.Int64(pageNum)
-					if pageNum == 0 {
-						resp.PrePage = nil
-					}
-				}
-				return false
-			} else {
-				pageNum++
-				return true
+			params.StartRecordName, params.StartRecordType, params.StartRecordIdentifier = out.NextRecordName, out.NextRecordType, out.NextRecordIdentifier
+			continue
+		}
+		if aws.BoolValue(out.IsTruncated) {
+			resp.NextMarker = model.EncodeMarker(awsRecordMarker{Name: out.NextRecordName, Type: out.NextRecordType, Identifier: out.NextRecordIdentifier})
+		}
+		// 使用游标时没有页码
+		if nextMarker == nil {
+			if resp.NextMarker != nil {
+				resp.NextPage = tea.Int64(pageNum + 1)
 			}
+			if pageNum > 1 {
+				resp.PrePage = tea.Int64(pageNum - 1)
+			}
+		}
+		return out.ResourceRecordSets, resp, nil
+	}
+}
+
+// awsRecordSetToRecord zoneName 不带结尾的点，RecordId 为记录的完整域名
+func awsRecordSetToRecord(recordSet *route53.ResourceRecordSet, zoneName string) model.Record {
+	record := model.NewRecordFromAwsResourceRecordSet(recordSet)
+	// 解决httpDecode问题，比如 * -> \\052 @ -> \\100 # -> \\043
+	name := strings.ReplaceAll(aws.StringValue(recordSet.Name), "\\052", "*")
+	name = strings.ReplaceAll(name, "\\100", "@")
+	name = strings.ReplaceAll(name, "\\043", "#")
+	subDomain := strings.TrimSuffix(name, fmt.Sprintf("%s.", zoneName))
+	record.SubDomain = aws.String(strings.TrimSuffix(subDomain, "."))
+	record.RecordId = aws.String(name)
+	return record
+}
+
+// listAwsRecordSets 返回 hosted zone 下全部原始记录，删除记录时需要提供和现有记录完全一致的内容
+func listAwsRecordSets(ctx context.Context, client *route53.Route53, zoneId *string) ([]*route53.ResourceRecordSet, error) {
+	var recordSets []*route53.ResourceRecordSet
+	err := client.ListResourceRecordSetsPagesWithContext(ctx, &route53.ListResourceRecordSetsInput{HostedZoneId: zoneId},
+		func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+			recordSets = append(recordSets, page.ResourceRecordSets...)
+			return true
 		})
 	if err != nil {
-		return resp, err
+		return nil, model.WrapCloudError(model.AWS, err)
 	}
-	return resp, nil
+	return recordSets, nil
 }
 
 // DescribeRecordList
-func (c *awsClient) DescribeRecordList(profile, region string, input model.DescribeRecordListRequest) (model.DescribeRecordListResponse, error) {
+func (c *awsClient) DescribeRecordList(ctx context.Context, profile, region string, input model.DescribeRecordListRequest) (model.DescribeRecordListResponse, error) {
 
 	client, err := c.io.GetAwsRoute53Client(profile, region)
 	if err != nil {
@@ -139,40 +170,25 @@ func (c *awsClient) DescribeRecordList(profile, region string, input model.Descr
 	param := &route53.ListResourceRecordSetsInput{
 		HostedZoneId: input.Domain,
 	}
-	domain, err := c.getHostedZoneIdByDomain(profile, region, input.Domain)
+	domain, err := c.getHostedZoneIdByDomain(ctx, profile, region, input.Domain)
 	if err != nil {
 		return model.DescribeRecordListResponse{}, err
 	}
 	param.HostedZoneId = domain.DomainId
 
 	var records []model.Record
-	resp, err := client.ListResourceRecordSets(param)
+	resp, err := client.ListResourceRecordSetsWithContext(ctx, param)
 	if err != nil {
-		return model.DescribeRecordListResponse{}, err
+		return model.DescribeRecordListResponse{}, model.WrapCloudError(model.AWS, err)
 	}
 	for {
 		for _, record := range resp.ResourceRecordSets {
-			var values string
-			for _, value := range record.ResourceRecords {
-				values = *value.Value
-			}
 			if input.Keyword != nil && *input.Keyword != "" {
 				if !strings.Contains(*record.Name, *input.Keyword) {
 					continue
 				}
 			}
-			// 解决httpDecode问题，比如 \\052
-			record.Name = tea.String(strings.ReplaceAll(*record.Name, "\\052", "*"))
-			subDomain := strings.TrimSuffix(*record.Name, fmt.Sprintf("%s.", *domain.Name))
-			records = append(records, model.Record{
-				SubDomain:  tea.String(strings.TrimSuffix(subDomain, ".")),
-				TTL:        tea.Uint64(cast.ToUint64(record.TTL)),
-				Weight:     tea.Uint64(cast.ToUint64(record.Weight)),
-				RecordType: record.Type,
-				Value:      tea.String(values),
-				Status:     record.SetIdentifier,
-				RecordId:   record.Name,
-			})
+			records = append(records, awsRecordSetToRecord(record, *domain.Name))
 		}
 
 		if resp.IsTruncated == nil || !*resp.IsTruncated {
@@ -181,9 +197,9 @@ func (c *awsClient) DescribeRecordList(profile, region string, input model.Descr
 		param.StartRecordName = resp.NextRecordName
 		param.StartRecordType = resp.NextRecordType
 		param.StartRecordIdentifier = resp.NextRecordIdentifier
-		resp, err = client.ListResourceRecordSets(param)
+		resp, err = client.ListResourceRecordSetsWithContext(ctx, param)
 		if err != nil {
-			return model.DescribeRecordListResponse{}, err
+			return model.DescribeRecordListResponse{}, model.WrapCloudError(model.AWS, err)
 		}
 	}
 	return model.DescribeRecordListResponse{
@@ -194,8 +210,8 @@ func (c *awsClient) DescribeRecordList(profile, region string, input model.Descr
 }
 
 // DescribeRecord 完全匹配
-func (c *awsClient) DescribeRecord(profile, region string, input model.DescribeRecordRequest) (model.Record, error) {
-	resp, err := c.DescribeRecordList(profile, region, model.DescribeRecordListRequest{
+func (c *awsClient) DescribeRecord(ctx context.Context, profile, region string, input model.DescribeRecordRequest) (model.Record, error) {
+	resp, err := c.DescribeRecordList(ctx, profile, region, model.DescribeRecordListRequest{
 		Domain:  input.Domain,
 		Keyword: input.SubDomain,
 	})
@@ -210,11 +226,11 @@ func (c *awsClient) DescribeRecord(profile, region string, input model.DescribeR
 			return record, nil
 		}
 	}
-	return model.Record{}, fmt.Errorf("record not found")
+	return model.Record{}, model.NewRecordNotFoundError(model.AWS)
 }
 
 // CreateRecord
-func (c *awsClient) CreateRecord(profile, region string, input model.CreateRecordRequest) (model.CreateRecordResponse, error) {
+func (c *awsClient) CreateRecord(ctx context.Context, profile, region string, input model.CreateRecordRequest) (model.CreateRecordResponse, error) {
 	client, err := c.io.GetAwsRoute53Client(profile, region)
 	if err != nil {
 		return model.CreateRecordResponse{}, err
@@ -223,34 +239,30 @@ func (c *awsClient) CreateRecord(profile, region string, input model.CreateRecor
 	if input.TTL != nil {
 		ttl = cast.ToInt64(input.TTL)
 	}
-	domain, err := c.getHostedZoneIdByDomain(profile, region, input.Domain)
+	domain, err := c.getHostedZoneIdByDomain(ctx, profile, region, input.Domain)
 	if err != nil {
 		return model.CreateRecordResponse{}, err
 	}
+	record, err := input.ToRecord().ResolveAwsRecordLine()
+	if err != nil {
+		return model.CreateRecordResponse{}, err
+	}
+	record.TTL = tea.Uint64(cast.ToUint64(ttl))
 	param := &route53.ChangeResourceRecordSetsInput{
 		HostedZoneId: domain.DomainId,
 		ChangeBatch: &route53.ChangeBatch{
 			Changes: []*route53.Change{
 				{
-					Action: aws.String("CREATE"),
-					ResourceRecordSet: &route53.ResourceRecordSet{
-						Name: tea.String(fmt.Sprintf("%s.%s.", *input.SubDomain, *input.Domain)),
-						Type: input.RecordType,
-						TTL:  tea.Int64(ttl),
-						ResourceRecords: []*route53.ResourceRecord{
-							{
-								Value: input.Value,
-							},
-						},
-					},
+					Action:            aws.String("CREATE"),
+					ResourceRecordSet: record.ToAwsResourceRecordSet(awsRecordName(input.SubDomain, *domain.Name)),
 				},
 			},
 			Comment: tea.String(fmt.Sprintf("%s, created by multi-cloud-sdk", tea.StringValue(input.Info))),
 		},
 	}
-	resp, err := client.ChangeResourceRecordSets(param)
+	resp, err := client.ChangeResourceRecordSetsWithContext(ctx, param)
 	if err != nil {
-		return model.CreateRecordResponse{}, err
+		return model.CreateRecordResponse{}, model.WrapCloudError(model.AWS, err)
 	}
 	return model.CreateRecordResponse{
 		RecordId: resp.ChangeInfo.Id,
@@ -259,11 +271,11 @@ func (c *awsClient) CreateRecord(profile, region string, input model.CreateRecor
 }
 
 // getHostedZoneIdByDomain domain 为域名或者hostedzoneId
-func (c *awsClient) getHostedZoneIdByDomain(profile, region string, domain *string) (*model.Domain, error) {
+func (c *awsClient) getHostedZoneIdByDomain(ctx context.Context, profile, region string, domain *string) (*model.Domain, error) {
 	if domain == nil {
 		return nil, fmt.Errorf("domain is required")
 	}
-	resp, err := c.DescribeDomainList(profile, region, model.DescribeDomainListRequest{})
+	resp, err := c.DescribeDomainList(ctx, profile, region, model.DescribeDomainListRequest{})
 	if err != nil {
 		return nil, err
 	}
@@ -278,17 +290,17 @@ func (c *awsClient) getHostedZoneIdByDomain(profile, region string, domain *stri
 			}
 		}
 	}
-	return nil, fmt.Errorf("domain not found")
+	return nil, model.NewCloudError(model.AWS, model.ErrorCategoryNotFound, "DomainNotFound", "domain not found")
 }
 
 // ModifyRecord
 // ignoreType 腾讯云修改需要一起提供记录类型，aws不需要，所以不处理
-func (c *awsClient) ModifyRecord(profile, region string, ignoreType bool, input model.ModifyRecordRequest) error {
+func (c *awsClient) ModifyRecord(ctx context.Context, profile, region string, ignoreType bool, input model.ModifyRecordRequest) error {
 	cloudClient, err := c.io.GetAwsRoute53Client(profile, region)
 	if err != nil {
 		return err
 	}
-	resp, err := c.getHostedZoneIdByDomain(profile, region, input.Domain)
+	resp, err := c.getHostedZoneIdByDomain(ctx, profile, region, input.Domain)
 	if err != nil {
 		return err
 	}
@@ -296,36 +308,32 @@ func (c *awsClient) ModifyRecord(profile, region string, ignoreType bool, input
 	if input.TTL != nil {
 		ttl = cast.ToInt64(input.TTL)
 	}
+	record, err := input.ToRecord().ResolveAwsRecordLine()
+	if err != nil {
+		return err
+	}
+	record.TTL = tea.Uint64(cast.ToUint64(ttl))
 	param := &route53.ChangeResourceRecordSetsInput{
 		HostedZoneId: resp.DomainId,
 		ChangeBatch: &route53.ChangeBatch{
 			Changes: []*route53.Change{
 				{
-					Action: aws.String("UPSERT"),
-					ResourceRecordSet: &route53.ResourceRecordSet{
-						Name: tea.String(fmt.Sprintf("%s.%s.", *input.SubDomain, *input.Domain)),
-						Type: input.RecordType,
-						TTL:  tea.Int64(ttl),
-						ResourceRecords: []*route53.ResourceRecord{
-							{
-								Value: input.Value,
-							},
-						},
-					},
+					Action:            aws.String("UPSERT"),
+					ResourceRecordSet: record.ToAwsResourceRecordSet(awsRecordName(input.SubDomain, *resp.Name)),
 				},
 			},
 		},
 	}
-	_, err = cloudClient.ChangeResourceRecordSets(param)
+	_, err = cloudClient.ChangeResourceRecordSetsWithContext(ctx, param)
 	if err != nil {
-		return err
+		return model.WrapCloudError(model.AWS, err)
 	}
 	return nil
 
 }
 
-// DeleteDns
-func (c *awsClient) DeleteRecord(profile, region string, input model.DeleteRecordRequest) (model.CommonDnsResponse, error) {
+// DeleteDns 删除同名同类型的整个记录集，多值记录一并删除；指定 SetIdentifier 或者地理位置的 RecordLineId 时只删除对应的记录
+func (c *awsClient) DeleteRecord(ctx context.Context, profile, region string, input model.DeleteRecordRequest) (model.CommonDnsResponse, error) {
 	if input.Domain == nil || input.SubDomain == nil || input.RecordType == nil {
 		return model.CommonDnsResponse{}, fmt.Errorf("domain, subDomain, recordType is required")
 	}
@@ -333,44 +341,192 @@ func (c *awsClient) DeleteRecord(profile, region string, input model.DeleteRecor
 	if err != nil {
 		return model.CommonDnsResponse{}, err
 	}
-	hostedZoneId, err := c.getHostedZoneIdByDomain(profile, region, input.Domain)
+	hostedZone, err := c.getHostedZoneIdByDomain(ctx, profile, region, input.Domain)
 	if err != nil {
 		return model.CommonDnsResponse{}, err
 	}
-	record, err := c.DescribeRecord(profile, region, model.DescribeRecordRequest{
-		Domain:     input.Domain,
-		SubDomain:  input.SubDomain,
-		RecordType: input.RecordType,
-	})
+	recordSets, err := listAwsRecordSets(ctx, client, hostedZone.DomainId)
 	if err != nil {
 		return model.CommonDnsResponse{}, err
 	}
+	var changes []*route53.Change
+	for _, recordSet := range recordSets {
+		record := awsRecordSetToRecord(recordSet, *hostedZone.Name)
+		if *record.SubDomain != *input.SubDomain || *record.RecordType != *input.RecordType {
+			continue
+		}
+		if input.SetIdentifier != nil && tea.StringValue(record.SetIdentifier) != *input.SetIdentifier {
+			continue
+		}
+		if input.RecordLineId != nil && tea.StringValue(record.RecordLineId) != *input.RecordLineId {
+			continue
+		}
+		changes = append(changes, &route53.Change{
+			Action:            aws.String("DELETE"),
+			ResourceRecordSet: recordSet,
+		})
+	}
+	if len(changes) == 0 {
+		return model.CommonDnsResponse{}, model.NewRecordNotFoundError(model.AWS)
+	}
 	param := &route53.ChangeResourceRecordSetsInput{
-		HostedZoneId: hostedZoneId.DomainId,
+		HostedZoneId: hostedZone.DomainId,
 		ChangeBatch: &route53.ChangeBatch{
-			Changes: []*route53.Change{
-				{
-					Action: aws.String("DELETE"),
-					ResourceRecordSet: &route53.ResourceRecordSet{
-						Name: tea.String(fmt.Sprintf("%s.%s.", *input.SubDomain, *input.Domain)),
-						Type: record.RecordType,
-						TTL:  tea.Int64(cast.ToInt64(record.TTL)),
-						ResourceRecords: []*route53.ResourceRecord{
-							{
-								Value: record.Value,
-							},
-						},
-					},
-				},
-			},
+			Changes: changes,
 			Comment: tea.String("deleted by multi-cloud-sdk"),
 		},
 	}
-	resp, err := client.ChangeResourceRecordSets(param)
+	resp, err := client.ChangeResourceRecordSetsWithContext(ctx, param)
 	if err != nil {
-		return model.CommonDnsResponse{}, err
+		return model.CommonDnsResponse{}, model.WrapCloudError(model.AWS, err)
 	}
 	return model.CommonDnsResponse{
 		Meta: resp.ChangeInfo,
 	}, nil
 }
+
+// ChangeRecordSet 所有变更放在一个 ChangeBatch 中提交，Route53 保证要么全部生效要么全部不生效
+func (c *awsClient) ChangeRecordSet(ctx context.Context, profile, region string, input model.ChangeRecordSetRequest) (model.ChangeRecordSetResponse, error) {
+	client, err := c.io.GetAwsRoute53Client(profile, region)
+	if err != nil {
+		return model.ChangeRecordSetResponse{}, err
+	}
+	domain, err := c.getHostedZoneIdByDomain(ctx, profile, region, input.Domain)
+	if err != nil {
+		return model.ChangeRecordSetResponse{}, err
+	}
+	return changeAwsRecordSets(ctx, client, domain.DomainId, *domain.Name, input)
+}
+
+func changeAwsRecordSets(ctx context.Context, client *route53.Route53, zoneId *string, zoneName string, input model.ChangeRecordSetRequest) (model.ChangeRecordSetResponse, error) {
+	if len(input.Changes) == 0 {
+		return model.ChangeRecordSetResponse{}, fmt.Errorf("changes is required")
+	}
+	// DELETE 需要提供和线上完全一致的记录集，有 DELETE 时才查询
+	var recordSets []*route53.ResourceRecordSet
+	var changes []*route53.Change
+	for _, change := range input.Changes {
+		record := change.Record
+		if record.RecordType == nil {
+			return model.ChangeRecordSetResponse{}, fmt.Errorf("recordType is required")
+		}
+		name := awsRecordName(record.SubDomain, zoneName)
+		switch change.Action {
+		case model.RecordChangeCreate, model.RecordChangeUpsert:
+			record, err := record.ResolveAwsRecordLine()
+			if err != nil {
+				return model.ChangeRecordSetResponse{}, err
+			}
+			if record.TTL == nil && record.AliasTarget == nil {
+				record.TTL = tea.Uint64(300)
+			}
+			changes = append(changes, &route53.Change{
+				Action:            aws.String(string(change.Action)),
+				ResourceRecordSet: record.ToAwsResourceRecordSet(name),
+			})
+		case model.RecordChangeDelete:
+			if recordSets == nil {
+				var err error
+				recordSets, err = listAwsRecordSets(ctx, client, zoneId)
+				if err != nil {
+					return model.ChangeRecordSetResponse{}, err
+				}
+			}
+			found := false
+			for _, recordSet := range recordSets {
+				current := awsRecordSetToRecord(recordSet, zoneName)
+				if tea.StringValue(current.RecordId) != name || *current.RecordType != *record.RecordType {
+					continue
+				}
+				if record.SetIdentifier != nil && tea.StringValue(current.SetIdentifier) != *record.SetIdentifier {
+					continue
+				}
+				if record.RecordLineId != nil && tea.StringValue(current.RecordLineId) != *record.RecordLineId {
+					continue
+				}
+				found = true
+				changes = append(changes, &route53.Change{
+					Action:            aws.String("DELETE"),
+					ResourceRecordSet: recordSet,
+				})
+			}
+			if !found {
+				return model.ChangeRecordSetResponse{}, model.NewRecordNotFoundError(model.AWS)
+			}
+		default:
+			return model.ChangeRecordSetResponse{}, fmt.Errorf("unsupported action %s", change.Action)
+		}
+	}
+	comment := "changed by multi-cloud-sdk"
+	if input.Comment != nil {
+		comment = *input.Comment
+	}
+	resp, err := client.ChangeResourceRecordSetsWithContext(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: zoneId,
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: changes,
+			Comment: aws.String(comment),
+		},
+	})
+	if err != nil {
+		return model.ChangeRecordSetResponse{}, model.WrapCloudError(model.AWS, err)
+	}
+	return model.ChangeRecordSetResponse{
+		ChangeId: resp.ChangeInfo.Id,
+		Meta:     resp.ChangeInfo,
+	}, nil
+}
+
+// GetRecordChange changeId 可以带 /change/ 前缀
+func (c *awsClient) GetRecordChange(ctx context.Context, profile, changeId string) (model.RecordChangeStatus, error) {
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.GetChangeWithContext(ctx, &route53.GetChangeInput{Id: aws.String(changeId)})
+	if err != nil {
+		return "", model.WrapCloudError(model.AWS, err)
+	}
+	return model.RecordChangeStatus(aws.StringValue(resp.ChangeInfo.Status)), nil
+}
+
+// DescribeRecordLineList aws 没有线路，返回地理位置解析支持的大洲、国家和美国的州，LineId 用于 RecordLineId
+func (c *awsClient) DescribeRecordLineList(ctx context.Context, profile, region string, input model.DescribeRecordLineListRequest) (model.DescribeRecordLineListResponse, error) {
+	client, err := c.io.GetAwsRoute53Client(profile, region)
+	if err != nil {
+		return model.DescribeRecordLineListResponse{}, err
+	}
+	var details []*route53.GeoLocationDetails
+	param := &route53.ListGeoLocationsInput{}
+	for {
+		resp, err := client.ListGeoLocationsWithContext(ctx, param)
+		if err != nil {
+			return model.DescribeRecordLineListResponse{}, model.WrapCloudError(model.AWS, err)
+		}
+		details = append(details, resp.GeoLocationDetailsList...)
+		if !aws.BoolValue(resp.IsTruncated) {
+			break
+		}
+		param.StartContinentCode = resp.NextContinentCode
+		param.StartCountryCode = resp.NextCountryCode
+		param.StartSubdivisionCode = resp.NextSubdivisionCode
+	}
+
+	var lines []model.RecordLineInfo
+	for _, detail := range details {
+		geo := model.Geolocation{
+			ContinentCode:   detail.ContinentCode,
+			CountryCode:     detail.CountryCode,
+			SubdivisionCode: detail.SubdivisionCode,
+		}
+		name := detail.ContinentName
+		switch {
+		case detail.SubdivisionName != nil:
+			name = aws.String(fmt.Sprintf("%s/%s", aws.StringValue(detail.CountryName), *detail.SubdivisionName))
+		case detail.CountryName != nil:
+			name = detail.CountryName
+		}
+		lines = append(lines, model.RecordLineInfo{Name: name, LineId: aws.String(geo.LineId())})
+	}
+	return model.DescribeRecordLineListResponse{Lines: lines, Meta: details}, nil
+}
@@ -0,0 +1,147 @@
+package io
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// DescribeHealthChecks 返回全部健康检查，名称来自 Name 标签
+func (c *awsClient) DescribeHealthChecks(ctx context.Context, profile string) ([]model.HealthCheck, error) {
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return nil, err
+	}
+	var healthChecks []*route53.HealthCheck
+	err = client.ListHealthChecksPagesWithContext(ctx, &route53.ListHealthChecksInput{},
+		func(page *route53.ListHealthChecksOutput, lastPage bool) bool {
+			healthChecks = append(healthChecks, page.HealthChecks...)
+			return true
+		})
+	if err != nil {
+		return nil, model.WrapCloudError(model.AWS, err)
+	}
+
+	names := map[string]*string{}
+	// ListTagsForResources 一次最多 10 个
+	for i := 0; i < len(healthChecks); i += 10 {
+		var ids []*string
+		for _, healthCheck := range healthChecks[i:min(i+10, len(healthChecks))] {
+			ids = append(ids, healthCheck.Id)
+		}
+		resp, err := client.ListTagsForResourcesWithContext(ctx, &route53.ListTagsForResourcesInput{
+			ResourceType: aws.String(route53.TagResourceTypeHealthcheck),
+			ResourceIds:  ids,
+		})
+		if err != nil {
+			return nil, model.WrapCloudError(model.AWS, err)
+		}
+		for _, tagSet := range resp.ResourceTagSets {
+			for _, tag := range tagSet.Tags {
+				if aws.StringValue(tag.Key) == "Name" {
+					names[aws.StringValue(tagSet.ResourceId)] = tag.Value
+				}
+			}
+		}
+	}
+
+	result := make([]model.HealthCheck, 0, len(healthChecks))
+	for _, healthCheck := range healthChecks {
+		result = append(result, model.NewHealthCheckFromAws(healthCheck, names[aws.StringValue(healthCheck.Id)]))
+	}
+	return result, nil
+}
+
+func (c *awsClient) CreateHealthCheck(ctx context.Context, profile string, input model.CreateHealthCheckRequest) (model.CreateHealthCheckResponse, error) {
+	if err := input.Validate(); err != nil {
+		return model.CreateHealthCheckResponse{}, err
+	}
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return model.CreateHealthCheckResponse{}, err
+	}
+	resp, err := client.CreateHealthCheckWithContext(ctx, &route53.CreateHealthCheckInput{
+		CallerReference:   aws.String(fmt.Sprintf("multi-cloud-sdk-%d", time.Now().UnixNano())),
+		HealthCheckConfig: input.ToAwsHealthCheckConfig(),
+	})
+	if err != nil {
+		return model.CreateHealthCheckResponse{}, model.WrapCloudError(model.AWS, err)
+	}
+	if input.Name != nil {
+		if err = setAwsHealthCheckName(ctx, client, resp.HealthCheck.Id, input.Name); err != nil {
+			return model.CreateHealthCheckResponse{Id: resp.HealthCheck.Id, Meta: resp.HealthCheck}, err
+		}
+	}
+	return model.CreateHealthCheckResponse{
+		Id:   resp.HealthCheck.Id,
+		Meta: resp.HealthCheck,
+	}, nil
+}
+
+func (c *awsClient) ModifyHealthCheck(ctx context.Context, profile string, input model.ModifyHealthCheckRequest) error {
+	if input.Id == nil {
+		return fmt.Errorf("health check id is required")
+	}
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.UpdateHealthCheckWithContext(ctx, &route53.UpdateHealthCheckInput{
+		HealthCheckId:            input.Id,
+		IPAddress:                input.IPAddress,
+		FullyQualifiedDomainName: input.Domain,
+		Port:                     input.Port,
+		ResourcePath:             input.Path,
+		FailureThreshold:         input.FailureThreshold,
+		Disabled:                 input.Disabled,
+	})
+	if err != nil {
+		return model.WrapCloudError(model.AWS, err)
+	}
+	if input.Name != nil {
+		return setAwsHealthCheckName(ctx, client, input.Id, input.Name)
+	}
+	return nil
+}
+
+func (c *awsClient) DeleteHealthCheck(ctx context.Context, profile, id string) error {
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.DeleteHealthCheckWithContext(ctx, &route53.DeleteHealthCheckInput{HealthCheckId: aws.String(id)})
+	if err != nil {
+		return model.WrapCloudError(model.AWS, err)
+	}
+	return nil
+}
+
+func (c *awsClient) DescribeHealthCheckStatus(ctx context.Context, profile, id string) (model.HealthCheckStatus, error) {
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return model.HealthCheckStatus{}, err
+	}
+	resp, err := client.GetHealthCheckStatusWithContext(ctx, &route53.GetHealthCheckStatusInput{HealthCheckId: aws.String(id)})
+	if err != nil {
+		return model.HealthCheckStatus{}, model.WrapCloudError(model.AWS, err)
+	}
+	return model.NewHealthCheckStatusFromAws(aws.String(id), resp.HealthCheckObservations), nil
+}
+
+// setAwsHealthCheckName 控制台上显示的名称是 Name 标签
+func setAwsHealthCheckName(ctx context.Context, client *route53.Route53, id, name *string) error {
+	_, err := client.ChangeTagsForResourceWithContext(ctx, &route53.ChangeTagsForResourceInput{
+		ResourceType: aws.String(route53.TagResourceTypeHealthcheck),
+		ResourceId:   id,
+		AddTags:      []*route53.Tag{{Key: aws.String("Name"), Value: name}},
+	})
+	if err != nil {
+		return model.WrapCloudError(model.AWS, err)
+	}
+	return nil
+}
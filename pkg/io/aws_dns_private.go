@@ -1,27 +1,484 @@
 package io
 
-import "github.com/xops-infra/multi-cloud-sdk/pkg/model"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
-func (c *awsClient) DescribePrivateDomainList(profile string, input model.DescribeDomainListRequest) (model.DescribePrivateDomainListResponse, error) {
-	panic("implement me")
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/spf13/cast"
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// aws 私有域就是 PrivateZone=true 的 Route53 hosted zone，Route53 为全局服务，region 使用 profile 的配置
+
+// DescribePrivateDomainList 按 ListHostedZones 的 PrivateZone 标记过滤私有域
+// VpcSet 和 Status 需要逐个 GetHostedZone 查询，只有 WithVpcs 时才查询，避免私有域很多时触发 Route53 限流
+func (c *awsClient) DescribePrivateDomainList(ctx context.Context, profile string, input model.DescribeDomainListRequest) (model.DescribePrivateDomainListResponse, error) {
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return model.DescribePrivateDomainListResponse{}, err
+	}
+	params := &route53.ListHostedZonesInput{}
+	var domains []model.PrivateDomain
+	for {
+		resp, err := client.ListHostedZonesWithContext(ctx, params)
+		if err != nil {
+			return model.DescribePrivateDomainListResponse{}, model.WrapCloudError(model.AWS, err)
+		}
+		for _, zone := range resp.HostedZones {
+			if zone.Config == nil || !aws.BoolValue(zone.Config.PrivateZone) {
+				continue
+			}
+			if input.DomainKeyword != nil && *input.DomainKeyword != "" {
+				if !strings.Contains(*zone.Name, *input.DomainKeyword) {
+					continue
+				}
+			}
+			domain := newAwsPrivateDomain(zone)
+			if input.WithVpcs {
+				detail, err := client.GetHostedZoneWithContext(ctx, &route53.GetHostedZoneInput{Id: zone.Id})
+				if err != nil {
+					return model.DescribePrivateDomainListResponse{}, model.WrapCloudError(model.AWS, err)
+				}
+				// 和腾讯云保持一致，未关联 VPC 为 SUSPEND
+				status := "SUSPEND"
+				if len(detail.VPCs) > 0 {
+					status = "ENABLED"
+				}
+				domain.VpcSet = model.NewPrivateZoneVpcsFromAws(detail.VPCs)
+				domain.Status = tea.String(status)
+			}
+			domains = append(domains, *domain)
+		}
+		if resp.IsTruncated == nil || !*resp.IsTruncated {
+			break
+		}
+		params.Marker = resp.NextMarker
+	}
+	return model.DescribePrivateDomainListResponse{
+		DomainList: domains,
+		TotalCount: tea.Int64(cast.ToInt64(len(domains))),
+	}, nil
+}
+
+// getPrivateHostedZone domain 为私有域名或者 hostedzoneId，同名的私有域可以关联不同的 VPC，这时需要使用 hostedzoneId
+// 按 ID 直接 GetHostedZone，按名称用 ListHostedZonesByName，不列出全部私有域，避免记录变更时触发 Route53 限流
+func (c *awsClient) getPrivateHostedZone(ctx context.Context, profile string, domain *string) (*model.PrivateDomain, error) {
+	if domain == nil {
+		return nil, fmt.Errorf("domain is required")
+	}
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return nil, err
+	}
+	// 域名都带点，没有点的按 hostedzoneId 处理，查不到时再按名称查找单标签的域名
+	if strings.HasPrefix(*domain, "/hostedzone/") || !strings.Contains(*domain, ".") {
+		resp, err := client.GetHostedZoneWithContext(ctx, &route53.GetHostedZoneInput{Id: aws.String(strings.TrimPrefix(*domain, "/hostedzone/"))})
+		if err == nil {
+			if resp.HostedZone.Config == nil || !aws.BoolValue(resp.HostedZone.Config.PrivateZone) {
+				return nil, model.NewCloudError(model.AWS, model.ErrorCategoryNotFound, "DomainNotFound", fmt.Sprintf("private domain not found: %s", *domain))
+			}
+			return newAwsPrivateDomain(resp.HostedZone), nil
+		}
+		if err = model.WrapCloudError(model.AWS, err); !errors.Is(err, model.ErrNotFound) || strings.HasPrefix(*domain, "/hostedzone/") {
+			return nil, err
+		}
+	}
+
+	name := strings.TrimSuffix(*domain, ".") + "."
+	params := &route53.ListHostedZonesByNameInput{DNSName: aws.String(name)}
+	var matched []*route53.HostedZone
+	for {
+		resp, err := client.ListHostedZonesByNameWithContext(ctx, params)
+		if err != nil {
+			return nil, model.WrapCloudError(model.AWS, err)
+		}
+		for _, zone := range resp.HostedZones {
+			if aws.StringValue(zone.Name) == name && zone.Config != nil && aws.BoolValue(zone.Config.PrivateZone) {
+				matched = append(matched, zone)
+			}
+		}
+		// 结果按名称排序，下一页不再是这个名称时结束
+		if !aws.BoolValue(resp.IsTruncated) || aws.StringValue(resp.NextDNSName) != name {
+			break
+		}
+		params.DNSName, params.HostedZoneId = resp.NextDNSName, resp.NextHostedZoneId
+	}
+	if len(matched) == 0 {
+		return nil, model.NewCloudError(model.AWS, model.ErrorCategoryNotFound, "DomainNotFound", fmt.Sprintf("private domain not found: %s", *domain))
+	}
+	if len(matched) > 1 {
+		return nil, model.NewCloudError(model.AWS, model.ErrorCategoryInvalidInput, "DomainAmbiguous", fmt.Sprintf("more than one private domain named %s, use hosted zone id", *domain))
+	}
+	return newAwsPrivateDomain(matched[0]), nil
+}
+
+// newAwsPrivateDomain 不包含 VpcSet 和 Status，需要时使用 DescribePrivateDomainList 并设置 WithVpcs
+func newAwsPrivateDomain(zone *route53.HostedZone) *model.PrivateDomain {
+	return &model.PrivateDomain{
+		DomainId:    zone.Id,
+		Name:        tea.String(strings.TrimSuffix(aws.StringValue(zone.Name), ".")),
+		RecordCount: zone.ResourceRecordSetCount,
+	}
+}
+
+// awsPrivateRecordId 私有域记录 ID 为 完整域名|记录类型，有 SetIdentifier 时再加上 |SetIdentifier，删除时按这个 ID 查找记录
+func awsPrivateRecordId(record model.Record) *string {
+	id := fmt.Sprintf("%s|%s", tea.StringValue(record.RecordId), tea.StringValue(record.RecordType))
+	if record.SetIdentifier != nil {
+		id += "|" + *record.SetIdentifier
+	}
+	return tea.String(id)
+}
+
+// awsRecordName 主机记录转换为完整域名，@ 或者空表示域名本身
+func awsRecordName(subDomain *string, zoneName string) string {
+	if subDomain == nil || *subDomain == "" || *subDomain == "@" {
+		return zoneName + "."
+	}
+	return fmt.Sprintf("%s.%s.", *subDomain, zoneName)
+}
+
+// DescribePrivateRecordList 和腾讯云一样按主机记录模糊匹配 Keyword
+func (c *awsClient) DescribePrivateRecordList(ctx context.Context, profile string, input model.DescribePrivateRecordListRequest) (model.DescribePrivateRecordListResponse, error) {
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return model.DescribePrivateRecordListResponse{}, err
+	}
+	zone, err := c.getPrivateHostedZone(ctx, profile, input.Domain)
+	if err != nil {
+		return model.DescribePrivateRecordListResponse{}, err
+	}
+	recordSets, err := listAwsRecordSets(ctx, client, zone.DomainId)
+	if err != nil {
+		return model.DescribePrivateRecordListResponse{}, err
+	}
+	var records []model.Record
+	for _, recordSet := range recordSets {
+		record := awsRecordSetToRecord(recordSet, *zone.Name)
+		if input.Keyword != nil && !strings.Contains(*record.SubDomain, *input.Keyword) {
+			continue
+		}
+		record.RecordId = awsPrivateRecordId(record)
+		records = append(records, record)
+	}
+	return model.DescribePrivateRecordListResponse{
+		RecordList: records,
+		TotalCount: tea.Int64(cast.ToInt64(len(records))),
+	}, nil
+}
+
+// DescribePrivateRecordListWithPages 和公有域一样，有 NextMarker 时从游标开始查询，否则按 Limit 逐页翻到 Page
+func (c *awsClient) DescribePrivateRecordListWithPages(ctx context.Context, profile string, input model.DescribePrivateDnsRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return model.ListRecordsPageResponse{}, err
+	}
+	zone, err := c.getPrivateHostedZone(ctx, profile, input.Domain)
+	if err != nil {
+		return model.ListRecordsPageResponse{}, err
+	}
+	recordSets, resp, err := listAwsRecordSetsPage(ctx, client, zone.DomainId, input.Limit, input.Page, input.NextMarker)
+	if err != nil {
+		return model.ListRecordsPageResponse{}, err
+	}
+	for _, recordSet := range recordSets {
+		record := awsRecordSetToRecord(recordSet, *zone.Name)
+		record.RecordId = awsPrivateRecordId(record)
+		resp.RecordList = append(resp.RecordList, record)
+	}
+	return resp, nil
+}
+
+// CreatePrivateRecord TTL 默认 300 和公有域一致
+func (c *awsClient) CreatePrivateRecord(ctx context.Context, profile string, input model.CreateRecordRequest) (model.CreateRecordResponse, error) {
+	if input.RecordType == nil || (input.Value == nil && len(input.Values) == 0 && input.AliasTarget == nil) {
+		return model.CreateRecordResponse{}, fmt.Errorf("recordtype, value is required")
+	}
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return model.CreateRecordResponse{}, err
+	}
+	zone, err := c.getPrivateHostedZone(ctx, profile, input.Domain)
+	if err != nil {
+		return model.CreateRecordResponse{}, err
+	}
+	var ttl int64 = 300
+	if input.TTL != nil {
+		ttl = cast.ToInt64(input.TTL)
+	}
+	name := awsRecordName(input.SubDomain, *zone.Name)
+	record, err := input.ToRecord().ResolveAwsRecordLine()
+	if err != nil {
+		return model.CreateRecordResponse{}, err
+	}
+	record.TTL = tea.Uint64(cast.ToUint64(ttl))
+	resp, err := client.ChangeResourceRecordSetsWithContext(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: zone.DomainId,
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{
+				{
+					Action:            aws.String(route53.ChangeActionCreate),
+					ResourceRecordSet: record.ToAwsResourceRecordSet(name),
+				},
+			},
+			Comment: tea.String(fmt.Sprintf("%s, created by multi-cloud-sdk", tea.StringValue(input.Info))),
+		},
+	})
+	if err != nil {
+		return model.CreateRecordResponse{}, model.WrapCloudError(model.AWS, err)
+	}
+	return model.CreateRecordResponse{
+		RecordId: awsPrivateRecordId(model.Record{RecordId: tea.String(name), RecordType: input.RecordType, SetIdentifier: input.SetIdentifier}),
+		Meta:     resp.ChangeInfo,
+	}, nil
+}
+
+// ModifyPrivateRecord 按 SubDomain 和 RecordType 覆盖记录，RecordId 不生效
+func (c *awsClient) ModifyPrivateRecord(ctx context.Context, profile string, input model.ModifyRecordRequest) error {
+	if input.RecordType == nil || (input.Value == nil && len(input.Values) == 0 && input.AliasTarget == nil) {
+		return fmt.Errorf("recordtype, value is required")
+	}
+	if input.Status != nil {
+		return fmt.Errorf("status is not supported for aws private dns. remove it from input")
+	}
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return err
+	}
+	zone, err := c.getPrivateHostedZone(ctx, profile, input.Domain)
+	if err != nil {
+		return err
+	}
+	var ttl int64 = 300
+	if input.TTL != nil {
+		ttl = cast.ToInt64(input.TTL)
+	}
+	record, err := input.ToRecord().ResolveAwsRecordLine()
+	if err != nil {
+		return err
+	}
+	record.TTL = tea.Uint64(cast.ToUint64(ttl))
+	_, err = client.ChangeResourceRecordSetsWithContext(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: zone.DomainId,
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{
+				{
+					Action:            aws.String(route53.ChangeActionUpsert),
+					ResourceRecordSet: record.ToAwsResourceRecordSet(awsRecordName(input.SubDomain, *zone.Name)),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return model.WrapCloudError(model.AWS, err)
+	}
+	return nil
+}
+
+// DeletePrivateRecord RecordId 为 DescribePrivateRecordList 返回的 ID，多条记录在一个 ChangeBatch 中删除
+func (c *awsClient) DeletePrivateRecord(ctx context.Context, profile string, input model.DeletePrivateRecordRequest) error {
+	if input.RecordId == nil && input.RecordIds == nil {
+		return fmt.Errorf("recordid & RecordIds must have one")
+	}
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return err
+	}
+	zone, err := c.getPrivateHostedZone(ctx, profile, input.Domain)
+	if err != nil {
+		return err
+	}
+	recordIds := map[string]bool{}
+	for _, id := range append([]*string{input.RecordId}, input.RecordIds...) {
+		if id != nil {
+			recordIds[*id] = true
+		}
+	}
+	recordSets, err := listAwsRecordSets(ctx, client, zone.DomainId)
+	if err != nil {
+		return err
+	}
+	var changes []*route53.Change
+	for _, recordSet := range recordSets {
+		id := awsPrivateRecordId(awsRecordSetToRecord(recordSet, *zone.Name))
+		if !recordIds[*id] {
+			continue
+		}
+		delete(recordIds, *id)
+		// 删除需要提供和现有记录完全一致的内容
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: recordSet,
+		})
+	}
+	for id := range recordIds {
+		return model.NewCloudError(model.AWS, model.ErrorCategoryNotFound, "RecordNotFound", fmt.Sprintf("private record not found: %s", id))
+	}
+	_, err = client.ChangeResourceRecordSetsWithContext(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: zone.DomainId,
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: changes,
+			Comment: tea.String("deleted by multi-cloud-sdk"),
+		},
+	})
+	if err != nil {
+		return model.WrapCloudError(model.AWS, err)
+	}
+	return nil
+}
+
+// ChangePrivateRecordSet 和公有域一样一次提交，私有域的 TTL 默认同样为 300
+func (c *awsClient) ChangePrivateRecordSet(ctx context.Context, profile string, input model.ChangeRecordSetRequest) (model.ChangeRecordSetResponse, error) {
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return model.ChangeRecordSetResponse{}, err
+	}
+	zone, err := c.getPrivateHostedZone(ctx, profile, input.Domain)
+	if err != nil {
+		return model.ChangeRecordSetResponse{}, err
+	}
+	return changeAwsRecordSets(ctx, client, zone.DomainId, *zone.Name, input)
 }
 
-func (c *awsClient) DescribePrivateRecordList(profile string, input model.DescribePrivateRecordListRequest) (model.DescribePrivateRecordListResponse, error) {
-	panic("implement me")
+// CreatePrivateZone aws 创建私有 hosted zone 时必须关联一个当前账号的 VPC，其余 VPC 创建后再关联
+func (c *awsClient) CreatePrivateZone(ctx context.Context, profile string, input model.CreatePrivateZoneRequest) (model.CreatePrivateZoneResponse, error) {
+	if err := input.Validate(); err != nil {
+		return model.CreatePrivateZoneResponse{}, err
+	}
+	if len(input.Vpcs) == 0 || input.Vpcs[0].IsCrossAccount() {
+		return model.CreatePrivateZoneResponse{}, fmt.Errorf("aws private zone requires a vpc of the current account as the first vpc")
+	}
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return model.CreatePrivateZoneResponse{}, err
+	}
+	name := strings.TrimSuffix(*input.Domain, ".")
+	resp, err := client.CreateHostedZoneWithContext(ctx, &route53.CreateHostedZoneInput{
+		Name:            tea.String(name),
+		CallerReference: tea.String(fmt.Sprintf("%s-%d", name, time.Now().UnixNano())),
+		HostedZoneConfig: &route53.HostedZoneConfig{
+			Comment:     input.Remark,
+			PrivateZone: aws.Bool(true),
+		},
+		VPC: input.Vpcs[0].ToAwsVpc(),
+	})
+	if err != nil {
+		return model.CreatePrivateZoneResponse{}, model.WrapCloudError(model.AWS, err)
+	}
+	if err := c.associateAwsVpcs(ctx, profile, client, resp.HostedZone.Id, input.Vpcs[1:]); err != nil {
+		return model.CreatePrivateZoneResponse{}, fmt.Errorf("private zone %s created, associate vpc failed: %w", aws.StringValue(resp.HostedZone.Id), err)
+	}
+	return model.CreatePrivateZoneResponse{
+		DomainId: resp.HostedZone.Id,
+		Name:     tea.String(name),
+		Meta:     resp,
+	}, nil
 }
 
-func (c *awsClient) CreatePrivateRecord(profile string, input model.CreateRecordRequest) (model.CreateRecordResponse, error) {
-	panic("implement me")
+// DeletePrivateZone 还有 SOA、NS 以外的记录时 Route53 拒绝删除
+func (c *awsClient) DeletePrivateZone(ctx context.Context, profile string, input model.DeletePrivateZoneRequest) error {
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return err
+	}
+	zone, err := c.getPrivateHostedZone(ctx, profile, input.Domain)
+	if err != nil {
+		return err
+	}
+	_, err = client.DeleteHostedZoneWithContext(ctx, &route53.DeleteHostedZoneInput{Id: zone.DomainId})
+	if err != nil {
+		return model.WrapCloudError(model.AWS, err)
+	}
+	return nil
 }
 
-func (c *awsClient) ModifyPrivateRecord(profile string, input model.ModifyRecordRequest) error {
-	panic("implement me")
+func (c *awsClient) AddZoneVpcAssociation(ctx context.Context, profile string, input model.ZoneVpcAssociationRequest) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return err
+	}
+	zone, err := c.getPrivateHostedZone(ctx, profile, input.Domain)
+	if err != nil {
+		return err
+	}
+	return c.associateAwsVpcs(ctx, profile, client, zone.DomainId, input.Vpcs)
 }
 
-func (c *awsClient) DeletePrivateRecord(profile string, input model.DeletePrivateRecordRequest) error {
-	panic("implement me")
+// associateAwsVpcs 其他账号的 VPC 先由私有域所在账号授权，再用 VPC 所属账号的 Profile 关联，关联后删除授权
+func (c *awsClient) associateAwsVpcs(ctx context.Context, profile string, client *route53.Route53, zoneId *string, vpcs []model.PrivateZoneVpc) error {
+	for _, vpc := range vpcs {
+		if !vpc.IsCrossAccount() {
+			_, err := client.AssociateVPCWithHostedZoneWithContext(ctx, &route53.AssociateVPCWithHostedZoneInput{
+				HostedZoneId: zoneId,
+				VPC:          vpc.ToAwsVpc(),
+			})
+			if err != nil {
+				return model.WrapCloudError(model.AWS, err)
+			}
+			continue
+		}
+		_, err := client.CreateVPCAssociationAuthorizationWithContext(ctx, &route53.CreateVPCAssociationAuthorizationInput{
+			HostedZoneId: zoneId,
+			VPC:          vpc.ToAwsVpc(),
+		})
+		if err != nil {
+			return model.WrapCloudError(model.AWS, err)
+		}
+		if vpc.Profile == nil {
+			continue
+		}
+		vpcClient, err := c.io.GetAwsRoute53Client(*vpc.Profile, "")
+		if err != nil {
+			return err
+		}
+		_, err = vpcClient.AssociateVPCWithHostedZoneWithContext(ctx, &route53.AssociateVPCWithHostedZoneInput{
+			HostedZoneId: zoneId,
+			VPC:          vpc.ToAwsVpc(),
+		})
+		if err != nil {
+			return model.WrapCloudError(model.AWS, err)
+		}
+		_, err = client.DeleteVPCAssociationAuthorizationWithContext(ctx, &route53.DeleteVPCAssociationAuthorizationInput{
+			HostedZoneId: zoneId,
+			VPC:          vpc.ToAwsVpc(),
+		})
+		if err != nil {
+			return model.WrapCloudError(model.AWS, err)
+		}
+	}
+	return nil
 }
 
-func (c *awsClient) DescribePrivateRecordListWithPages(profile string, input model.DescribePrivateDnsRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
-	panic("implement me")
+// RemoveZoneVpcAssociation 私有域所在账号可以解除其他账号 VPC 的关联，Route53 不允许解除最后一个 VPC
+func (c *awsClient) RemoveZoneVpcAssociation(ctx context.Context, profile string, input model.ZoneVpcAssociationRequest) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+	client, err := c.io.GetAwsRoute53Client(profile, "")
+	if err != nil {
+		return err
+	}
+	zone, err := c.getPrivateHostedZone(ctx, profile, input.Domain)
+	if err != nil {
+		return err
+	}
+	for _, vpc := range input.Vpcs {
+		_, err := client.DisassociateVPCFromHostedZoneWithContext(ctx, &route53.DisassociateVPCFromHostedZoneInput{
+			HostedZoneId: zone.DomainId,
+			VPC:          vpc.ToAwsVpc(),
+		})
+		if err != nil {
+			return model.WrapCloudError(model.AWS, err)
+		}
+	}
+	return nil
 }
@@ -1,6 +1,7 @@
 package io_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -22,6 +23,7 @@ func TestMain(t *testing.T) {
 			Domain: tea.String(os.Getenv("TEST_AWS_DOMAIN")),
 		}
 		_, err := AwsIo.DescribeRecordListWithPages(
+			context.Background(),
 			profile,
 			region,
 			req,
@@ -34,7 +36,7 @@ func TestMain(t *testing.T) {
 			Domain:  tea.String(os.Getenv("TEST_AWS_DOMAIN")),
 			Keyword: tea.String("pop"),
 		}
-		resp, err := AwsIo.DescribeRecordList(profile, region, req)
+		resp, err := AwsIo.DescribeRecordList(context.Background(), profile, region, req)
 		if err != nil {
 			t.Error(err)
 			return
@@ -44,7 +46,7 @@ func TestMain(t *testing.T) {
 	}
 	// TEST DescribeRecord
 	{
-		_, err := AwsIo.DescribeRecord(profile, region, model.DescribeRecordRequest{
+		_, err := AwsIo.DescribeRecord(context.Background(), profile, region, model.DescribeRecordRequest{
 			Domain:     tea.String(os.Getenv("TEST_AWS_DOMAIN")),
 			SubDomain:  tea.String("test"),
 			RecordType: tea.String("CNAME"),
@@ -58,7 +60,7 @@ func TestListDomain(t *testing.T) {
 	// TEST DescribePrivateDomainList
 	{
 		req := model.DescribeDomainListRequest{}
-		resp, err := AwsIo.DescribeDomainList("aws", "cn-notrhwest-1", req)
+		resp, err := AwsIo.DescribeDomainList(context.Background(), "aws", "cn-notrhwest-1", req)
 		if err != nil {
 			t.Error(err)
 			return
@@ -75,7 +77,7 @@ func TestDescribeList(t *testing.T) {
 		req := model.DescribeRecordListRequest{
 			Domain: tea.String("test.com"),
 		}
-		resp, err := AwsIo.DescribeRecordList("aws-prod", "us-east-1", req)
+		resp, err := AwsIo.DescribeRecordList(context.Background(), "aws-prod", "us-east-1", req)
 		if err != nil {
 			t.Error(err)
 			return
@@ -93,7 +95,7 @@ func TestDescribeRecordListWithPages(t *testing.T) {
 		Page:   tea.Int64(1),
 		Domain: tea.String("patsnap.co"),
 	}
-	resp, err := AwsIo.DescribeRecordListWithPages("aws", "cn-notrhwest-1", req)
+	resp, err := AwsIo.DescribeRecordListWithPages(context.Background(), "aws", "cn-notrhwest-1", req)
 	assert.Nil(t, err)
 	t.Log(tea.Prettify(resp))
 }
@@ -102,7 +104,7 @@ func TestDescribeRecordListWithPages(t *testing.T) {
 func TestDescribeListWithPages(t *testing.T) {
 	// TEST DescribeRecordList
 	req := model.DescribeDomainListRequest{}
-	resp, err := AwsIo.DescribeDomainList("aws", "cn-notrhwest-1", req)
+	resp, err := AwsIo.DescribeDomainList(context.Background(), "aws", "cn-notrhwest-1", req)
 	if err != nil {
 		t.Error(err)
 		return
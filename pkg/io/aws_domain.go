@@ -0,0 +1,59 @@
+package io
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// DescribeRegisteredDomains Route53 Domains 注册的域名，Detail 时逐个查询 GetDomainDetail
+func (c *awsClient) DescribeRegisteredDomains(ctx context.Context, profile string, input model.DescribeRegisteredDomainsRequest) ([]model.RegisteredDomain, error) {
+	client, err := c.io.GetAwsRoute53DomainClient(profile)
+	if err != nil {
+		return nil, err
+	}
+	var summaries []*route53domains.DomainSummary
+	err = client.ListDomainsPagesWithContext(ctx, &route53domains.ListDomainsInput{},
+		func(page *route53domains.ListDomainsOutput, lastPage bool) bool {
+			summaries = append(summaries, page.Domains...)
+			return true
+		})
+	if err != nil {
+		return nil, model.WrapCloudError(model.AWS, err)
+	}
+
+	domains := make([]model.RegisteredDomain, 0, len(summaries))
+	for _, summary := range summaries {
+		if !input.Detail {
+			domains = append(domains, model.NewRegisteredDomainFromAwsSummary(summary))
+			continue
+		}
+		domain, err := c.describeRegisteredDomain(ctx, client, aws.StringValue(summary.DomainName))
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, domain)
+	}
+	return domains, nil
+}
+
+func (c *awsClient) DescribeRegisteredDomain(ctx context.Context, profile, domain string) (model.RegisteredDomain, error) {
+	client, err := c.io.GetAwsRoute53DomainClient(profile)
+	if err != nil {
+		return model.RegisteredDomain{}, err
+	}
+	return c.describeRegisteredDomain(ctx, client, domain)
+}
+
+func (c *awsClient) describeRegisteredDomain(ctx context.Context, client *route53domains.Route53Domains, domain string) (model.RegisteredDomain, error) {
+	resp, err := client.GetDomainDetailWithContext(ctx, &route53domains.GetDomainDetailInput{
+		DomainName: aws.String(domain),
+	})
+	if err != nil {
+		return model.RegisteredDomain{}, model.WrapCloudError(model.AWS, err)
+	}
+	return model.NewRegisteredDomainFromAwsDetail(resp), nil
+}
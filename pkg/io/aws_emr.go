@@ -1,6 +1,7 @@
 package io
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -10,7 +11,7 @@ import (
 )
 
 // EMR
-func (c *awsClient) QueryEmrCluster(filter model.EmrFilter) (model.FilterEmrResponse, error) {
+func (c *awsClient) QueryEmrCluster(ctx context.Context, filter model.EmrFilter) (model.FilterEmrResponse, error) {
 	if filter.Region == nil && filter.Profile == nil {
 		return model.FilterEmrResponse{}, fmt.Errorf("region or profile is empty")
 	}
@@ -30,9 +31,9 @@ func (c *awsClient) QueryEmrCluster(filter model.EmrFilter) (model.FilterEmrResp
 	if filter.Period != nil {
 		input.CreatedAfter = aws.Time(time.Now().Add(-*filter.Period))
 	}
-	result, err := svc.ListClusters(input)
+	result, err := svc.ListClustersWithContext(ctx, input)
 	if err != nil {
-		return model.FilterEmrResponse{}, err
+		return model.FilterEmrResponse{}, model.WrapCloudError(model.AWS, err)
 	}
 	var clusters []model.EmrCluster
 	for _, cluster := range result.Clusters {
@@ -52,7 +53,7 @@ func (c *awsClient) QueryEmrCluster(filter model.EmrFilter) (model.FilterEmrResp
 	}, nil
 }
 
-func (c *awsClient) DescribeEmrCluster(input model.DescribeInput) ([]model.DescribeEmrCluster, error) {
+func (c *awsClient) DescribeEmrCluster(ctx context.Context, input model.DescribeInput) ([]model.DescribeEmrCluster, error) {
 	if input.Region == nil && input.Profile == nil {
 		return nil, fmt.Errorf("region or profile is empty")
 	}
@@ -62,11 +63,11 @@ func (c *awsClient) DescribeEmrCluster(input model.DescribeInput) ([]model.Descr
 	}
 	var clusters []model.DescribeEmrCluster
 	for _, id := range input.IDS {
-		out, err := svc.DescribeCluster(&emr.DescribeClusterInput{
+		out, err := svc.DescribeClusterWithContext(ctx, &emr.DescribeClusterInput{
 			ClusterId: id,
 		})
 		if err != nil {
-			return nil, err
+			return nil, model.WrapCloudError(model.AWS, err)
 		}
 		clusters = append(clusters, model.DescribeEmrCluster{
 			ID:         out.Cluster.Id,
@@ -80,7 +81,7 @@ func (c *awsClient) DescribeEmrCluster(input model.DescribeInput) ([]model.Descr
 	return clusters, nil
 }
 
-func (c *awsClient) CreateEmrCluster(profile, region string, input model.CreateEmrClusterInput) (model.CreateEmrClusterResponse, error) {
+func (c *awsClient) CreateEmrCluster(ctx context.Context, profile, region string, input model.CreateEmrClusterInput) (model.CreateEmrClusterResponse, error) {
 	client, err := c.io.GetAWSEmrClient(profile, region)
 	if err != nil {
 		return model.CreateEmrClusterResponse{}, err
@@ -89,9 +90,9 @@ func (c *awsClient) CreateEmrCluster(profile, region string, input model.CreateE
 	if err != nil {
 		return model.CreateEmrClusterResponse{}, err
 	}
-	response, err := client.RunJobFlow(req)
+	response, err := client.RunJobFlowWithContext(ctx, req)
 	if err != nil {
-		return model.CreateEmrClusterResponse{}, fmt.Errorf("create emr cluster error: %s", err)
+		return model.CreateEmrClusterResponse{}, fmt.Errorf("create emr cluster error: %w", model.WrapCloudError(model.AWS, err))
 	}
 	return model.CreateEmrClusterResponse{ID: *response.JobFlowId}, nil
 }
@@ -0,0 +1,171 @@
+package io
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// DescribeImages 私有镜像按 Owners=self 查询，共享镜像按 ExecutableUsers=self 查询；按 ID 查询时无法区分私有和共享，非公共镜像都为 private
+func (c *awsClient) DescribeImages(ctx context.Context, profile, region string, input model.DescribeImagesRequest) ([]model.Image, error) {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	var filters []*ec2.Filter
+	if input.Name != nil {
+		filters = append(filters, &ec2.Filter{Name: aws.String("name"), Values: []*string{input.Name}})
+	}
+	if input.Architecture != nil {
+		filters = append(filters, &ec2.Filter{Name: aws.String("architecture"), Values: []*string{input.Architecture}})
+	}
+
+	if len(input.ImageIds) > 0 {
+		return describeAwsImages(ctx, client, &ec2.DescribeImagesInput{ImageIds: aws.StringSlice(input.ImageIds), Filters: filters}, model.ImagePrivate, input)
+	}
+	visibilities := []model.ImageVisibility{model.ImagePrivate, model.ImageShared}
+	if input.Visibility != nil {
+		visibilities = []model.ImageVisibility{*input.Visibility}
+	}
+	var images []model.Image
+	for _, visibility := range visibilities {
+		query := &ec2.DescribeImagesInput{Filters: append([]*ec2.Filter{}, filters...)}
+		switch visibility {
+		case model.ImagePublic:
+			query.Filters = append(query.Filters, &ec2.Filter{Name: aws.String("is-public"), Values: aws.StringSlice([]string{"true"})})
+		case model.ImagePrivate:
+			query.Owners = aws.StringSlice([]string{"self"})
+		case model.ImageShared:
+			query.ExecutableUsers = aws.StringSlice([]string{"self"})
+		default:
+			return nil, fmt.Errorf("%w: unknown image visibility %s", model.ErrInvalidInput, visibility)
+		}
+		result, err := describeAwsImages(ctx, client, query, visibility, input)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, result...)
+	}
+	return images, nil
+}
+
+func describeAwsImages(ctx context.Context, client *ec2.EC2, query *ec2.DescribeImagesInput, visibility model.ImageVisibility, input model.DescribeImagesRequest) ([]model.Image, error) {
+	if len(query.ImageIds) == 0 {
+		query.MaxResults = aws.Int64(1000)
+	}
+	var images []model.Image
+	err := client.DescribeImagesPagesWithContext(ctx, query, func(page *ec2.DescribeImagesOutput, lastPage bool) bool {
+		for _, image := range page.Images {
+			m := model.NewImageFromAws(image, visibility)
+			if input.Match(m) {
+				images = append(images, m)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, model.WrapCloudError(model.AWS, err)
+	}
+	return images, nil
+}
+
+func (c *awsClient) CreateImage(ctx context.Context, profile, region string, input model.CreateImageRequest) (model.CreateImageResponse, error) {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return model.CreateImageResponse{}, err
+	}
+	resp, err := client.CreateImageWithContext(ctx, input.ToAwsCreateImageInput())
+	if err != nil {
+		return model.CreateImageResponse{}, model.WrapCloudError(model.AWS, err)
+	}
+	return model.CreateImageResponse{ImageId: resp.ImageId, Meta: resp}, nil
+}
+
+// CopyImage 在目标地域调用 CopyImage，没有指定名称时使用源镜像的名称
+func (c *awsClient) CopyImage(ctx context.Context, profile, region string, input model.CopyImageRequest) (model.CopyImageResponse, error) {
+	name := input.Name
+	if name == nil {
+		source, err := c.DescribeImages(ctx, profile, region, model.DescribeImagesRequest{ImageIds: []string{aws.StringValue(input.ImageId)}})
+		if err != nil {
+			return model.CopyImageResponse{}, err
+		}
+		if len(source) == 0 {
+			return model.CopyImageResponse{}, model.NewCloudError(model.AWS, model.ErrorCategoryNotFound, "InvalidAMIID.NotFound", fmt.Sprintf("image %s not found", aws.StringValue(input.ImageId)))
+		}
+		name = source[0].Name
+	}
+	var resp model.CopyImageResponse
+	var outputs []*ec2.CopyImageOutput
+	for _, destination := range input.DestinationRegions {
+		client, err := c.io.GetAwsEc2Client(profile, destination)
+		if err != nil {
+			return resp, err
+		}
+		output, err := client.CopyImageWithContext(ctx, &ec2.CopyImageInput{
+			SourceImageId: input.ImageId,
+			SourceRegion:  aws.String(region),
+			Name:          name,
+		})
+		if err != nil {
+			return resp, model.WrapCloudError(model.AWS, err)
+		}
+		outputs = append(outputs, output)
+		resp.Images = append(resp.Images, model.CopiedImage{Region: destination, ImageId: output.ImageId})
+	}
+	resp.Meta = outputs
+	return resp, nil
+}
+
+func (c *awsClient) ShareImage(ctx context.Context, profile, region string, input model.ShareImageRequest) error {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return err
+	}
+	var permissions []*ec2.LaunchPermission
+	for _, account := range input.AccountIds {
+		permissions = append(permissions, &ec2.LaunchPermission{UserId: aws.String(account)})
+	}
+	modification := &ec2.LaunchPermissionModifications{Add: permissions}
+	if input.Unshare {
+		modification = &ec2.LaunchPermissionModifications{Remove: permissions}
+	}
+	_, err = client.ModifyImageAttributeWithContext(ctx, &ec2.ModifyImageAttributeInput{
+		ImageId:          input.ImageId,
+		LaunchPermission: modification,
+	})
+	if err != nil {
+		return model.WrapCloudError(model.AWS, err)
+	}
+	return nil
+}
+
+// DeleteImage 注销镜像，DeleteSnapshots 时在注销之后删除关联的快照
+func (c *awsClient) DeleteImage(ctx context.Context, profile, region string, input model.DeleteImageRequest) error {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return err
+	}
+	var snapshots []*string
+	if input.DeleteSnapshots {
+		resp, err := client.DescribeImagesWithContext(ctx, &ec2.DescribeImagesInput{ImageIds: []*string{input.ImageId}})
+		if err != nil {
+			return model.WrapCloudError(model.AWS, err)
+		}
+		for _, image := range resp.Images {
+			snapshots = append(snapshots, model.AwsImageSnapshotIds(image)...)
+		}
+	}
+	if _, err := client.DeregisterImageWithContext(ctx, &ec2.DeregisterImageInput{ImageId: input.ImageId}); err != nil {
+		return model.WrapCloudError(model.AWS, err)
+	}
+	for _, snapshot := range snapshots {
+		if _, err := client.DeleteSnapshotWithContext(ctx, &ec2.DeleteSnapshotInput{SnapshotId: snapshot}); err != nil {
+			return model.WrapCloudError(model.AWS, err)
+		}
+	}
+	return nil
+}
@@ -1,13 +1,17 @@
 package io
 
 import (
+	"context"
+	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
-func (c *awsClient) DescribeInstances(profile, region string, input model.DescribeInstancesInput) (model.InstanceResponse, error) {
+// DescribeInstances Size 和 NextMarker 都为空时逐页查询全部，否则只返回一页
+func (c *awsClient) DescribeInstances(ctx context.Context, profile, region string, input model.DescribeInstancesInput) (model.InstanceResponse, error) {
 	svc, err := c.io.GetAwsEc2Client(profile, region)
 	if err != nil {
 		return model.InstanceResponse{}, err
@@ -29,19 +33,20 @@ func (c *awsClient) DescribeInstances(profile, region string, input model.Descri
 		req.NextToken = input.NextMarker
 	}
 
-	if input.Size != nil {
-		req.MaxResults = input.Size
+	// 指定 InstanceIds 时不能设置 MaxResults，MaxResults 的范围为 5 到 1000
+	if input.Size != nil && len(req.InstanceIds) == 0 {
+		req.MaxResults = aws.Int64(min(max(*input.Size, 5), 1000))
 	}
+	onePage := input.Size != nil || input.NextMarker != nil
 
 	var instances []model.Instance
 
 	var out *ec2.DescribeInstancesOutput
 
 	for {
-		// 打印 out 占用内存
-		out, err = svc.DescribeInstances(req)
+		out, err = svc.DescribeInstancesWithContext(ctx, req)
 		if err != nil {
-			return model.InstanceResponse{}, err
+			return model.InstanceResponse{}, model.WrapCloudError(model.AWS, err)
 		}
 		for _, reservation := range out.Reservations {
 			for _, instance := range reservation.Instances {
@@ -62,26 +67,151 @@ func (c *awsClient) DescribeInstances(profile, region string, input model.Descri
 
 			}
 		}
-		if out.NextToken == nil {
+		if onePage || out.NextToken == nil {
 			break
 		}
 		req.NextToken = out.NextToken
 	}
 
-	out = nil
+	return model.InstanceResponse{Instances: instances, NextMarker: out.NextToken}, nil
 
-	return model.InstanceResponse{Instances: instances}, nil
+}
 
+// CreateInstance 指定了系统盘时先查询镜像的根设备名
+func (c *awsClient) CreateInstance(ctx context.Context, profile, region string, input model.CreateInstanceInput) (model.CreateInstanceResponse, error) {
+	svc, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return model.CreateInstanceResponse{}, err
+	}
+	var rootDeviceName string
+	if input.SystemDisk != nil {
+		images, err := svc.DescribeImagesWithContext(ctx, &ec2.DescribeImagesInput{
+			ImageIds: []*string{input.ImageID},
+		})
+		if err != nil {
+			return model.CreateInstanceResponse{}, model.WrapCloudError(model.AWS, err)
+		}
+		if len(images.Images) == 0 {
+			return model.CreateInstanceResponse{}, model.NewCloudError(model.AWS, model.ErrorCategoryNotFound, "InvalidAMIID.NotFound", fmt.Sprintf("image %s not found", aws.StringValue(input.ImageID)))
+		}
+		rootDeviceName = aws.StringValue(images.Images[0].RootDeviceName)
+	}
+	request, err := input.ToAwsRunInstancesInput(rootDeviceName)
+	if err != nil {
+		return model.CreateInstanceResponse{}, err
+	}
+	out, err := svc.RunInstancesWithContext(ctx, request)
+	if err != nil {
+		return model.CreateInstanceResponse{}, model.WrapCloudError(model.AWS, err)
+	}
+	var instanceIds []*string
+	for _, instance := range out.Instances {
+		instanceIds = append(instanceIds, instance.InstanceId)
+	}
+	return model.CreateInstanceResponse{
+		Meta:        out,
+		InstanceIds: instanceIds,
+	}, nil
 }
 
-func (c *awsClient) CreateInstance(profile, region string, input model.CreateInstanceInput) (model.CreateInstanceResponse, error) {
-	panic("implement me")
+func (c *awsClient) ModifyInstance(ctx context.Context, profile, region string, input model.ModifyInstanceInput) (model.ModifyInstanceResponse, error) {
+	svc, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return model.ModifyInstanceResponse{}, err
+	}
+	var meta any
+	switch input.Action {
+	case model.StartInstance:
+		meta, err = svc.StartInstancesWithContext(ctx, &ec2.StartInstancesInput{InstanceIds: input.InstanceIDs})
+	case model.StopInstance:
+		meta, err = svc.StopInstancesWithContext(ctx, &ec2.StopInstancesInput{InstanceIds: input.InstanceIDs})
+	case model.RebootInstance:
+		meta, err = svc.RebootInstancesWithContext(ctx, &ec2.RebootInstancesInput{InstanceIds: input.InstanceIDs})
+	case model.ChangeInstanceType:
+		if input.InstanceType == nil {
+			return model.ModifyInstanceResponse{}, fmt.Errorf("instance type is required")
+		}
+		return c.changeInstanceType(ctx, svc, input.InstanceIDs, input.InstanceType)
+	case model.ChangeInstanceTags:
+		if len(input.Tags) == 0 {
+			return model.ModifyInstanceResponse{}, fmt.Errorf("tags is required")
+		}
+		meta, err = svc.CreateTagsWithContext(ctx, &ec2.CreateTagsInput{
+			Resources: input.InstanceIDs,
+			Tags:      input.Tags.ToAwsEc2Tags(),
+		})
+	default:
+		return model.ModifyInstanceResponse{}, fmt.Errorf("unsupported action: %s", input.Action)
+	}
+	if err != nil {
+		return model.ModifyInstanceResponse{}, model.WrapCloudError(model.AWS, err)
+	}
+	return model.ModifyInstanceResponse{
+		Meta: meta,
+	}, nil
 }
 
-func (c *awsClient) ModifyInstance(profile, region string, input model.ModifyInstanceInput) (model.ModifyInstanceResponse, error) {
-	panic("implement me")
+// ModifyInstanceAttribute 一次只能修改一个实例，实例需要先停机
+func (c *awsClient) changeInstanceType(ctx context.Context, svc *ec2.EC2, instances []*string, instanceType *string) (model.ModifyInstanceResponse, error) {
+	var metas []*ec2.ModifyInstanceAttributeOutput
+	for _, instance := range instances {
+		out, err := svc.ModifyInstanceAttributeWithContext(ctx, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:   instance,
+			InstanceType: &ec2.AttributeValue{Value: instanceType},
+		})
+		if err != nil {
+			return model.ModifyInstanceResponse{Meta: metas}, model.WrapCloudError(model.AWS, err)
+		}
+		metas = append(metas, out)
+	}
+	return model.ModifyInstanceResponse{
+		Meta: metas,
+	}, nil
 }
 
-func (c *awsClient) DeleteInstance(profile, region string, input model.DeleteInstanceInput) (model.DeleteInstanceResponse, error) {
-	panic("implement me")
+// DeleteInstance 和腾讯云一样默认不释放数据盘，ReleaseDisk 通过修改数据盘的 DeleteOnTermination 实现，系统盘总是随实例释放
+func (c *awsClient) DeleteInstance(ctx context.Context, profile, region string, input model.DeleteInstanceInput) (model.DeleteInstanceResponse, error) {
+	svc, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return model.DeleteInstanceResponse{}, err
+	}
+	releaseDisk := aws.BoolValue(input.ReleaseDisk)
+	describe, err := svc.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{InstanceIds: input.InstanceIds})
+	if err != nil {
+		return model.DeleteInstanceResponse{}, model.WrapCloudError(model.AWS, err)
+	}
+	for _, reservation := range describe.Reservations {
+		for _, instance := range reservation.Instances {
+			var mappings []*ec2.InstanceBlockDeviceMappingSpecification
+			for _, device := range instance.BlockDeviceMappings {
+				if device.Ebs == nil || aws.StringValue(device.DeviceName) == aws.StringValue(instance.RootDeviceName) {
+					continue
+				}
+				if aws.BoolValue(device.Ebs.DeleteOnTermination) == releaseDisk {
+					continue
+				}
+				mappings = append(mappings, &ec2.InstanceBlockDeviceMappingSpecification{
+					DeviceName: device.DeviceName,
+					Ebs:        &ec2.EbsInstanceBlockDeviceSpecification{DeleteOnTermination: aws.Bool(releaseDisk)},
+				})
+			}
+			if len(mappings) == 0 {
+				continue
+			}
+			_, err := svc.ModifyInstanceAttributeWithContext(ctx, &ec2.ModifyInstanceAttributeInput{
+				InstanceId:          instance.InstanceId,
+				BlockDeviceMappings: mappings,
+			})
+			if err != nil {
+				return model.DeleteInstanceResponse{}, model.WrapCloudError(model.AWS, err)
+			}
+		}
+	}
+	out, err := svc.TerminateInstancesWithContext(ctx, &ec2.TerminateInstancesInput{InstanceIds: input.InstanceIds})
+	if err != nil {
+		return model.DeleteInstanceResponse{}, model.WrapCloudError(model.AWS, err)
+	}
+	return model.DeleteInstanceResponse{
+		Meta: out,
+	}, nil
 }
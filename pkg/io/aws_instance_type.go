@@ -0,0 +1,70 @@
+package io
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// DescribeInstanceTypes 规格来自 DescribeInstanceTypes，售卖的可用区来自 DescribeInstanceTypeOfferings
+func (c *awsClient) DescribeInstanceTypes(ctx context.Context, profile, region string, filter model.InstanceTypeFilter) ([]model.InstanceType, error) {
+	if len(filter.InstanceTypes) > 100 {
+		return nil, fmt.Errorf("%w: at most 100 instance types", model.ErrInvalidInput)
+	}
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return nil, err
+	}
+
+	offeringInput := &ec2.DescribeInstanceTypeOfferingsInput{LocationType: aws.String(ec2.LocationTypeAvailabilityZone)}
+	if filter.Zone != nil {
+		offeringInput.Filters = append(offeringInput.Filters, &ec2.Filter{Name: aws.String("location"), Values: []*string{filter.Zone}})
+	}
+	if len(filter.InstanceTypes) > 0 {
+		offeringInput.Filters = append(offeringInput.Filters, &ec2.Filter{Name: aws.String("instance-type"), Values: aws.StringSlice(filter.InstanceTypes)})
+	}
+	zones := make(map[string][]model.InstanceTypeZone)
+	err = client.DescribeInstanceTypeOfferingsPagesWithContext(ctx, offeringInput, func(page *ec2.DescribeInstanceTypeOfferingsOutput, lastPage bool) bool {
+		for _, offering := range page.InstanceTypeOfferings {
+			instanceType := aws.StringValue(offering.InstanceType)
+			zones[instanceType] = append(zones[instanceType], model.InstanceTypeZone{
+				Zone:  aws.StringValue(offering.Location),
+				State: model.AvailabilityAvailable,
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, model.WrapCloudError(model.AWS, err)
+	}
+
+	input := &ec2.DescribeInstanceTypesInput{}
+	if len(filter.InstanceTypes) > 0 {
+		input.InstanceTypes = aws.StringSlice(filter.InstanceTypes)
+	} else {
+		input.MaxResults = aws.Int64(100)
+	}
+	var types []model.InstanceType
+	err = client.DescribeInstanceTypesPagesWithContext(ctx, input, func(page *ec2.DescribeInstanceTypesOutput, lastPage bool) bool {
+		for _, info := range page.InstanceTypes {
+			t := model.NewInstanceTypeFromAws(info)
+			t.Zones = zones[t.InstanceType]
+			// 指定可用区时不返回该可用区没有售卖的机型
+			if filter.Zone != nil && len(t.Zones) == 0 {
+				continue
+			}
+			if filter.Match(t) {
+				types = append(types, t)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, model.WrapCloudError(model.AWS, err)
+	}
+	return types, nil
+}
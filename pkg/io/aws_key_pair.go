@@ -0,0 +1,100 @@
+package io
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// DescribeKeyPairs AWS 的密钥对按地域隔离，没有分页
+func (c *awsClient) DescribeKeyPairs(ctx context.Context, profile, region string, input model.DescribeKeyPairsRequest) ([]model.KeyPair, error) {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	query := &ec2.DescribeKeyPairsInput{IncludePublicKey: aws.Bool(true)}
+	if len(input.KeyIds) > 0 {
+		query.KeyPairIds = aws.StringSlice(input.KeyIds)
+	}
+	if input.Name != nil {
+		query.KeyNames = []*string{input.Name}
+	}
+	resp, err := client.DescribeKeyPairsWithContext(ctx, query)
+	if err != nil {
+		return nil, model.WrapCloudError(model.AWS, err)
+	}
+	var keys []model.KeyPair
+	for _, key := range resp.KeyPairs {
+		keys = append(keys, model.NewKeyPairFromAws(key))
+	}
+	return keys, nil
+}
+
+func (c *awsClient) CreateKeyPair(ctx context.Context, profile, region string, input model.CreateKeyPairRequest) (model.CreateKeyPairResponse, error) {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return model.CreateKeyPairResponse{}, err
+	}
+	resp, err := client.CreateKeyPairWithContext(ctx, &ec2.CreateKeyPairInput{
+		KeyName:           input.Name,
+		KeyType:           input.KeyType,
+		TagSpecifications: model.ToAwsKeyPairTags(input.Tags),
+	})
+	if err != nil {
+		return model.CreateKeyPairResponse{}, model.WrapCloudError(model.AWS, err)
+	}
+	return model.CreateKeyPairResponse{
+		KeyPair: model.NewKeyPairFromAws(&ec2.KeyPairInfo{
+			KeyPairId:      resp.KeyPairId,
+			KeyName:        resp.KeyName,
+			KeyFingerprint: resp.KeyFingerprint,
+			Tags:           resp.Tags,
+		}),
+		PrivateKey: resp.KeyMaterial,
+	}, nil
+}
+
+func (c *awsClient) ImportKeyPair(ctx context.Context, profile, region string, input model.ImportKeyPairRequest) (model.KeyPair, error) {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return model.KeyPair{}, err
+	}
+	resp, err := client.ImportKeyPairWithContext(ctx, &ec2.ImportKeyPairInput{
+		KeyName:           input.Name,
+		PublicKeyMaterial: []byte(aws.StringValue(input.PublicKey)),
+		TagSpecifications: model.ToAwsKeyPairTags(input.Tags),
+	})
+	if err != nil {
+		return model.KeyPair{}, model.WrapCloudError(model.AWS, err)
+	}
+	return model.NewKeyPairFromAws(&ec2.KeyPairInfo{
+		KeyPairId:      resp.KeyPairId,
+		KeyName:        resp.KeyName,
+		KeyFingerprint: resp.KeyFingerprint,
+		PublicKey:      input.PublicKey,
+		Tags:           resp.Tags,
+	}), nil
+}
+
+func (c *awsClient) DeleteKeyPair(ctx context.Context, profile, region string, input model.DeleteKeyPairRequest) error {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return err
+	}
+	if _, err := client.DeleteKeyPairWithContext(ctx, &ec2.DeleteKeyPairInput{KeyPairId: input.KeyId}); err != nil {
+		return model.WrapCloudError(model.AWS, err)
+	}
+	return nil
+}
+
+// AssociateKeyPairs AWS 只能在创建实例时指定密钥对
+func (c *awsClient) AssociateKeyPairs(ctx context.Context, profile, region string, input model.KeyPairAssociationRequest) error {
+	return model.NewNotImplementedError(model.AWS, "AssociateKeyPairs")
+}
+
+func (c *awsClient) DisassociateKeyPairs(ctx context.Context, profile, region string, input model.KeyPairAssociationRequest) error {
+	return model.NewNotImplementedError(model.AWS, "DisassociateKeyPairs")
+}
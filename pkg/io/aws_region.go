@@ -0,0 +1,47 @@
+package io
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// ListRegions 包括没有开通的地域，region 为调用接口的地域，为空时使用 us-east-1，中国区需要传 cn-north-1 或 cn-northwest-1
+func (c *awsClient) ListRegions(ctx context.Context, profile, region string) ([]model.Region, error) {
+	if region == "" {
+		region = "us-east-1"
+	}
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.DescribeRegionsWithContext(ctx, &ec2.DescribeRegionsInput{AllRegions: aws.Bool(true)})
+	if err != nil {
+		return nil, model.WrapCloudError(model.AWS, err)
+	}
+	regions := make([]model.Region, 0, len(resp.Regions))
+	for _, r := range resp.Regions {
+		regions = append(regions, model.NewRegionFromAws(r))
+	}
+	return regions, nil
+}
+
+// ListZones 只返回已开通的可用区，包括 Local Zone
+func (c *awsClient) ListZones(ctx context.Context, profile, region string) ([]model.Zone, error) {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.DescribeAvailabilityZonesWithContext(ctx, &ec2.DescribeAvailabilityZonesInput{})
+	if err != nil {
+		return nil, model.WrapCloudError(model.AWS, err)
+	}
+	zones := make([]model.Zone, 0, len(resp.AvailabilityZones))
+	for _, z := range resp.AvailabilityZones {
+		zones = append(zones, model.NewZoneFromAws(z))
+	}
+	return zones, nil
+}
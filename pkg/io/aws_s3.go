@@ -1,6 +1,7 @@
 package io
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -14,7 +15,7 @@ import (
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
-func (c *awsClient) CreateBucketLifecycle(profile, region string, input model.CreateBucketLifecycleRequest) error {
+func (c *awsClient) CreateBucketLifecycle(ctx context.Context, profile, region string, input model.CreateBucketLifecycleRequest) error {
 	client, err := c.io.GetAWSS3Client(profile, region)
 	if err != nil {
 		return err
@@ -23,9 +24,9 @@ func (c *awsClient) CreateBucketLifecycle(profile, region string, input model.Cr
 	if err != nil {
 		return err
 	}
-	_, err = client.PutBucketLifecycle(req)
+	_, err = client.PutBucketLifecycleWithContext(ctx, req)
 	if err != nil {
-		return err
+		return model.WrapCloudError(model.AWS, err)
 	}
 	return nil
 }
@@ -50,16 +51,16 @@ func (c *awsClient) CreateBucketLifecycle(profile, region string, input model.Cr
 	   ]
 	}
 */
-func (c *awsClient) GetBucketLifecycle(profile, region string, input model.GetBucketLifecycleRequest) (model.GetBucketLifecycleResponse, error) {
+func (c *awsClient) GetBucketLifecycle(ctx context.Context, profile, region string, input model.GetBucketLifecycleRequest) (model.GetBucketLifecycleResponse, error) {
 	client, err := c.io.GetAWSS3Client(profile, region)
 	if err != nil {
 		return model.GetBucketLifecycleResponse{}, err
 	}
-	resp, err := client.GetBucketLifecycle(&s3.GetBucketLifecycleInput{
+	resp, err := client.GetBucketLifecycleWithContext(ctx, &s3.GetBucketLifecycleInput{
 		Bucket: input.Bucket,
 	})
 	if err != nil {
-		return model.GetBucketLifecycleResponse{}, err
+		return model.GetBucketLifecycleResponse{}, model.WrapCloudError(model.AWS, err)
 	}
 	return model.GetBucketLifecycleResponse{
 		Lifecycle: resp.Rules,
@@ -67,27 +68,27 @@ func (c *awsClient) GetBucketLifecycle(profile, region string, input model.GetBu
 }
 
 // 比官方多了个创建bucket的tags功能。
-func (c *awsClient) CreateBucket(profile, region string, input model.CreateBucketRequest) error {
+func (c *awsClient) CreateBucket(ctx context.Context, profile, region string, input model.CreateBucketRequest) error {
 	client, err := c.io.GetAWSS3Client(profile, region)
 	if err != nil {
 		return err
 	}
 
-	_, err = client.CreateBucket(&s3.CreateBucketInput{
+	_, err = client.CreateBucketWithContext(ctx, &s3.CreateBucketInput{
 		Bucket: input.BucketName,
 	})
 	if err != nil {
-		return err
+		return model.WrapCloudError(model.AWS, err)
 	}
 
 	// add tags
 	if len(input.Tags) > 0 {
-		_, err = client.PutBucketTagging(&s3.PutBucketTaggingInput{
+		_, err = client.PutBucketTaggingWithContext(ctx, &s3.PutBucketTaggingInput{
 			Bucket:  input.BucketName,
 			Tagging: &s3.Tagging{TagSet: input.Tags.ToAWSS3Tags()},
 		})
 		if err != nil {
-			return fmt.Errorf("create bucket success. put bucket tags failed: %v", err)
+			return fmt.Errorf("create bucket success. put bucket tags failed: %w", model.WrapCloudError(model.AWS, err))
 		}
 	}
 
@@ -95,34 +96,19 @@ func (c *awsClient) CreateBucket(profile, region string, input model.CreateBucke
 }
 
 // 删除走后台人工吧，接口不支持。
-func (c *awsClient) DeleteBucket(profile, region string, input model.DeleteBucketRequest) (model.DeleteBucketResponse, error) {
-	panic("implement me")
-	// client, err := c.io.GetAWSS3Client(profile, region)
-	// if err != nil {
-	// 	return model.DeleteBucketResponse{}, err
-
-	// }
-	// resp, err := client.DeleteBucket(&s3.DeleteBucketInput{
-	// 	Bucket: input.BucketName,
-	// })
-	// if err != nil {
-	// 	return model.DeleteBucketResponse{}, err
-
-	// }
-	// return model.DeleteBucketResponse{
-	// 	Meta: resp.GoString(),
-	// }, nil
+func (c *awsClient) DeleteBucket(ctx context.Context, profile, region string, input model.DeleteBucketRequest) (model.DeleteBucketResponse, error) {
+	return model.DeleteBucketResponse{}, model.NewNotImplementedError(model.AWS, "DeleteBucket")
 }
 
 // 比官方多了个查询桶标签和地域的功能。
-func (c *awsClient) ListBucket(profile, region string, input model.ListBucketRequest) (model.ListBucketResponse, error) {
+func (c *awsClient) ListBucket(ctx context.Context, profile, region string, input model.ListBucketRequest) (model.ListBucketResponse, error) {
 	client, err := c.io.GetAWSS3Client(profile, region)
 	if err != nil {
 		return model.ListBucketResponse{}, err
 	}
-	resp, err := client.ListBuckets(&s3.ListBucketsInput{})
+	resp, err := client.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
 	if err != nil {
-		return model.ListBucketResponse{}, err
+		return model.ListBucketResponse{}, model.WrapCloudError(model.AWS, err)
 	}
 	var buckets []*model.Bucket
 	wg := sync.WaitGroup{}
@@ -145,7 +131,7 @@ func (c *awsClient) ListBucket(profile, region string, input model.ListBucketReq
 		go func(bucket *model.Bucket) {
 			// 查询一下桶地域
 			defer wg.Done()
-			locationResp, err := client.GetBucketLocation(&s3.GetBucketLocationInput{
+			locationResp, err := client.GetBucketLocationWithContext(ctx, &s3.GetBucketLocationInput{
 				Bucket: &bucket.Name,
 			})
 			if err != nil {
@@ -163,7 +149,7 @@ func (c *awsClient) ListBucket(profile, region string, input model.ListBucketReq
 			}
 
 			// 查询一下桶标签
-			tagResp, err := client.GetBucketTagging(&s3.GetBucketTaggingInput{
+			tagResp, err := client.GetBucketTaggingWithContext(ctx, &s3.GetBucketTaggingInput{
 				Bucket: &bucket.Name,
 			})
 			if err != nil {
@@ -191,15 +177,15 @@ func (c *awsClient) ListBucket(profile, region string, input model.ListBucketReq
 	}, nil
 }
 
-func (c *awsClient) GetObjectPregisn(profile, region string, req model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
+func (c *awsClient) GetObjectPregisn(ctx context.Context, profile, region string, req model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
 	client, err := c.io.GetAWSS3Client(profile, region)
 	if err != nil {
 		return model.ObjectPregisnResponse{}, err
 	}
-	return c.getObjectPregisn(client, req)
+	return c.getObjectPregisn(ctx, client, req)
 }
 
-func (c *awsClient) GetObjectPregisnWithAKSK(ak, sk, region string, req model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
+func (c *awsClient) GetObjectPregisnWithAKSK(ctx context.Context, ak, sk, region string, req model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
 	cre := credentials.NewStaticCredentials(ak, sk, "")
 	session, err := session.NewSession(aws.NewConfig().WithCredentials(cre))
 	if err != nil {
@@ -207,17 +193,17 @@ func (c *awsClient) GetObjectPregisnWithAKSK(ak, sk, region string, req model.Ob
 	}
 	session.Config.Region = aws.String(region)
 	client := s3.New(session)
-	return c.getObjectPregisn(client, req)
+	return c.getObjectPregisn(ctx, client, req)
 }
 
-func (c *awsClient) getObjectPregisn(client *s3.S3, req model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
+func (c *awsClient) getObjectPregisn(ctx context.Context, client *s3.S3, req model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
 	// head object
-	_, err := client.HeadObject(&s3.HeadObjectInput{
+	_, err := client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
 		Bucket: req.Bucket,
 		Key:    req.Key,
 	})
 	if err != nil {
-		return model.ObjectPregisnResponse{}, err
+		return model.ObjectPregisnResponse{}, model.WrapCloudError(model.AWS, err)
 	}
 
 	// request object
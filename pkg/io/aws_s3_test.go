@@ -1,6 +1,7 @@
 package io_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -9,7 +10,7 @@ import (
 )
 
 func TestGetBucketLifecycle(t *testing.T) {
-	resp, err := AwsIo.GetBucketLifecycle("aws", "us-east-1", model.GetBucketLifecycleRequest{
+	resp, err := AwsIo.GetBucketLifecycle(context.Background(), "aws", "us-east-1", model.GetBucketLifecycleRequest{
 		Bucket: tea.String("zhoushoujiantest"),
 	})
 	if err != nil {
@@ -19,7 +20,7 @@ func TestGetBucketLifecycle(t *testing.T) {
 }
 
 func TestAwsCreateBucketLifecycle(t *testing.T) {
-	err := AwsIo.CreateBucketLifecycle("aws", "us-east-1", model.CreateBucketLifecycleRequest{
+	err := AwsIo.CreateBucketLifecycle(context.Background(), "aws", "us-east-1", model.CreateBucketLifecycleRequest{
 		Bucket: tea.String("zhoushoujiantest"),
 		Lifecycles: []model.Lifecycle{
 			{
@@ -42,7 +43,7 @@ func TestAwsCreateBucketLifecycle(t *testing.T) {
 }
 
 func TestCreateS3Bucket(t *testing.T) {
-	err := AwsIo.CreateBucket("aws", "us-east-1", model.CreateBucketRequest{
+	err := AwsIo.CreateBucket(context.Background(), "aws", "us-east-1", model.CreateBucketRequest{
 		BucketName: tea.String("test-bucket-zsj-1"),
 		Tags: model.Tags{
 			{Key: "Owner", Value: "zhoushoujian"},
@@ -57,7 +58,7 @@ func TestCreateS3Bucket(t *testing.T) {
 
 // TestListS3Bucket
 func TestListS3Bucket(t *testing.T) {
-	resp, err := AwsIo.ListBucket("aws", "us-east-1", model.ListBucketRequest{
+	resp, err := AwsIo.ListBucket(context.Background(), "aws", "us-east-1", model.ListBucketRequest{
 		KeyWord: tea.String("test-bucket-zsj"),
 	})
 	if err != nil {
@@ -68,7 +69,7 @@ func TestListS3Bucket(t *testing.T) {
 
 // TestGetObjectPregisn
 func TestS3GetObjectPregisn(t *testing.T) {
-	resp, err := AwsIo.GetObjectPregisn("aws", "us-east-1", model.ObjectPregisnRequest{
+	resp, err := AwsIo.GetObjectPregisn(context.Background(), "aws", "us-east-1", model.ObjectPregisnRequest{
 		Bucket: tea.String("zhoushoujiantest"),
 		Key:    tea.String("xxx.pdf"),
 		Expire: tea.Int64(3600),
@@ -1,6 +1,7 @@
 package io_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -47,7 +48,7 @@ func TestQueryEmrCluster(t *testing.T) {
 		// NextMarker: tea.String("xxx"),
 	}
 	// filter.ClusterStates = []model.EMRClusterStatus{model.EMRClusterRunning}
-	resp, err := AwsIo.QueryEmrCluster(filter)
+	resp, err := AwsIo.QueryEmrCluster(context.Background(), filter)
 	if err != nil {
 		t.Error(err)
 		return
@@ -63,7 +64,7 @@ func TestQueryEmrCluster(t *testing.T) {
 
 func TestDescribeEmrCluster(t *testing.T) {
 	timeStart := time.Now()
-	clusters, err := AwsIo.DescribeEmrCluster(model.DescribeInput{
+	clusters, err := AwsIo.DescribeEmrCluster(context.Background(), model.DescribeInput{
 		Profile: tea.String("aws"),
 		Region:  tea.String("us-east-1"),
 		IDS:     []*string{tea.String("j-xxx")},
@@ -81,7 +82,7 @@ func TestAwsDescribeInstances(t *testing.T) {
 	filter := model.InstanceFilter{
 		Size: tea.Int64(6),
 	}
-	instances, err := AwsIo.DescribeInstances("aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
+	instances, err := AwsIo.DescribeInstances(context.Background(), "aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
 	if err != nil {
 		t.Error(err)
 		return
@@ -97,7 +98,7 @@ func TestAwsDescribeInstancesAll(t *testing.T) {
 		timeStart := time.Now()
 		filter := model.InstanceFilter{}
 		filter.PrivateIp = tea.String(os.Getenv("TEST_AWS_PRIVATE_IP"))
-		instances, err := AwsIo.DescribeInstances("aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
+		instances, err := AwsIo.DescribeInstances(context.Background(), "aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
 		if err != nil {
 			t.Error(err)
 			return
@@ -108,7 +109,7 @@ func TestAwsDescribeInstancesAll(t *testing.T) {
 		timeStart := time.Now()
 		filter := model.InstanceFilter{}
 		filter.PublicIp = tea.String(os.Getenv("TEST_AWS_PUBLIC_IP"))
-		instances, err := AwsIo.DescribeInstances("aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
+		instances, err := AwsIo.DescribeInstances(context.Background(), "aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
 		if err != nil {
 			t.Error(err)
 			return
@@ -119,7 +120,7 @@ func TestAwsDescribeInstancesAll(t *testing.T) {
 		timeStart := time.Now()
 		filter := model.InstanceFilter{}
 		filter.Owner = tea.String("zhoushoujian")
-		instances, err := AwsIo.DescribeInstances("aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
+		instances, err := AwsIo.DescribeInstances(context.Background(), "aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
 		if err != nil {
 			t.Error(err)
 			return
@@ -130,7 +131,7 @@ func TestAwsDescribeInstancesAll(t *testing.T) {
 		timeStart := time.Now()
 		filter := model.InstanceFilter{}
 		filter.IDs = []*string{tea.String(os.Getenv("TEST_AWS_ID"))}
-		instances, err := AwsIo.DescribeInstances("aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
+		instances, err := AwsIo.DescribeInstances(context.Background(), "aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
 		if err != nil {
 			t.Error(err)
 			return
@@ -141,7 +142,7 @@ func TestAwsDescribeInstancesAll(t *testing.T) {
 		timeStart := time.Now()
 		filter := model.InstanceFilter{}
 		filter.Name = tea.String(os.Getenv("TEST_AWS_NAME"))
-		instances, err := AwsIo.DescribeInstances("aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
+		instances, err := AwsIo.DescribeInstances(context.Background(), "aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
 		if err != nil {
 			t.Error(err)
 			return
@@ -152,7 +153,7 @@ func TestAwsDescribeInstancesAll(t *testing.T) {
 		timeStart := time.Now()
 		filter := model.InstanceFilter{}
 		filter.Status = model.InstanceStatusRunning.TString()
-		instances, err := AwsIo.DescribeInstances("aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
+		instances, err := AwsIo.DescribeInstances(context.Background(), "aws", "cn-northwest-1", filter.ToAwsDescribeInstancesInput())
 		if err != nil {
 			t.Error(err)
 			return
@@ -160,3 +161,47 @@ func TestAwsDescribeInstancesAll(t *testing.T) {
 		t.Log("Status Success.", time.Since(timeStart), len(instances.Instances))
 	}
 }
+
+func TestAwsCreateInstance(t *testing.T) {
+	resp, err := AwsIo.CreateInstance(context.Background(), "aws", "cn-northwest-1", model.CreateInstanceInput{
+		Name:             tea.String("multi-cloud-sdk-test"),
+		ImageID:          tea.String(os.Getenv("TEST_AWS_IMAGE_ID")),
+		InstanceType:     tea.String("t3.micro"),
+		KeyIds:           []*string{tea.String(os.Getenv("TEST_AWS_KEY_NAME"))},
+		SubnetID:         tea.String(os.Getenv("TEST_AWS_SUBNET_ID")),
+		SecurityGroupIDs: []*string{tea.String(os.Getenv("TEST_AWS_SECURITY_GROUP_ID"))},
+		SystemDisk:       &model.Disk{Size: tea.Int64(20), Type: tea.String("gp3")},
+		DataDisks:        []model.Disk{{Size: tea.Int64(10), Type: tea.String("gp3")}},
+		Tags:             model.Tags{{Key: "Owner", Value: "multi-cloud-sdk"}},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	t.Logf("Success. %s", tea.Prettify(resp.InstanceIds))
+}
+
+func TestAwsModifyInstance(t *testing.T) {
+	resp, err := AwsIo.ModifyInstance(context.Background(), "aws", "cn-northwest-1", model.ModifyInstanceInput{
+		Action:      model.ChangeInstanceTags,
+		InstanceIDs: []*string{tea.String(os.Getenv("TEST_AWS_ID"))},
+		Tags:        model.Tags{{Key: "Owner", Value: "multi-cloud-sdk"}},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	t.Logf("Success. %s", tea.Prettify(resp))
+}
+
+func TestAwsDeleteInstance(t *testing.T) {
+	resp, err := AwsIo.DeleteInstance(context.Background(), "aws", "cn-northwest-1", model.DeleteInstanceInput{
+		InstanceIds: []*string{tea.String("i-xx")},
+		ReleaseDisk: tea.Bool(true),
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	t.Logf("Success. %s", tea.Prettify(resp))
+}
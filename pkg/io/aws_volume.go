@@ -0,0 +1,148 @@
+package io
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+func (c *awsClient) DescribeVolumes(ctx context.Context, profile, region string, input model.DescribeVolumesRequest) ([]model.Volume, error) {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	query := &ec2.DescribeVolumesInput{}
+	if len(input.VolumeIds) > 0 {
+		query.VolumeIds = aws.StringSlice(input.VolumeIds)
+	} else {
+		query.MaxResults = aws.Int64(500)
+	}
+	if input.InstanceId != nil {
+		query.Filters = append(query.Filters, &ec2.Filter{Name: aws.String("attachment.instance-id"), Values: []*string{input.InstanceId}})
+	}
+	if input.Zone != nil {
+		query.Filters = append(query.Filters, &ec2.Filter{Name: aws.String("availability-zone"), Values: []*string{input.Zone}})
+	}
+	var volumes []model.Volume
+	err = client.DescribeVolumesPagesWithContext(ctx, query, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
+		for _, volume := range page.Volumes {
+			volumes = append(volumes, model.NewVolumeFromAws(volume))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, model.WrapCloudError(model.AWS, err)
+	}
+	return volumes, nil
+}
+
+func (c *awsClient) CreateVolume(ctx context.Context, profile, region string, input model.CreateVolumeRequest) (model.CreateVolumeResponse, error) {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return model.CreateVolumeResponse{}, err
+	}
+	resp, err := client.CreateVolumeWithContext(ctx, input.ToAwsCreateVolumeInput())
+	if err != nil {
+		return model.CreateVolumeResponse{}, model.WrapCloudError(model.AWS, err)
+	}
+	return model.CreateVolumeResponse{VolumeId: resp.VolumeId, Meta: resp}, nil
+}
+
+// ResizeVolume 同一个卷每 6 小时只能修改一次
+func (c *awsClient) ResizeVolume(ctx context.Context, profile, region string, input model.ResizeVolumeRequest) error {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return err
+	}
+	_, err = client.ModifyVolumeWithContext(ctx, &ec2.ModifyVolumeInput{
+		VolumeId: input.VolumeId,
+		Size:     aws.Int64(input.SizeGiB),
+	})
+	if err != nil {
+		return model.WrapCloudError(model.AWS, err)
+	}
+	return nil
+}
+
+// AttachVolume 没有指定设备名时查询实例已使用的设备名，选择第一个空闲的
+func (c *awsClient) AttachVolume(ctx context.Context, profile, region string, input model.AttachVolumeRequest) error {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return err
+	}
+	device := input.Device
+	if device == nil {
+		device, err = nextAwsDeviceName(ctx, client, input.InstanceId)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = client.AttachVolumeWithContext(ctx, &ec2.AttachVolumeInput{
+		VolumeId:   input.VolumeId,
+		InstanceId: input.InstanceId,
+		Device:     device,
+	})
+	if err != nil {
+		return model.WrapCloudError(model.AWS, err)
+	}
+	return nil
+}
+
+func nextAwsDeviceName(ctx context.Context, client *ec2.EC2, instanceId *string) (*string, error) {
+	resp, err := client.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{InstanceIds: []*string{instanceId}})
+	if err != nil {
+		return nil, model.WrapCloudError(model.AWS, err)
+	}
+	used := make(map[string]bool)
+	found := false
+	for _, reservation := range resp.Reservations {
+		for _, instance := range reservation.Instances {
+			found = true
+			for _, mapping := range instance.BlockDeviceMappings {
+				used[aws.StringValue(mapping.DeviceName)] = true
+			}
+		}
+	}
+	if !found {
+		return nil, model.NewCloudError(model.AWS, model.ErrorCategoryNotFound, "InvalidInstanceID.NotFound", fmt.Sprintf("instance %s not found", aws.StringValue(instanceId)))
+	}
+	for letter := 'f'; letter <= 'p'; letter++ {
+		name := fmt.Sprintf("/dev/sd%c", letter)
+		// Nitro 实例上 /dev/sdf 显示为 /dev/xvdf
+		if !used[name] && !used[fmt.Sprintf("/dev/xvd%c", letter)] {
+			return aws.String(name), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: instance %s has no free device name", model.ErrInvalidInput, aws.StringValue(instanceId))
+}
+
+func (c *awsClient) DetachVolume(ctx context.Context, profile, region string, input model.DetachVolumeRequest) error {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return err
+	}
+	_, err = client.DetachVolumeWithContext(ctx, &ec2.DetachVolumeInput{
+		VolumeId:   input.VolumeId,
+		InstanceId: input.InstanceId,
+		Force:      aws.Bool(input.Force),
+	})
+	if err != nil {
+		return model.WrapCloudError(model.AWS, err)
+	}
+	return nil
+}
+
+func (c *awsClient) DeleteVolume(ctx context.Context, profile, region string, input model.DeleteVolumeRequest) error {
+	client, err := c.io.GetAwsEc2Client(profile, region)
+	if err != nil {
+		return err
+	}
+	if _, err := client.DeleteVolumeWithContext(ctx, &ec2.DeleteVolumeInput{VolumeId: input.VolumeId}); err != nil {
+		return model.WrapCloudError(model.AWS, err)
+	}
+	return nil
+}
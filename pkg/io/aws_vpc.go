@@ -1,13 +1,14 @@
 package io
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
 // QueryVpcs
-func (c *awsClient) QueryVPC(profile, region string, input model.CommonFilter) ([]model.VPC, error) {
+func (c *awsClient) QueryVPC(ctx context.Context, profile, region string, input model.CommonFilter) ([]model.VPC, error) {
 	svc, err := c.io.GetAwsEc2Client(profile, region)
 	if err != nil {
 		return nil, err
@@ -18,9 +19,9 @@ func (c *awsClient) QueryVPC(profile, region string, input model.CommonFilter) (
 		_input.VpcIds = []*string{aws.String(input.ID)}
 	}
 	for {
-		out, err := svc.DescribeVpcs(_input)
+		out, err := svc.DescribeVpcsWithContext(ctx, _input)
 		if err != nil {
-			return nil, err
+			return nil, model.WrapCloudError(model.AWS, err)
 		}
 		for _, vpc := range out.Vpcs {
 			vpcs = append(vpcs, model.VPC{
@@ -42,7 +43,7 @@ func (c *awsClient) QueryVPC(profile, region string, input model.CommonFilter) (
 }
 
 // QuerySubnet
-func (c *awsClient) QuerySubnet(profile, region string, input model.CommonFilter) ([]model.Subnet, error) {
+func (c *awsClient) QuerySubnet(ctx context.Context, profile, region string, input model.CommonFilter) ([]model.Subnet, error) {
 	svc, err := c.io.GetAwsEc2Client(profile, region)
 	if err != nil {
 		return nil, err
@@ -53,9 +54,9 @@ func (c *awsClient) QuerySubnet(profile, region string, input model.CommonFilter
 		_input.SubnetIds = []*string{aws.String(input.ID)}
 	}
 	for {
-		out, err := svc.DescribeSubnets(_input)
+		out, err := svc.DescribeSubnetsWithContext(ctx, _input)
 		if err != nil {
-			return nil, err
+			return nil, model.WrapCloudError(model.AWS, err)
 		}
 		for _, subnet := range out.Subnets {
 			tags := model.AwsTagsToModelTags(subnet.Tags)
@@ -85,7 +86,7 @@ func (c *awsClient) QuerySubnet(profile, region string, input model.CommonFilter
 }
 
 // QueryEIP
-func (c *awsClient) QueryEIP(profile, region string, input model.CommonFilter) ([]model.EIP, error) {
+func (c *awsClient) QueryEIP(ctx context.Context, profile, region string, input model.CommonFilter) ([]model.EIP, error) {
 	svc, err := c.io.GetAwsEc2Client(profile, region)
 	if err != nil {
 		return nil, err
@@ -95,9 +96,9 @@ func (c *awsClient) QueryEIP(profile, region string, input model.CommonFilter) (
 	if input.ID != "" {
 		_input.AllocationIds = []*string{aws.String(input.ID)}
 	}
-	out, err := svc.DescribeAddresses(_input)
+	out, err := svc.DescribeAddressesWithContext(ctx, _input)
 	if err != nil {
-		return nil, err
+		return nil, model.WrapCloudError(model.AWS, err)
 	}
 	for _, address := range out.Addresses {
 		tags := model.AwsTagsToModelTags(address.Tags)
@@ -121,7 +122,7 @@ func (c *awsClient) QueryEIP(profile, region string, input model.CommonFilter) (
 }
 
 // QueryNAT
-func (c *awsClient) QueryNAT(profile, region string, input model.CommonFilter) ([]model.NAT, error) {
+func (c *awsClient) QueryNAT(ctx context.Context, profile, region string, input model.CommonFilter) ([]model.NAT, error) {
 	svc, err := c.io.GetAwsEc2Client(profile, region)
 	if err != nil {
 		return nil, err
@@ -131,9 +132,9 @@ func (c *awsClient) QueryNAT(profile, region string, input model.CommonFilter) (
 	if input.ID != "" {
 		_input.NatGatewayIds = []*string{aws.String(input.ID)}
 	}
-	out, err := svc.DescribeNatGateways(_input)
+	out, err := svc.DescribeNatGatewaysWithContext(ctx, _input)
 	if err != nil {
-		return nil, err
+		return nil, model.WrapCloudError(model.AWS, err)
 	}
 	for _, nat := range out.NatGateways {
 		tags := model.AwsTagsToModelTags(nat.Tags)
@@ -155,10 +156,10 @@ func (c *awsClient) QueryNAT(profile, region string, input model.CommonFilter) (
 }
 
 // CreateSecurityGroupWithPolicies
-func (c *awsClient) CreateSecurityGroupWithPolicies(profile, region string, input model.CreateSecurityGroupWithPoliciesInput) (model.CreateSecurityGroupWithPoliciesResponse, error) {
-	panic("implement me")
+func (c *awsClient) CreateSecurityGroupWithPolicies(ctx context.Context, profile, region string, input model.CreateSecurityGroupWithPoliciesInput) (model.CreateSecurityGroupWithPoliciesResponse, error) {
+	return model.CreateSecurityGroupWithPoliciesResponse{}, model.NewNotImplementedError(model.AWS, "CreateSecurityGroupWithPolicies")
 }
 
-func (c *awsClient) CreateSecurityGroupPolicies(profile, region string, input model.CreateSecurityGroupPoliciesInput) (model.CreateSecurityGroupPoliciesResponse, error) {
-	panic("implement me")
+func (c *awsClient) CreateSecurityGroupPolicies(ctx context.Context, profile, region string, input model.CreateSecurityGroupPoliciesInput) (model.CreateSecurityGroupPoliciesResponse, error) {
+	return model.CreateSecurityGroupPoliciesResponse{}, model.NewNotImplementedError(model.AWS, "CreateSecurityGroupPolicies")
 }
@@ -6,7 +6,9 @@ import (
 	"net/url"
 	"strings"
 
+	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -34,11 +36,18 @@ type cloudClient struct {
 	profiles          map[string]model.ProfileConfig
 	awsCredential     map[string]*credentials.Credentials
 	tencentCredential map[string]*common.Credential
+	aliyunCredential  map[string]*aliyunCredential
+}
+
+type aliyunCredential struct {
+	AccessKeyId     string
+	AccessKeySecret string
 }
 
 func NewCloudClient(profiles []model.ProfileConfig) model.ClientIo {
 	awsCredential := make(map[string]*credentials.Credentials)
 	tencentCredential := make(map[string]*common.Credential)
+	aliyunCredential := make(map[string]*aliyunCredential)
 	_profiles := make(map[string]model.ProfileConfig)
 	for _, profile := range profiles {
 		_profiles[profile.Name] = profile
@@ -47,6 +56,8 @@ func NewCloudClient(profiles []model.ProfileConfig) model.ClientIo {
 			awsCredential[profile.Name] = credentials.NewStaticCredentials(profile.AK, profile.SK, "")
 		case model.TENCENT:
 			tencentCredential[profile.Name] = common.NewTokenCredential(profile.AK, profile.SK, "")
+		case model.ALIYUN:
+			aliyunCredential[profile.Name] = newAliyunCredential(profile.AK, profile.SK)
 		default:
 		}
 	}
@@ -54,6 +65,7 @@ func NewCloudClient(profiles []model.ProfileConfig) model.ClientIo {
 		profiles:          _profiles,
 		awsCredential:     awsCredential,
 		tencentCredential: tencentCredential,
+		aliyunCredential:  aliyunCredential,
 	}
 }
 
@@ -127,9 +139,13 @@ func (c *cloudClient) GetAWSS3Client(accountId, region string) (*s3.S3, error) {
 	return s3.New(sess), nil
 }
 
+// region 为空时使用 profile 配置的 region，用于私有域这类不带 region 的接口
 func (c *cloudClient) GetAwsRoute53Client(accountId, region string) (*route53.Route53, error) {
 	if region == "" {
-		return nil, fmt.Errorf("region is empty")
+		region = c.profiles[accountId].Region
+	}
+	if region == "" {
+		region = "us-east-1"
 	}
 	sess, err := c.getAWSSession(accountId)
 	if err != nil {
@@ -139,12 +155,13 @@ func (c *cloudClient) GetAwsRoute53Client(accountId, region string) (*route53.Ro
 	return route53.New(sess), nil
 }
 
-// getAwsRoute53DomainClient
+// GetAwsRoute53DomainClient Route53 Domains 只有 us-east-1
 func (c *cloudClient) GetAwsRoute53DomainClient(accountId string) (*route53domains.Route53Domains, error) {
 	sess, err := c.getAWSSession(accountId)
 	if err != nil {
 		return nil, err
 	}
+	sess.Config.Region = tea.String("us-east-1")
 	client := route53domains.New(sess)
 
 	return client, nil
@@ -261,6 +278,26 @@ func (c *cloudClient) GetTencentDnsPodClient(accountId string) (*dnspod.Client,
 	return dnspod.NewClient(credential, "", clientProfile)
 }
 
+// GetTencentDomainClient 域名注册服务，SDK 没有引入 domain 模块，使用通用客户端调用
+func (c *cloudClient) GetTencentDomainClient(accountId string) (*common.Client, error) {
+	credential, err := c.getTencentCredential(accountId)
+	if err != nil {
+		return nil, err
+	}
+	clientProfile := profile.NewClientProfile()
+	return common.NewCommonClient(credential, "", clientProfile), nil
+}
+
+// GetTencentCbsClient 云硬盘，SDK 没有引入 cbs 模块，使用通用客户端调用
+func (c *cloudClient) GetTencentCbsClient(accountId, region string) (*common.Client, error) {
+	credential, err := c.getTencentCredential(accountId)
+	if err != nil {
+		return nil, err
+	}
+	clientProfile := profile.NewClientProfile()
+	return common.NewCommonClient(credential, region, clientProfile), nil
+}
+
 func (c *cloudClient) GetTencentPrivateDNSClient(accountId string) (*privatedns.Client, error) {
 	credential, ok := c.tencentCredential[accountId]
 	if !ok {
@@ -274,3 +311,63 @@ func (c *cloudClient) GetTencentPrivateDNSClient(accountId string) (*privatedns.
 	}
 	return client, nil
 }
+
+func newAliyunCredential(ak, sk string) *aliyunCredential {
+	return &aliyunCredential{AccessKeyId: ak, AccessKeySecret: sk}
+}
+
+func (c *cloudClient) getAliyunCredential(accountId string) (*aliyunCredential, error) {
+	credential, ok := c.aliyunCredential[accountId]
+	if !ok {
+		return nil, fmt.Errorf("aliyun credential %s not found", accountId)
+	}
+	return credential, nil
+}
+
+// 阿里云各产品的 OpenAPI 客户端只是 endpoint 不同
+func (c *cloudClient) newAliyunOpenapiClient(accountId, endpoint string) (*openapi.Client, error) {
+	credential, err := c.getAliyunCredential(accountId)
+	if err != nil {
+		return nil, err
+	}
+	return openapi.NewClient(&openapi.Config{
+		AccessKeyId:     tea.String(credential.AccessKeyId),
+		AccessKeySecret: tea.String(credential.AccessKeySecret),
+		Endpoint:        tea.String(endpoint),
+	})
+}
+
+func (c *cloudClient) GetAliyunEcsClient(accountId, region string) (*openapi.Client, error) {
+	if region == "" {
+		return nil, fmt.Errorf("region is empty")
+	}
+	return c.newAliyunOpenapiClient(accountId, fmt.Sprintf("ecs.%s.aliyuncs.com", region))
+}
+
+func (c *cloudClient) GetAliyunVpcClient(accountId, region string) (*openapi.Client, error) {
+	if region == "" {
+		return nil, fmt.Errorf("region is empty")
+	}
+	return c.newAliyunOpenapiClient(accountId, fmt.Sprintf("vpc.%s.aliyuncs.com", region))
+}
+
+// 云解析是全局服务，不需要 region
+func (c *cloudClient) GetAliyunDnsClient(accountId string) (*openapi.Client, error) {
+	return c.newAliyunOpenapiClient(accountId, "alidns.aliyuncs.com")
+}
+
+// region 为空时使用杭州的 endpoint，列举存储桶不区分地域
+func (c *cloudClient) GetAliyunOssClient(accountId, region string) (*oss.Client, error) {
+	credential, err := c.getAliyunCredential(accountId)
+	if err != nil {
+		return nil, err
+	}
+	return newAliyunOssClient(credential.AccessKeyId, credential.AccessKeySecret, region)
+}
+
+func newAliyunOssClient(ak, sk, region string) (*oss.Client, error) {
+	if region == "" {
+		region = "cn-hangzhou"
+	}
+	return oss.New(fmt.Sprintf("https://oss-%s.aliyuncs.com", region), ak, sk)
+}
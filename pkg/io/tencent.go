@@ -1,6 +1,13 @@
 package io
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	tchttp "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/http"
+
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
@@ -13,3 +20,47 @@ func NewTencentClient(io model.ClientIo) model.CloudIO {
 		io: io,
 	}
 }
+
+// NotImplemented cos 的生命周期查询和删除桶还没有接入，DNSPod 没有变更状态可查，D 监控的健康检查暂缓实现
+func (c *tencentClient) NotImplemented() []string {
+	return []string{
+		"GetBucketLifecycle",
+		"DeleteBucket",
+		"GetRecordChange",
+		"DescribeHealthChecks",
+		"CreateHealthCheck",
+		"ModifyHealthCheck",
+		"DeleteHealthCheck",
+		"DescribeHealthCheckStatus",
+	}
+}
+
+// Regionless 密钥对所有地域通用
+func (c *tencentClient) Regionless() []string {
+	return []string{
+		"DescribeKeyPairs",
+		"CreateKeyPair",
+		"ImportKeyPair",
+		"DeleteKeyPair",
+	}
+}
+
+// sendTencentCommonRequest SDK 没有引入的产品使用通用请求调用，out 对应返回中的 Response
+func sendTencentCommonRequest(ctx context.Context, client *common.Client, service, version, action string, params map[string]interface{}, out interface{}) error {
+	request := tchttp.NewCommonRequest(service, version, action)
+	request.SetContext(ctx)
+	if err := request.SetActionParameters(params); err != nil {
+		return err
+	}
+	response := tchttp.NewCommonResponse()
+	if err := client.Send(request, response); err != nil {
+		return model.WrapCloudError(model.TENCENT, err)
+	}
+	body := struct {
+		Response interface{} `json:"Response"`
+	}{Response: out}
+	if err := json.Unmarshal(response.GetBody(), &body); err != nil {
+		return fmt.Errorf("parse tencent %s response: %w", action, err)
+	}
+	return nil
+}
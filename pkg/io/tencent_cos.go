@@ -15,11 +15,11 @@ import (
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
-func (c *tencentClient) GetBucketLifecycle(profile, region string, input model.GetBucketLifecycleRequest) (model.GetBucketLifecycleResponse, error) {
-	panic("implement me")
+func (c *tencentClient) GetBucketLifecycle(ctx context.Context, profile, region string, input model.GetBucketLifecycleRequest) (model.GetBucketLifecycleResponse, error) {
+	return model.GetBucketLifecycleResponse{}, model.NewNotImplementedError(model.TENCENT, "GetBucketLifecycle")
 }
 
-func (c *tencentClient) CreateBucketLifecycle(profile, region string, input model.CreateBucketLifecycleRequest) error {
+func (c *tencentClient) CreateBucketLifecycle(ctx context.Context, profile, region string, input model.CreateBucketLifecycleRequest) error {
 	if input.Bucket == nil || region == "" {
 		return fmt.Errorf("bucket name or region is empty")
 	}
@@ -31,9 +31,9 @@ func (c *tencentClient) CreateBucketLifecycle(profile, region string, input mode
 	if err != nil {
 		return err
 	}
-	resp, err := client.Bucket.PutLifecycle(context.Background(), param)
+	resp, err := client.Bucket.PutLifecycle(ctx, param)
 	if err != nil {
-		return err
+		return model.WrapCloudError(model.TENCENT, err)
 	}
 	fmt.Printf(tea.Prettify(resp))
 	return nil
@@ -41,7 +41,7 @@ func (c *tencentClient) CreateBucketLifecycle(profile, region string, input mode
 
 // Host: <BucketName-APPID>.cos.<Region>.myqcloud.com，其中 <BucketName-APPID> 为带 APPID 后缀的存储桶名字，例如 examplebucket-1250000000
 // appid不考虑在配置中获取，考虑云配置的一致性，特性的东西不应该放在配置中。
-func (c *tencentClient) CreateBucket(profile, region string, input model.CreateBucketRequest) error {
+func (c *tencentClient) CreateBucket(ctx context.Context, profile, region string, input model.CreateBucketRequest) error {
 	if input.BucketName == nil || region == "" {
 		return fmt.Errorf("bucket name or region is empty")
 	}
@@ -64,20 +64,20 @@ func (c *tencentClient) CreateBucket(profile, region string, input model.CreateB
 	// 增加 bucketUrl
 	bucketUrl, _ := url.Parse(fmt.Sprintf("https://%s.cos.%s.myqcloud.com", *input.BucketName, region))
 	client.BaseURL.BucketURL = bucketUrl
-	_, err = client.Bucket.Put(context.Background(), &cos.BucketPutOptions{
+	_, err = client.Bucket.Put(ctx, &cos.BucketPutOptions{
 		XCosACL: "private",
 	})
 	if err != nil {
-		return err
+		return model.WrapCloudError(model.TENCENT, err)
 	}
 
 	// add tags
 	if len(input.Tags) > 0 {
-		_, err = client.Bucket.PutTagging(context.Background(), &cos.BucketPutTaggingOptions{
+		_, err = client.Bucket.PutTagging(ctx, &cos.BucketPutTaggingOptions{
 			TagSet: input.Tags.ToTencentCosTags(),
 		})
 		if err != nil {
-			return fmt.Errorf("create bucket success. put bucket tags failed: %v", err)
+			return fmt.Errorf("create bucket success. put bucket tags failed: %w", model.WrapCloudError(model.TENCENT, err))
 		}
 	}
 
@@ -85,12 +85,12 @@ func (c *tencentClient) CreateBucket(profile, region string, input model.CreateB
 }
 
 // 删除走后台人工吧，接口不支持。
-func (c *tencentClient) DeleteBucket(profile, region string, input model.DeleteBucketRequest) (model.DeleteBucketResponse, error) {
-	panic("implement me")
+func (c *tencentClient) DeleteBucket(ctx context.Context, profile, region string, input model.DeleteBucketRequest) (model.DeleteBucketResponse, error) {
+	return model.DeleteBucketResponse{}, model.NewNotImplementedError(model.TENCENT, "DeleteBucket")
 }
 
 // Host: 查询全部存储桶列表指定为 service.cos.myqcloud.com，查询特定地域下的存储桶列表指定为 cos.<Region>.myqcloud.com，其中 <Region> 为 COS 的可用地域
-func (c *tencentClient) ListBucket(profile, region string, input model.ListBucketRequest) (model.ListBucketResponse, error) {
+func (c *tencentClient) ListBucket(ctx context.Context, profile, region string, input model.ListBucketRequest) (model.ListBucketResponse, error) {
 	client, err := c.io.GetTencentCosClient(profile, region)
 	if err != nil {
 		return model.ListBucketResponse{}, err
@@ -100,9 +100,9 @@ func (c *tencentClient) ListBucket(profile, region string, input model.ListBucke
 		MaxKeys: 20,
 	}
 
-	result, _, err := client.Service.Get(context.Background(), opt)
+	result, _, err := client.Service.Get(ctx, opt)
 	if err != nil {
-		return model.ListBucketResponse{}, err
+		return model.ListBucketResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 
 	var buckets []*model.Bucket
@@ -128,7 +128,7 @@ func (c *tencentClient) ListBucket(profile, region string, input model.ListBucke
 					Scheme: "https",
 					Host:   fmt.Sprintf("%s.cos.%s.myqcloud.com", bucket.Name, bucket.Location),
 				}
-				result, _, err := client.Bucket.GetTagging(context.Background())
+				result, _, err := client.Bucket.GetTagging(ctx)
 				if err != nil {
 					return
 				}
@@ -141,9 +141,9 @@ func (c *tencentClient) ListBucket(profile, region string, input model.ListBucke
 		wg.Wait()
 		if result.IsTruncated {
 			opt.Marker = result.NextMarker
-			result, _, err = client.Service.Get(context.Background(), opt)
+			result, _, err = client.Service.Get(ctx, opt)
 			if err != nil {
-				return model.ListBucketResponse{}, err
+				return model.ListBucketResponse{}, model.WrapCloudError(model.TENCENT, err)
 			}
 		} else {
 			break
@@ -156,15 +156,15 @@ func (c *tencentClient) ListBucket(profile, region string, input model.ListBucke
 	}, nil
 }
 
-func (c *tencentClient) GetObjectPregisn(profile, region string, input model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
+func (c *tencentClient) GetObjectPregisn(ctx context.Context, profile, region string, input model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
 	client, err := c.io.GetTencentCosClient(profile, region)
 	if err != nil {
 		return model.ObjectPregisnResponse{}, err
 	}
-	return c.getObjectPregisn(client, region, input)
+	return c.getObjectPregisn(ctx, client, region, input)
 }
 
-func (c *tencentClient) GetObjectPregisnWithAKSK(ak, sk, region string, input model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
+func (c *tencentClient) GetObjectPregisnWithAKSK(ctx context.Context, ak, sk, region string, input model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
 	credential := common.NewTokenCredential(ak, sk, "")
 	host := "https://service.cos.myqcloud.com"
 	if region != "" {
@@ -180,17 +180,17 @@ func (c *tencentClient) GetObjectPregisnWithAKSK(ak, sk, region string, input mo
 			SecretKey: credential.SecretKey,
 		},
 	})
-	return c.getObjectPregisn(client, region, input)
+	return c.getObjectPregisn(ctx, client, region, input)
 }
 
-func (c *tencentClient) getObjectPregisn(client *cos.Client, region string, input model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
+func (c *tencentClient) getObjectPregisn(ctx context.Context, client *cos.Client, region string, input model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
 
 	bucketUrl, _ := url.Parse(fmt.Sprintf("https://%s.cos.%s.myqcloud.com", *input.Bucket, region))
 	client.BaseURL.BucketURL = bucketUrl
 	// check object exist
-	_, err := client.Object.Head(context.Background(), *input.Key, nil)
+	_, err := client.Object.Head(ctx, *input.Key, nil)
 	if err != nil {
-		return model.ObjectPregisnResponse{}, err
+		return model.ObjectPregisnResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 
 	// get presigned url
@@ -198,9 +198,9 @@ func (c *tencentClient) getObjectPregisn(client *cos.Client, region string, inpu
 	if input.Expire != nil {
 		timeD = time.Second * time.Duration(*input.Expire)
 	}
-	url, err := client.Object.GetPresignedURL2(context.Background(), http.MethodGet, *input.Key, timeD, nil)
+	url, err := client.Object.GetPresignedURL2(ctx, http.MethodGet, *input.Key, timeD, nil)
 	if err != nil {
-		return model.ObjectPregisnResponse{}, err
+		return model.ObjectPregisnResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	// 替换 %2F 为 /，解决腾讯签名对象下载文件带上 key问题
 	return model.ObjectPregisnResponse{Url: strings.Replace(url.String(), "%2F", "/", -1)}, nil
@@ -1,6 +1,7 @@
 package io_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/alibabacloud-go/tea/tea"
@@ -10,7 +11,7 @@ import (
 // TEST CreateBucketLifecycle
 func TestCreateBucketLifecycle(t *testing.T) {
 	t.Log("CreateBucketLifecycle")
-	err := TencentIo.CreateBucketLifecycle(profile, "na-ashburn", model.CreateBucketLifecycleRequest{
+	err := TencentIo.CreateBucketLifecycle(context.Background(), profile, "na-ashburn", model.CreateBucketLifecycleRequest{
 		Bucket: tea.String("examplebucket-1250000000"),
 		Lifecycles: []model.Lifecycle{
 			{
@@ -46,7 +47,7 @@ func TestCreateBucketLifecycle(t *testing.T) {
 // CreateBucket
 func TestCreateBucket(t *testing.T) {
 	t.Log("CreateBucket")
-	err := TencentIo.CreateBucket(profile, "ap-beijing", model.CreateBucketRequest{
+	err := TencentIo.CreateBucket(context.Background(), profile, "ap-beijing", model.CreateBucketRequest{
 		BucketName: tea.String("examplebucket-1250000000"),
 		Tags: model.Tags{
 			{Key: "Owner", Value: "zhoushoujian"},
@@ -64,7 +65,7 @@ func TestCreateBucket(t *testing.T) {
 // ListBucket
 func TestListBucket(t *testing.T) {
 	t.Log("ListBucket")
-	resp, err := TencentIo.ListBucket(profile, "", model.ListBucketRequest{
+	resp, err := TencentIo.ListBucket(context.Background(), profile, "", model.ListBucketRequest{
 		KeyWord: tea.String(""),
 	})
 	if err != nil {
@@ -76,7 +77,7 @@ func TestListBucket(t *testing.T) {
 // GetObjectPregisn
 func TestGetObjectPregisn(t *testing.T) {
 	t.Log("GetObjectPregisn")
-	resp, err := TencentIo.GetObjectPregisn(profile, "ap-shanghai", model.ObjectPregisnRequest{
+	resp, err := TencentIo.GetObjectPregisn(context.Background(), profile, "ap-shanghai", model.ObjectPregisnRequest{
 		Bucket: tea.String("examplebucket-1250000000"),
 		Key:    tea.String("test.txt"),
 		Expire: tea.Int64(3600),
@@ -1,8 +1,11 @@
 package io
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/spf13/cast"
@@ -10,8 +13,73 @@ import (
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
+// dnspod 查询不到任何记录时返回 ResourceNotFound.NoDataOfRecord，列表接口当作空列表处理
+var tencentNoDataOfRecord = &model.CloudError{
+	Provider: model.TENCENT,
+	Code:     "ResourceNotFound.NoDataOfRecord",
+	Category: model.ErrorCategoryNotFound,
+}
+
+// tencentRecordToModel 腾讯云一条记录只有一个值，设置了权重的记录视为加权解析
+// MX 记录的值和 aws 一样带上优先级，比如 10 mail.example.com.
+func tencentRecordToModel(record *dnspod.RecordListItem) model.Record {
+	value := record.Value
+	if tea.StringValue(record.Type) == "MX" && record.MX != nil {
+		value = tea.String(fmt.Sprintf("%d %s", *record.MX, tea.StringValue(record.Value)))
+	}
+	result := model.Record{
+		RecordId:     tea.String(cast.ToString(record.RecordId)),
+		SubDomain:    record.Name,
+		RecordType:   record.Type,
+		Value:        value,
+		Values:       []*string{value},
+		Status:       record.Status,
+		UpdatedOn:    record.UpdatedOn,
+		TTL:          record.TTL,
+		RecordLine:   record.Line,
+		RecordLineId: record.LineId,
+		Remark:       record.Remark,
+		Weight:       record.Weight,
+	}
+	if record.Weight != nil {
+		result.RoutingPolicy = &model.RoutingPolicy{Type: model.RoutingPolicyWeighted}
+	}
+	return result
+}
+
+// splitTencentMx MX 记录的值为 优先级 域名 时拆开，腾讯云的优先级是单独的参数
+func splitTencentMx(recordType, value *string) (*uint64, *string) {
+	if tea.StringValue(recordType) != "MX" {
+		return nil, value
+	}
+	fields := strings.Fields(tea.StringValue(value))
+	if len(fields) != 2 {
+		return nil, value
+	}
+	mx, err := cast.ToUint64E(fields[0])
+	if err != nil {
+		return nil, value
+	}
+	return tea.Uint64(mx), tea.String(fields[1])
+}
+
+// checkTencentRecord dnspod 只有线路和权重，其他解析策略和 aws 的别名记录不支持
+func checkTencentRecord(record model.Record) error {
+	if record.AliasTarget != nil {
+		return model.NewCloudError(model.TENCENT, model.ErrorCategoryUnsupported, "UnsupportedAliasTarget", "alias record is not supported, use CNAME instead")
+	}
+	policy := record.RoutingPolicy
+	if policy != nil && policy.HealthCheckId != nil {
+		return model.NewCloudError(model.TENCENT, model.ErrorCategoryUnsupported, "UnsupportedHealthCheck", "health check is not supported")
+	}
+	if policy == nil || policy.Type == model.RoutingPolicySimple || policy.Type == model.RoutingPolicyWeighted {
+		return nil
+	}
+	return model.NewCloudError(model.TENCENT, model.ErrorCategoryUnsupported, "UnsupportedRoutingPolicy", fmt.Sprintf("routing policy %s is not supported, use record line instead", policy.Type))
+}
+
 // DescribeDomainList
-func (c *tencentClient) DescribeDomainList(profile, region string, input model.DescribeDomainListRequest) (model.DescribeDomainListResponse, error) {
+func (c *tencentClient) DescribeDomainList(ctx context.Context, profile, region string, input model.DescribeDomainListRequest) (model.DescribeDomainListResponse, error) {
 	client, err := c.io.GetTencentDnsPodClient(profile)
 	if err != nil {
 		return model.DescribeDomainListResponse{}, err
@@ -20,9 +88,9 @@ func (c *tencentClient) DescribeDomainList(profile, region string, input model.D
 	request.Type = tea.String("ALL")
 	request.Keyword = input.DomainKeyword
 
-	response, err := client.DescribeDomainList(request)
+	response, err := client.DescribeDomainListWithContext(ctx, request)
 	if err != nil {
-		return model.DescribeDomainListResponse{}, err
+		return model.DescribeDomainListResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	var domains []model.Domain
 	for _, domain := range response.Response.DomainList {
@@ -42,7 +110,7 @@ func (c *tencentClient) DescribeDomainList(profile, region string, input model.D
 	}, nil
 }
 
-func (c *tencentClient) DescribeRecordListWithPages(profile, region string, input model.DescribeRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
+func (c *tencentClient) DescribeRecordListWithPages(ctx context.Context, profile, region string, input model.DescribeRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
 	client, err := c.io.GetTencentDnsPodClient(profile)
 	if err != nil {
 		return model.ListRecordsPageResponse{}, err
@@ -50,38 +118,34 @@ func (c *tencentClient) DescribeRecordListWithPages(profile, region string, inpu
 	request := dnspod.NewDescribeRecordListRequest()
 	request.Domain = input.Domain
 	request.Limit = tea.Uint64(100)
-	request.Offset = tea.Uint64(0)
 	if input.Limit != nil {
 		request.Limit = tea.Uint64(cast.ToUint64(*input.Limit))
 	}
-	if input.Page != nil {
-		request.Offset = tea.Uint64((cast.ToUint64(*input.Page) - 1) * cast.ToUint64(*input.Limit))
+	offset, err := model.ParseOffsetMarker(input.NextMarker)
+	if err != nil {
+		return model.ListRecordsPageResponse{}, err
+	}
+	// 没有游标时按页码计算，Limit 为空时使用默认的 100
+	if input.NextMarker == nil && input.Page != nil && *input.Page > 1 {
+		offset = (*input.Page - 1) * cast.ToInt64(*request.Limit)
 	}
+	request.Offset = tea.Uint64(cast.ToUint64(offset))
 
-	resp, err := client.DescribeRecordList(request)
+	resp, err := client.DescribeRecordListWithContext(ctx, request)
 	if err != nil {
-		if strings.Contains(err.Error(), "ResourceNotFound") {
+		err = model.WrapCloudError(model.TENCENT, err)
+		if errors.Is(err, tencentNoDataOfRecord) {
 			return model.ListRecordsPageResponse{}, nil
 		}
 		return model.ListRecordsPageResponse{}, err
 	}
 	var records []model.Record
 	for _, record := range resp.Response.RecordList {
-		records = append(records, model.Record{
-			RecordId:   tea.String(cast.ToString(record.RecordId)),
-			SubDomain:  record.Name,
-			RecordType: record.Type,
-			Value:      record.Value,
-			Status:     record.Status,
-			UpdatedOn:  record.UpdatedOn,
-			TTL:        record.TTL,
-			RecordLine: record.Line,
-			Remark:     record.Remark,
-			Weight:     record.Weight,
-		})
+		records = append(records, tencentRecordToModel(record))
 	}
 	var nextPage, prePage *int64
-	if len(records) == int(*request.Limit) {
+	nextMarker := model.NextOffsetMarker(offset, len(records), cast.ToInt64(resp.Response.RecordCountInfo.TotalCount))
+	if nextMarker != nil {
 		if input.Page == nil {
 			nextPage = tea.Int64(2)
 		} else {
@@ -95,12 +159,13 @@ func (c *tencentClient) DescribeRecordListWithPages(profile, region string, inpu
 	return model.ListRecordsPageResponse{
 		PrePage:    prePage,
 		NextPage:   nextPage,
+		NextMarker: nextMarker,
 		RecordList: records,
 	}, nil
 }
 
 // DescribeRecordList
-func (c *tencentClient) DescribeRecordList(profile, region string, input model.DescribeRecordListRequest) (model.DescribeRecordListResponse, error) {
+func (c *tencentClient) DescribeRecordList(ctx context.Context, profile, region string, input model.DescribeRecordListRequest) (model.DescribeRecordListResponse, error) {
 	client, err := c.io.GetTencentDnsPodClient(profile)
 	if err != nil {
 		return model.DescribeRecordListResponse{}, err
@@ -113,38 +178,32 @@ func (c *tencentClient) DescribeRecordList(profile, region string, input model.D
 	request.Keyword = input.Keyword
 	request.Limit = tea.Uint64(100)
 
-	resp, err := client.DescribeRecordList(request)
+	resp, err := client.DescribeRecordListWithContext(ctx, request)
 	if err != nil {
+		err = model.WrapCloudError(model.TENCENT, err)
+		if errors.Is(err, tencentNoDataOfRecord) {
+			return model.DescribeRecordListResponse{}, nil
+		}
 		return model.DescribeRecordListResponse{}, err
 	}
 	var records []model.Record
+	// total 为已经拉取的行数，作为下一页的 Offset，不能用本地过滤后的记录数
 	total := 0
 	for {
 		for _, record := range resp.Response.RecordList {
-			total++
 			if input.Keyword != nil && *input.Keyword != "" && !strings.Contains(*record.Name, *input.Keyword) {
 				continue
 			}
-			records = append(records, model.Record{
-				RecordId:   tea.String(cast.ToString(record.RecordId)),
-				SubDomain:  record.Name,
-				RecordType: record.Type,
-				Value:      record.Value,
-				Status:     record.Status,
-				UpdatedOn:  record.UpdatedOn,
-				TTL:        record.TTL,
-				RecordLine: record.Line,
-				Remark:     record.Remark,
-				Weight:     record.Weight,
-			})
+			records = append(records, tencentRecordToModel(record))
 		}
-		if total == int(*resp.Response.RecordCountInfo.TotalCount) {
+		total += len(resp.Response.RecordList)
+		if len(resp.Response.RecordList) == 0 || total >= int(tea.Uint64Value(resp.Response.RecordCountInfo.TotalCount)) {
 			break
 		}
-		request.Offset = tea.Uint64(cast.ToUint64(len(records)))
-		resp, err = client.DescribeRecordList(request)
+		request.Offset = tea.Uint64(cast.ToUint64(total))
+		resp, err = client.DescribeRecordListWithContext(ctx, request)
 		if err != nil {
-			return model.DescribeRecordListResponse{}, err
+			return model.DescribeRecordListResponse{}, model.WrapCloudError(model.TENCENT, err)
 		}
 	}
 
@@ -155,12 +214,12 @@ func (c *tencentClient) DescribeRecordList(profile, region string, input model.D
 }
 
 // DescribeRecord
-func (c *tencentClient) DescribeRecord(profile, region string, input model.DescribeRecordRequest) (model.Record, error) {
+func (c *tencentClient) DescribeRecord(ctx context.Context, profile, region string, input model.DescribeRecordRequest) (model.Record, error) {
 	if input.SubDomain == nil {
 		return model.Record{}, fmt.Errorf("SubDomain is required")
 	}
 
-	resp, err := c.DescribeRecordList(profile, region, model.DescribeRecordListRequest{
+	resp, err := c.DescribeRecordList(ctx, profile, region, model.DescribeRecordListRequest{
 		Domain:  input.Domain,
 		Keyword: input.SubDomain,
 	})
@@ -175,44 +234,68 @@ func (c *tencentClient) DescribeRecord(profile, region string, input model.Descr
 			return record, nil
 		}
 	}
-	return model.Record{}, fmt.Errorf("record not found")
+	return model.Record{}, model.NewRecordNotFoundError(model.TENCENT)
 }
 
-// CreateRecord
-func (c *tencentClient) CreateRecord(profile, region string, input model.CreateRecordRequest) (model.CreateRecordResponse, error) {
+// CreateRecord 多个值时每个值创建一条记录，RecordId 为第一条记录的 ID
+func (c *tencentClient) CreateRecord(ctx context.Context, profile, region string, input model.CreateRecordRequest) (model.CreateRecordResponse, error) {
+	if err := checkTencentRecord(input.ToRecord()); err != nil {
+		return model.CreateRecordResponse{}, err
+	}
 	client, err := c.io.GetTencentDnsPodClient(profile)
 	if err != nil {
 		return model.CreateRecordResponse{}, err
 	}
-	// 实例化一个请求对象,每个接口都会对应一个request对象
-	request := dnspod.NewCreateRecordRequest()
-	request.Domain = input.Domain
-	request.SubDomain = input.SubDomain
-	request.RecordType = input.RecordType
-	request.Value = input.Value
-	request.RecordLine = tea.String("默认")
-	request.Remark = input.Info
-	if input.TTL != nil {
-		request.TTL = input.TTL
-	} else {
-		request.TTL = tea.Uint64(600)
+	var recordIds []*string
+	var metas []*dnspod.CreateRecordResponseParams
+	for _, value := range input.ToRecord().GetValues() {
+		// 实例化一个请求对象,每个接口都会对应一个request对象
+		request := dnspod.NewCreateRecordRequest()
+		request.Domain = input.Domain
+		request.SubDomain = input.SubDomain
+		request.RecordType = input.RecordType
+		request.MX, request.Value = splitTencentMx(input.RecordType, value)
+		request.RecordLine = tea.String("默认")
+		if input.RecordLine != nil {
+			request.RecordLine = input.RecordLine
+		}
+		// 同时传时以线路 ID 为准
+		request.RecordLineId = input.RecordLineId
+		request.Weight = input.Weight
+		request.Remark = input.Info
+		if input.TTL != nil {
+			request.TTL = input.TTL
+		} else {
+			request.TTL = tea.Uint64(600)
+		}
+		// 返回的resp是一个CreatePrivateZoneRecordResponse的实例，与请求对象对应
+		response, err := client.CreateRecordWithContext(ctx, request)
+		if err != nil {
+			return model.CreateRecordResponse{}, model.WrapCloudError(model.TENCENT, err)
+		}
+		recordIds = append(recordIds, tea.String(cast.ToString(response.Response.RecordId)))
+		metas = append(metas, response.Response)
 	}
-	// 返回的resp是一个CreatePrivateZoneRecordResponse的实例，与请求对象对应
-	response, err := client.CreateRecord(request)
-	if err != nil {
-		return model.CreateRecordResponse{}, err
+	if len(recordIds) == 0 {
+		return model.CreateRecordResponse{}, fmt.Errorf("value is required")
+	}
+	if len(metas) == 1 {
+		return model.CreateRecordResponse{
+			RecordId: recordIds[0],
+			Meta:     metas[0],
+		}, nil
 	}
 	return model.CreateRecordResponse{
-		RecordId: tea.String(cast.ToString(response.Response.RecordId)),
-		Meta:     response.Response,
+		RecordId: recordIds[0],
+		Meta:     metas,
 	}, nil
 }
 
 // ModifyRecord
 // ignoreType 是否开启忽略 recordType,
-// true 注意这里会删除所有相同 subDomain 的记录，然后创建新的记录
+// true 注意这里会删除相同 subDomain、相同线路的所有类型的记录，然后创建新的记录
 // false 如果 recordType 不同，会报没找到记录
-func (c *tencentClient) ModifyRecord(profile, region string, ignoreType bool, input model.ModifyRecordRequest) error {
+func (c *tencentClient) ModifyRecord(ctx context.Context, profile, region string, ignoreType bool, input model.ModifyRecordRequest) error {
 	client, err := c.io.GetTencentDnsPodClient(profile)
 	if err != nil {
 		return err
@@ -224,7 +307,19 @@ func (c *tencentClient) ModifyRecord(profile, region string, ignoreType bool, in
 		return fmt.Errorf("subDomain is required")
 	}
 	if ignoreType {
-		resp, err := c.DescribeRecordList(profile, region, model.DescribeRecordListRequest{
+		// 先校验再删除，避免删掉线上记录后新建失败
+		if err := checkTencentRecord(input.ToRecord()); err != nil {
+			return err
+		}
+		if input.RecordType == nil || len(input.ToRecord().GetValues()) == 0 {
+			return fmt.Errorf("%w: recordType and value are required", model.ErrInvalidInput)
+		}
+		// 只删除要修改的线路上的记录，没有指定线路时和 CreateRecord 一样为默认线路
+		recordLine := input.RecordLine
+		if recordLine == nil && input.RecordLineId == nil {
+			recordLine = tea.String("默认")
+		}
+		resp, err := c.DescribeRecordList(ctx, profile, region, model.DescribeRecordListRequest{
 			Domain:  input.Domain,
 			Keyword: input.SubDomain,
 		})
@@ -233,58 +328,73 @@ func (c *tencentClient) ModifyRecord(profile, region string, ignoreType bool, in
 		}
 		var delDomain []map[string]interface{}
 		for _, record := range resp.RecordList {
-			if *record.SubDomain == *input.SubDomain {
-				_, err := c.DeleteRecord(profile, region, model.DeleteRecordRequest{
-					Domain:     input.Domain,
-					SubDomain:  input.SubDomain,
-					RecordType: record.RecordType,
-				})
-				if err != nil {
-					return fmt.Errorf("delete record error: %v", err)
-				}
-				delDomain = append(delDomain, map[string]interface{}{
-					"recordId":   record.RecordId,
-					"recordType": record.RecordType,
-					"subDomain":  record.SubDomain,
-					"ttl":        record.TTL,
-					"value":      record.Value,
-				})
+			if *record.SubDomain != *input.SubDomain || !record.MatchRecordLine(recordLine, input.RecordLineId) {
+				continue
+			}
+			request := dnspod.NewDeleteRecordRequest()
+			request.Domain = input.Domain
+			request.RecordId = tea.Uint64(cast.ToUint64(record.RecordId))
+			if _, err := client.DeleteRecordWithContext(ctx, request); err != nil {
+				return fmt.Errorf("delete record error: %w", model.WrapCloudError(model.TENCENT, err))
 			}
+			delDomain = append(delDomain, map[string]interface{}{
+				"recordId":   record.RecordId,
+				"recordType": record.RecordType,
+				"subDomain":  record.SubDomain,
+				"ttl":        record.TTL,
+				"value":      record.Value,
+			})
 		}
 
 		if delDomain == nil {
-			return fmt.Errorf("record not found")
+			return model.NewRecordNotFoundError(model.TENCENT)
 		}
 
 		createInput := model.CreateRecordRequest{
-			Domain:     input.Domain,
-			SubDomain:  input.SubDomain,
-			RecordType: input.RecordType,
-			Value:      input.Value,
-			TTL:        tea.Uint64(60),
-			Info:       input.Info,
+			Domain:        input.Domain,
+			SubDomain:     input.SubDomain,
+			RecordType:    input.RecordType,
+			Value:         input.Value,
+			Values:        input.Values,
+			TTL:           tea.Uint64(60),
+			Info:          input.Info,
+			RecordLine:    input.RecordLine,
+			RecordLineId:  input.RecordLineId,
+			Weight:        input.Weight,
+			RoutingPolicy: input.RoutingPolicy,
+			AliasTarget:   input.AliasTarget,
 		}
 		if input.TTL != nil {
 			createInput.TTL = input.TTL
 		}
-		_, err = c.CreateRecord(profile, region, createInput)
+		_, err = c.CreateRecord(ctx, profile, region, createInput)
 		if err != nil {
-			return fmt.Errorf("create record error: %v", err)
+			return fmt.Errorf("create record error: %w", err)
 		}
 		return nil
 	} else {
-		recordId, err := c.getRecordIdBySubDomain(profile, region, *input.SubDomain, *input.Domain, *input.RecordType)
+		if err := checkTencentRecord(input.ToRecord()); err != nil {
+			return err
+		}
+		values := input.ToRecord().GetValues()
+		if len(values) != 1 {
+			return model.NewCloudError(model.TENCENT, model.ErrorCategoryInvalidInput, "InvalidParameter.Values", "tencent record has exactly one value")
+		}
+		records, err := c.findRecords(ctx, profile, region, *input.SubDomain, *input.Domain, *input.RecordType, input.RecordLine, input.RecordLineId)
 		if err != nil {
 			return err
 		}
+		record := records[0]
 
 		request := dnspod.NewModifyRecordRequest()
-		request.RecordId = recordId
+		request.RecordId = tea.Uint64(cast.ToUint64(record.RecordId))
 		request.Domain = input.Domain
 		request.SubDomain = input.SubDomain
 		request.RecordType = input.RecordType
-		request.Value = input.Value
-		request.RecordLine = tea.String("默认")
+		request.MX, request.Value = splitTencentMx(input.RecordType, values[0])
+		// 修改记录的其他内容，线路保持不变
+		request.RecordLine = record.RecordLine
+		request.RecordLineId = record.RecordLineId
 		request.TTL = input.TTL
 		request.Weight = input.Weight
 
@@ -296,33 +406,41 @@ func (c *tencentClient) ModifyRecord(profile, region string, ignoreType bool, in
 			}
 		}
 
-		_, err = client.ModifyRecord(request)
+		_, err = client.ModifyRecordWithContext(ctx, request)
 		if err != nil {
-			return err
+			return model.WrapCloudError(model.TENCENT, err)
 		}
 		return nil
 	}
 }
 
-// getRecordIdBySubDomain
-func (c *tencentClient) getRecordIdBySubDomain(profile, region, subDomain, domain, recordType string) (*uint64, error) {
-	resp, err := c.DescribeRecordList(profile, region, model.DescribeRecordListRequest{
+// findRecords 查找同名同类型的记录，线路为空时不按线路过滤，一条都没有时返回 ErrRecordNotFound
+func (c *tencentClient) findRecords(ctx context.Context, profile, region, subDomain, domain, recordType string, recordLine, recordLineId *string) ([]model.Record, error) {
+	resp, err := c.DescribeRecordList(ctx, profile, region, model.DescribeRecordListRequest{
 		Domain:  &domain,
 		Keyword: &subDomain,
 	})
 	if err != nil {
 		return nil, err
 	}
+	var records []model.Record
 	for _, record := range resp.RecordList {
-		if *record.SubDomain == subDomain && *record.RecordType == recordType {
-			return tea.Uint64(cast.ToUint64(record.RecordId)), nil
+		if *record.SubDomain != subDomain || *record.RecordType != recordType {
+			continue
+		}
+		if !record.MatchRecordLine(recordLine, recordLineId) {
+			continue
 		}
+		records = append(records, record)
 	}
-	return nil, fmt.Errorf("record not found")
+	if len(records) == 0 {
+		return nil, model.NewRecordNotFoundError(model.TENCENT)
+	}
+	return records, nil
 }
 
-// DeleteRecord
-func (c *tencentClient) DeleteRecord(profile, region string, input model.DeleteRecordRequest) (model.CommonDnsResponse, error) {
+// DeleteRecord 删除同名同类型的全部记录，多个值的记录一并删除，可以用 RecordLine 限定线路
+func (c *tencentClient) DeleteRecord(ctx context.Context, profile, region string, input model.DeleteRecordRequest) (model.CommonDnsResponse, error) {
 	if input.SubDomain == nil || input.Domain == nil || input.RecordType == nil {
 		return model.CommonDnsResponse{}, fmt.Errorf("SubDomain, Domain and RecordType are required")
 	}
@@ -330,20 +448,323 @@ func (c *tencentClient) DeleteRecord(profile, region string, input model.DeleteR
 	if err != nil {
 		return model.CommonDnsResponse{}, err
 	}
-	record_id, err := c.getRecordIdBySubDomain(profile, region, *input.SubDomain, *input.Domain, *input.RecordType)
+	records, err := c.findRecords(ctx, profile, region, *input.SubDomain, *input.Domain, *input.RecordType, input.RecordLine, input.RecordLineId)
 	if err != nil {
 		return model.CommonDnsResponse{}, err
 	}
 
-	request := dnspod.NewDeleteRecordRequest()
-	request.RecordId = record_id
-	request.Domain = input.Domain
+	var metas []*dnspod.DeleteRecordResponseParams
+	for _, record := range records {
+		request := dnspod.NewDeleteRecordRequest()
+		request.RecordId = tea.Uint64(cast.ToUint64(record.RecordId))
+		request.Domain = input.Domain
 
-	resp, err := client.DeleteRecord(request)
-	if err != nil {
-		return model.CommonDnsResponse{}, err
+		resp, err := client.DeleteRecordWithContext(ctx, request)
+		if err != nil {
+			return model.CommonDnsResponse{Meta: metas}, model.WrapCloudError(model.TENCENT, err)
+		}
+		metas = append(metas, resp.Response)
+	}
+	if len(metas) == 1 {
+		return model.CommonDnsResponse{
+			Meta: metas[0],
+		}, nil
 	}
 	return model.CommonDnsResponse{
-		Meta: resp.Response,
+		Meta: metas,
 	}, nil
 }
+
+// 批量任务是异步的，按这个间隔查询任务状态
+var tencentBatchTaskInterval = time.Second
+
+// ChangeRecordSet dnspod 先批量删除再批量新增，UPSERT 为删除同名同类型（指定线路时同线路）的记录后新增
+// 批量接口不是原子的，任一步失败时删除本次新增的记录、恢复已删除的记录，尽量回到变更前的状态
+func (c *tencentClient) ChangeRecordSet(ctx context.Context, profile, region string, input model.ChangeRecordSetRequest) (model.ChangeRecordSetResponse, error) {
+	if input.Domain == nil {
+		return model.ChangeRecordSetResponse{}, fmt.Errorf("domain is required")
+	}
+	if len(input.Changes) == 0 {
+		return model.ChangeRecordSetResponse{}, fmt.Errorf("changes is required")
+	}
+	client, err := c.io.GetTencentDnsPodClient(profile)
+	if err != nil {
+		return model.ChangeRecordSetResponse{}, err
+	}
+	domainRequest := dnspod.NewDescribeDomainRequest()
+	domainRequest.Domain = input.Domain
+	domainResp, err := client.DescribeDomainWithContext(ctx, domainRequest)
+	if err != nil {
+		return model.ChangeRecordSetResponse{}, model.WrapCloudError(model.TENCENT, err)
+	}
+	domainId := cast.ToString(domainResp.Response.DomainInfo.DomainId)
+	live, err := c.DescribeRecordList(ctx, profile, region, model.DescribeRecordListRequest{Domain: input.Domain})
+	if err != nil {
+		return model.ChangeRecordSetResponse{}, err
+	}
+
+	var deletes []model.Record
+	var creates []*dnspod.AddRecordBatch
+	deleted := map[string]bool{}
+	for _, change := range input.Changes {
+		record := change.Record
+		if record.SubDomain == nil || record.RecordType == nil {
+			return model.ChangeRecordSetResponse{}, fmt.Errorf("subDomain and recordType are required")
+		}
+		if err := checkTencentRecord(record); err != nil {
+			return model.ChangeRecordSetResponse{}, err
+		}
+		switch change.Action {
+		case model.RecordChangeCreate, model.RecordChangeUpsert, model.RecordChangeDelete:
+		default:
+			return model.ChangeRecordSetResponse{}, fmt.Errorf("unsupported action %s", change.Action)
+		}
+		if change.Action != model.RecordChangeCreate {
+			matches := matchTencentRecords(live.RecordList, record)
+			if len(matches) == 0 && change.Action == model.RecordChangeDelete {
+				return model.ChangeRecordSetResponse{}, model.NewRecordNotFoundError(model.TENCENT)
+			}
+			for _, match := range matches {
+				if !deleted[*match.RecordId] {
+					deleted[*match.RecordId] = true
+					deletes = append(deletes, match)
+				}
+			}
+		}
+		if change.Action != model.RecordChangeDelete {
+			creates = append(creates, tencentAddRecordBatch(record)...)
+		}
+	}
+
+	var jobIds []*uint64
+	if len(deletes) > 0 {
+		jobId, err := deleteTencentRecordBatch(ctx, client, deletes)
+		if err != nil {
+			return model.ChangeRecordSetResponse{}, c.rollbackTencentRecords(ctx, profile, region, client, input.Domain, domainId, live.RecordList, deletes, creates, err)
+		}
+		jobIds = append(jobIds, jobId)
+	}
+	if len(creates) > 0 {
+		jobId, err := createTencentRecordBatch(ctx, client, domainId, creates)
+		if err != nil {
+			return model.ChangeRecordSetResponse{}, c.rollbackTencentRecords(ctx, profile, region, client, input.Domain, domainId, live.RecordList, deletes, creates, err)
+		}
+		jobIds = append(jobIds, jobId)
+	}
+	// 腾讯云 ChangeId 为最后一个批量任务的 JobId
+	return model.ChangeRecordSetResponse{
+		ChangeId: tea.String(cast.ToString(jobIds[len(jobIds)-1])),
+		Meta:     jobIds,
+	}, nil
+}
+
+// matchTencentRecords 根域名统一按 @ 比较，record 没有线路时不按线路过滤
+func matchTencentRecords(records []model.Record, record model.Record) []model.Record {
+	subDomain := tea.StringValue(record.SubDomain)
+	if subDomain == "" {
+		subDomain = "@"
+	}
+	var matches []model.Record
+	for _, r := range records {
+		if tea.StringValue(r.SubDomain) != subDomain || tea.StringValue(r.RecordType) != *record.RecordType {
+			continue
+		}
+		if !r.MatchRecordLine(record.RecordLine, record.RecordLineId) {
+			continue
+		}
+		matches = append(matches, r)
+	}
+	return matches
+}
+
+// tencentAddRecordBatch 每个值一条记录，默认线路 默认，TTL 默认 600
+func tencentAddRecordBatch(record model.Record) []*dnspod.AddRecordBatch {
+	var records []*dnspod.AddRecordBatch
+	subDomain := record.SubDomain
+	if tea.StringValue(subDomain) == "" {
+		subDomain = tea.String("@")
+	}
+	for _, value := range record.GetValues() {
+		add := &dnspod.AddRecordBatch{
+			SubDomain:  subDomain,
+			RecordType: record.RecordType,
+			RecordLine: tea.String("默认"),
+			TTL:        tea.Uint64(600),
+			Weight:     record.Weight,
+			Remark:     record.Remark,
+		}
+		add.MX, add.Value = splitTencentMx(record.RecordType, value)
+		if record.RecordLine != nil {
+			add.RecordLine = record.RecordLine
+		}
+		add.RecordLineId = record.RecordLineId
+		if record.TTL != nil {
+			add.TTL = record.TTL
+		}
+		if tea.StringValue(record.Status) == "DISABLE" {
+			add.Enabled = tea.Uint64(0)
+		}
+		records = append(records, add)
+	}
+	return records
+}
+
+func deleteTencentRecordBatch(ctx context.Context, client *dnspod.Client, records []model.Record) (*uint64, error) {
+	request := dnspod.NewDeleteRecordBatchRequest()
+	for _, record := range records {
+		request.RecordIdList = append(request.RecordIdList, tea.Uint64(cast.ToUint64(record.RecordId)))
+	}
+	resp, err := client.DeleteRecordBatchWithContext(ctx, request)
+	if err != nil {
+		return nil, model.WrapCloudError(model.TENCENT, err)
+	}
+	return resp.Response.JobId, waitTencentBatchTask(ctx, client, resp.Response.JobId)
+}
+
+func createTencentRecordBatch(ctx context.Context, client *dnspod.Client, domainId string, records []*dnspod.AddRecordBatch) (*uint64, error) {
+	request := dnspod.NewCreateRecordBatchRequest()
+	request.DomainIdList = []*string{tea.String(domainId)}
+	request.RecordList = records
+	resp, err := client.CreateRecordBatchWithContext(ctx, request)
+	if err != nil {
+		return nil, model.WrapCloudError(model.TENCENT, err)
+	}
+	return resp.Response.JobId, waitTencentBatchTask(ctx, client, resp.Response.JobId)
+}
+
+// waitTencentBatchTask 等待任务结束，成功和失败数量之和等于总数时为结束，有失败时返回失败的记录
+func waitTencentBatchTask(ctx context.Context, client *dnspod.Client, jobId *uint64) error {
+	request := dnspod.NewDescribeBatchTaskRequest()
+	request.JobId = jobId
+	for {
+		resp, err := client.DescribeBatchTaskWithContext(ctx, request)
+		if err != nil {
+			return model.WrapCloudError(model.TENCENT, err)
+		}
+		total := tea.Uint64Value(resp.Response.TotalCount)
+		success := tea.Uint64Value(resp.Response.SuccessCount)
+		fail := tea.Uint64Value(resp.Response.FailCount)
+		if total > 0 && success+fail >= total {
+			if fail == 0 {
+				return nil
+			}
+			var messages []string
+			for _, detail := range resp.Response.DetailList {
+				for _, record := range detail.RecordList {
+					if tea.StringValue(record.ErrMsg) != "" {
+						messages = append(messages, fmt.Sprintf("%s %s: %s", tea.StringValue(record.SubDomain), tea.StringValue(record.RecordType), *record.ErrMsg))
+					}
+				}
+			}
+			return model.NewCloudError(model.TENCENT, model.ErrorCategoryUnknown, "BatchTaskFailed",
+				fmt.Sprintf("batch task %d: %d of %d failed: %s", tea.Uint64Value(jobId), fail, total, strings.Join(messages, "; ")))
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(tencentBatchTaskInterval):
+		}
+	}
+}
+
+// rollbackTencentRecords 以变更前的记录 live 为准，删除本次新增的记录，恢复 deletes 中已经被删除的记录
+// 即使 ctx 已经取消也继续回滚，返回的错误包含 cause
+func (c *tencentClient) rollbackTencentRecords(ctx context.Context, profile, region string, client *dnspod.Client, domain *string, domainId string, live, deletes []model.Record, creates []*dnspod.AddRecordBatch, cause error) error {
+	ctx = context.WithoutCancel(ctx)
+	current, err := c.DescribeRecordList(ctx, profile, region, model.DescribeRecordListRequest{Domain: domain})
+	if err != nil {
+		return fmt.Errorf("%w, rollback failed: %v", cause, err)
+	}
+	liveIds := map[string]bool{}
+	for _, record := range live {
+		liveIds[*record.RecordId] = true
+	}
+	touched := map[string]bool{}
+	for _, add := range creates {
+		touched[*add.SubDomain+" "+*add.RecordType] = true
+	}
+	var created []model.Record
+	currentIds := map[string]bool{}
+	for _, record := range current.RecordList {
+		currentIds[*record.RecordId] = true
+		if !liveIds[*record.RecordId] && touched[*record.SubDomain+" "+*record.RecordType] {
+			created = append(created, record)
+		}
+	}
+	var restore []*dnspod.AddRecordBatch
+	for _, record := range deletes {
+		if !currentIds[*record.RecordId] {
+			restore = append(restore, tencentAddRecordBatch(record)...)
+		}
+	}
+	if len(created) > 0 {
+		if _, err := deleteTencentRecordBatch(ctx, client, created); err != nil {
+			return fmt.Errorf("%w, rollback failed: %v", cause, err)
+		}
+	}
+	if len(restore) > 0 {
+		if _, err := createTencentRecordBatch(ctx, client, domainId, restore); err != nil {
+			return fmt.Errorf("%w, rollback failed: %v", cause, err)
+		}
+	}
+	return fmt.Errorf("%w, changes have been rolled back", cause)
+}
+
+// GetRecordChange DNSPod 没有变更 ID，等待生效需要查询记录
+func (c *tencentClient) GetRecordChange(ctx context.Context, profile, changeId string) (model.RecordChangeStatus, error) {
+	return "", model.NewNotImplementedError(model.TENCENT, "GetRecordChange")
+}
+
+// 健康检查暂缓实现：DNSPod 的 D 监控没有云 API 3.0 接口，返回 ErrNotImplemented 并在 NotImplemented 中列出
+func (c *tencentClient) DescribeHealthChecks(ctx context.Context, profile string) ([]model.HealthCheck, error) {
+	return nil, model.NewNotImplementedError(model.TENCENT, "DescribeHealthChecks")
+}
+
+func (c *tencentClient) CreateHealthCheck(ctx context.Context, profile string, input model.CreateHealthCheckRequest) (model.CreateHealthCheckResponse, error) {
+	return model.CreateHealthCheckResponse{}, model.NewNotImplementedError(model.TENCENT, "CreateHealthCheck")
+}
+
+func (c *tencentClient) ModifyHealthCheck(ctx context.Context, profile string, input model.ModifyHealthCheckRequest) error {
+	return model.NewNotImplementedError(model.TENCENT, "ModifyHealthCheck")
+}
+
+func (c *tencentClient) DeleteHealthCheck(ctx context.Context, profile, id string) error {
+	return model.NewNotImplementedError(model.TENCENT, "DeleteHealthCheck")
+}
+
+func (c *tencentClient) DescribeHealthCheckStatus(ctx context.Context, profile, id string) (model.HealthCheckStatus, error) {
+	return model.HealthCheckStatus{}, model.NewNotImplementedError(model.TENCENT, "DescribeHealthCheckStatus")
+}
+
+// DescribeRecordLineList 线路和域名套餐有关，不传 DomainGrade 时先查询域名当前的套餐
+func (c *tencentClient) DescribeRecordLineList(ctx context.Context, profile, region string, input model.DescribeRecordLineListRequest) (model.DescribeRecordLineListResponse, error) {
+	if input.Domain == nil {
+		return model.DescribeRecordLineListResponse{}, fmt.Errorf("domain is required")
+	}
+	client, err := c.io.GetTencentDnsPodClient(profile)
+	if err != nil {
+		return model.DescribeRecordLineListResponse{}, err
+	}
+	grade := input.DomainGrade
+	if grade == nil {
+		domainRequest := dnspod.NewDescribeDomainRequest()
+		domainRequest.Domain = input.Domain
+		domainResp, err := client.DescribeDomainWithContext(ctx, domainRequest)
+		if err != nil {
+			return model.DescribeRecordLineListResponse{}, model.WrapCloudError(model.TENCENT, err)
+		}
+		grade = domainResp.Response.DomainInfo.Grade
+	}
+	request := dnspod.NewDescribeRecordLineListRequest()
+	request.Domain = input.Domain
+	request.DomainGrade = grade
+	response, err := client.DescribeRecordLineListWithContext(ctx, request)
+	if err != nil {
+		return model.DescribeRecordLineListResponse{}, model.WrapCloudError(model.TENCENT, err)
+	}
+	var lines []model.RecordLineInfo
+	for _, line := range response.Response.LineList {
+		lines = append(lines, model.RecordLineInfo{Name: line.Name, LineId: line.LineId})
+	}
+	return model.DescribeRecordLineListResponse{Lines: lines, Meta: response.Response}, nil
+}
@@ -1,18 +1,18 @@
 package io
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/spf13/cast"
-	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	privatedns "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/privatedns/v20201028"
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
-func (c *tencentClient) DescribePrivateDomainList(profile string, input model.DescribeDomainListRequest) (model.DescribePrivateDomainListResponse, error) {
+func (c *tencentClient) DescribePrivateDomainList(ctx context.Context, profile string, input model.DescribeDomainListRequest) (model.DescribePrivateDomainListResponse, error) {
 	client, err := c.io.GetTencentPrivateDNSClient(profile)
 	if err != nil {
 		return model.DescribePrivateDomainListResponse{}, err
@@ -31,12 +31,9 @@ func (c *tencentClient) DescribePrivateDomainList(profile string, input model.De
 	}
 
 	// 返回的resp是一个DescribePrivateZoneListResponse的实例，与请求对象对应
-	response, err := client.DescribePrivateZoneList(request)
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return model.DescribePrivateDomainListResponse{}, fmt.Errorf("an api error has returned: %s", err.Error())
-	}
+	response, err := client.DescribePrivateZoneListWithContext(ctx, request)
 	if err != nil {
-		return model.DescribePrivateDomainListResponse{}, err
+		return model.DescribePrivateDomainListResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	var domains []model.PrivateDomain
 	for _, domain := range response.Response.PrivateZoneSet {
@@ -44,7 +41,7 @@ func (c *tencentClient) DescribePrivateDomainList(profile string, input model.De
 			DomainId:    tea.String(cast.ToString(domain.ZoneId)),
 			Name:        domain.Domain,
 			RecordCount: domain.RecordCount,
-			VpcSet:      domain.VpcSet,
+			VpcSet:      model.NewPrivateZoneVpcsFromTencent(domain),
 			Status:      domain.Status,
 			Tags:        domain.Tags,
 		})
@@ -56,25 +53,28 @@ func (c *tencentClient) DescribePrivateDomainList(profile string, input model.De
 }
 
 // getDomainIdByname
-func (c *tencentClient) getDomainIdByname(profile string, domain string) (string, error) {
+func (c *tencentClient) getDomainIdByname(ctx context.Context, profile string, domain string) (string, error) {
 	if strings.HasPrefix(domain, "zone-") {
 		// 支持直接使用zoneId
 		return domain, nil
 	}
-	resp, err := c.DescribePrivateDomainList(profile, model.DescribeDomainListRequest{
+	resp, err := c.DescribePrivateDomainList(ctx, profile, model.DescribeDomainListRequest{
 		DomainKeyword: tea.String(domain),
 	})
 	if err != nil {
 		return "", err
 	}
-	if len(resp.DomainList) != 1 {
-		return "", fmt.Errorf("domain not found,or more than one,filter by keyword:%s", domain)
+	if len(resp.DomainList) == 0 {
+		return "", model.NewCloudError(model.TENCENT, model.ErrorCategoryNotFound, "DomainNotFound", fmt.Sprintf("domain not found,filter by keyword:%s", domain))
+	}
+	if len(resp.DomainList) > 1 {
+		return "", model.NewCloudError(model.TENCENT, model.ErrorCategoryInvalidInput, "DomainAmbiguous", fmt.Sprintf("more than one domain,filter by keyword:%s", domain))
 	}
 	return *resp.DomainList[0].DomainId, nil
 }
 
 // 过滤 keyword通过数据集再次比较的方式实现，官方接口不支持模糊匹配。
-func (c *tencentClient) DescribePrivateRecordList(profile string, input model.DescribePrivateRecordListRequest) (model.DescribePrivateRecordListResponse, error) {
+func (c *tencentClient) DescribePrivateRecordList(ctx context.Context, profile string, input model.DescribePrivateRecordListRequest) (model.DescribePrivateRecordListResponse, error) {
 	client, err := c.io.GetTencentPrivateDNSClient(profile)
 	if err != nil {
 		return model.DescribePrivateRecordListResponse{}, err
@@ -84,7 +84,7 @@ func (c *tencentClient) DescribePrivateRecordList(profile string, input model.De
 	if input.Domain == nil {
 		return model.DescribePrivateRecordListResponse{}, fmt.Errorf("domain is required")
 	}
-	zoneId, err := c.getDomainIdByname(profile, *input.Domain)
+	zoneId, err := c.getDomainIdByname(ctx, profile, *input.Domain)
 	if err != nil {
 		return model.DescribePrivateRecordListResponse{}, err
 	}
@@ -92,12 +92,9 @@ func (c *tencentClient) DescribePrivateRecordList(profile string, input model.De
 	request.Limit = tea.Int64(100) // 默认100
 
 	// 返回的resp是一个DescribePrivateZoneRecordListResponse的实例，与请求对象对应
-	response, err := client.DescribePrivateZoneRecordList(request)
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return model.DescribePrivateRecordListResponse{}, fmt.Errorf("an api error has returned: %s", err.Error())
-	}
+	response, err := client.DescribePrivateZoneRecordListWithContext(ctx, request)
 	if err != nil {
-		return model.DescribePrivateRecordListResponse{}, err
+		return model.DescribePrivateRecordListResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	var records []model.Record
 	total := 0
@@ -123,9 +120,9 @@ func (c *tencentClient) DescribePrivateRecordList(profile string, input model.De
 			break
 		}
 		request.Offset = tea.Int64(cast.ToInt64(len(records)))
-		response, err = client.DescribePrivateZoneRecordList(request)
+		response, err = client.DescribePrivateZoneRecordListWithContext(ctx, request)
 		if err != nil {
-			return model.DescribePrivateRecordListResponse{}, err
+			return model.DescribePrivateRecordListResponse{}, model.WrapCloudError(model.TENCENT, err)
 		}
 	}
 
@@ -136,7 +133,7 @@ func (c *tencentClient) DescribePrivateRecordList(profile string, input model.De
 
 }
 
-func (c *tencentClient) DescribePrivateRecordListWithPages(profile string, input model.DescribePrivateDnsRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
+func (c *tencentClient) DescribePrivateRecordListWithPages(ctx context.Context, profile string, input model.DescribePrivateDnsRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
 	client, err := c.io.GetTencentPrivateDNSClient(profile)
 	if err != nil {
 		return model.ListRecordsPageResponse{}, err
@@ -146,7 +143,7 @@ func (c *tencentClient) DescribePrivateRecordListWithPages(profile string, input
 	if input.Domain == nil {
 		return model.ListRecordsPageResponse{}, fmt.Errorf("domain is required")
 	}
-	zoneId, err := c.getDomainIdByname(profile, *input.Domain)
+	zoneId, err := c.getDomainIdByname(ctx, profile, *input.Domain)
 	if err != nil {
 		return model.ListRecordsPageResponse{}, err
 	}
@@ -155,16 +152,19 @@ func (c *tencentClient) DescribePrivateRecordListWithPages(profile string, input
 	if input.Limit != nil {
 		request.Limit = tea.Int64(cast.ToInt64(input.Limit))
 	}
-	if input.Page != nil {
-		request.Offset = tea.Int64(cast.ToInt64(input.Limit) * cast.ToInt64(tea.Int64Value(input.Page)-1))
+	offset, err := model.ParseOffsetMarker(input.NextMarker)
+	if err != nil {
+		return model.ListRecordsPageResponse{}, err
 	}
-	// 返回的resp是一个DescribePrivateZoneRecordListResponse的实例，与请求对象对应
-	response, err := client.DescribePrivateZoneRecordList(request)
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return model.ListRecordsPageResponse{}, fmt.Errorf("an api error has returned: %s", err.Error())
+	// 没有游标时按页码计算，Limit 为空时使用默认的 100
+	if input.NextMarker == nil && tea.Int64Value(input.Page) > 1 {
+		offset = *request.Limit * (*input.Page - 1)
 	}
+	request.Offset = tea.Int64(offset)
+	// 返回的resp是一个DescribePrivateZoneRecordListResponse的实例，与请求对象对应
+	response, err := client.DescribePrivateZoneRecordListWithContext(ctx, request)
 	if err != nil {
-		return model.ListRecordsPageResponse{}, err
+		return model.ListRecordsPageResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	var records []model.Record
 	for _, record := range response.Response.RecordSet {
@@ -179,7 +179,8 @@ func (c *tencentClient) DescribePrivateRecordListWithPages(profile string, input
 		})
 	}
 	var nextPage, prePage *int64
-	if *request.Limit == int64(len(records)) {
+	nextMarker := model.NextOffsetMarker(offset, len(records), tea.Int64Value(response.Response.TotalCount))
+	if nextMarker != nil {
 		if input.Page == nil {
 			nextPage = tea.Int64(2)
 		} else {
@@ -192,57 +193,70 @@ func (c *tencentClient) DescribePrivateRecordListWithPages(profile string, input
 	return model.ListRecordsPageResponse{
 		RecordList: records,
 		NextPage:   nextPage,
+		NextMarker: nextMarker,
 		PrePage:    prePage,
 	}, nil
 }
 
-func (c *tencentClient) CreatePrivateRecord(profile string, input model.CreateRecordRequest) (model.CreateRecordResponse, error) {
+// CreatePrivateRecord 多个值时每个值创建一条记录，RecordId 为第一条记录的 ID
+func (c *tencentClient) CreatePrivateRecord(ctx context.Context, profile string, input model.CreateRecordRequest) (model.CreateRecordResponse, error) {
 	client, err := c.io.GetTencentPrivateDNSClient(profile)
 	if err != nil {
 		return model.CreateRecordResponse{}, err
 	}
-	// 实例化一个请求对象,每个接口都会对应一个request对象
-	request := privatedns.NewCreatePrivateZoneRecordRequest()
-	request.TTL = tea.Int64(60) // 默认60
 	if input.Domain == nil {
 		return model.CreateRecordResponse{}, fmt.Errorf("domain is required")
 	}
-	zoneId, err := c.getDomainIdByname(profile, *input.Domain)
+	zoneId, err := c.getDomainIdByname(ctx, profile, *input.Domain)
 	if err != nil {
 		return model.CreateRecordResponse{}, err
 	}
-	request.ZoneId = tea.String(zoneId)
 	if input.RecordType == nil {
 		return model.CreateRecordResponse{}, fmt.Errorf("recordtype is required")
 	}
-	if input.TTL != nil {
-		request.TTL = tea.Int64(cast.ToInt64(*input.TTL))
-	}
 	if input.SubDomain == nil {
 		return model.CreateRecordResponse{}, fmt.Errorf("subdomain is required")
 	}
-	request.SubDomain = input.SubDomain
-	if input.Value == nil {
+	values := input.ToRecord().GetValues()
+	if len(values) == 0 {
 		return model.CreateRecordResponse{}, fmt.Errorf("value is required")
 	}
-	request.RecordValue = input.Value
-	request.RecordType = input.RecordType
-	log.Println(tea.Prettify(request))
-	// 返回的resp是一个CreatePrivateZoneRecordResponse的实例，与请求对象对应
-	response, err := client.CreatePrivateZoneRecord(request)
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return model.CreateRecordResponse{}, fmt.Errorf("an api error has returned: %s", err.Error())
+	var responses []*privatedns.CreatePrivateZoneRecordResponseParams
+	for _, value := range values {
+		// 实例化一个请求对象,每个接口都会对应一个request对象
+		request := privatedns.NewCreatePrivateZoneRecordRequest()
+		request.TTL = tea.Int64(60) // 默认60
+		request.ZoneId = tea.String(zoneId)
+		if input.TTL != nil {
+			request.TTL = tea.Int64(cast.ToInt64(*input.TTL))
+		}
+		if input.Weight != nil {
+			request.Weight = tea.Int64(cast.ToInt64(*input.Weight))
+		}
+		request.SubDomain = input.SubDomain
+		request.RecordValue = value
+		request.RecordType = input.RecordType
+		log.Println(tea.Prettify(request))
+		// 返回的resp是一个CreatePrivateZoneRecordResponse的实例，与请求对象对应
+		response, err := client.CreatePrivateZoneRecordWithContext(ctx, request)
+		if err != nil {
+			return model.CreateRecordResponse{}, model.WrapCloudError(model.TENCENT, err)
+		}
+		responses = append(responses, response.Response)
 	}
-	if err != nil {
-		return model.CreateRecordResponse{}, err
+	if len(responses) == 1 {
+		return model.CreateRecordResponse{
+			RecordId: tea.String(cast.ToString(responses[0].RecordId)),
+			Meta:     responses[0],
+		}, nil
 	}
 	return model.CreateRecordResponse{
-		RecordId: tea.String(cast.ToString(response.Response.RecordId)),
-		Meta:     response.Response,
+		RecordId: tea.String(cast.ToString(responses[0].RecordId)),
+		Meta:     responses,
 	}, nil
 }
 
-func (c *tencentClient) ModifyPrivateRecord(profile string, input model.ModifyRecordRequest) error {
+func (c *tencentClient) ModifyPrivateRecord(ctx context.Context, profile string, input model.ModifyRecordRequest) error {
 	client, err := c.io.GetTencentPrivateDNSClient(profile)
 	if err != nil {
 		return err
@@ -250,7 +264,7 @@ func (c *tencentClient) ModifyPrivateRecord(profile string, input model.ModifyRe
 	if input.Domain == nil {
 		return fmt.Errorf("domain is required")
 	}
-	zoneId, err := c.getDomainIdByname(profile, *input.Domain)
+	zoneId, err := c.getDomainIdByname(ctx, profile, *input.Domain)
 	if err != nil {
 		return err
 	}
@@ -289,18 +303,15 @@ func (c *tencentClient) ModifyPrivateRecord(profile string, input model.ModifyRe
 		return fmt.Errorf("status is not supported for tencent cloud private dns. remove it from input")
 	}
 
-	_, err = client.ModifyPrivateZoneRecord(request)
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return fmt.Errorf("an api error has returned: %s", err.Error())
-	}
+	_, err = client.ModifyPrivateZoneRecordWithContext(ctx, request)
 	if err != nil {
-		return err
+		return model.WrapCloudError(model.TENCENT, err)
 	}
 	return nil
 
 }
 
-func (c *tencentClient) DeletePrivateRecord(profile string, input model.DeletePrivateRecordRequest) error {
+func (c *tencentClient) DeletePrivateRecord(ctx context.Context, profile string, input model.DeletePrivateRecordRequest) error {
 	client, err := c.io.GetTencentPrivateDNSClient(profile)
 	if err != nil {
 		return err
@@ -308,7 +319,7 @@ func (c *tencentClient) DeletePrivateRecord(profile string, input model.DeletePr
 	if input.Domain == nil {
 		return fmt.Errorf("domain is required")
 	}
-	zoneId, err := c.getDomainIdByname(profile, *input.Domain)
+	zoneId, err := c.getDomainIdByname(ctx, profile, *input.Domain)
 	if err != nil {
 		return err
 	}
@@ -320,12 +331,223 @@ func (c *tencentClient) DeletePrivateRecord(profile string, input model.DeletePr
 	}
 	request.RecordId = input.RecordId
 	request.RecordIdSet = input.RecordIds
-	_, err = client.DeletePrivateZoneRecord(request)
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return fmt.Errorf("an api error has returned: %s", err.Error())
+	_, err = client.DeletePrivateZoneRecordWithContext(ctx, request)
+	if err != nil {
+		return model.WrapCloudError(model.TENCENT, err)
 	}
+	return nil
+}
+
+// ChangePrivateRecordSet 私有域只有删除支持批量，先批量删除再逐条新增，失败时删除已新增的记录并恢复已删除的记录
+func (c *tencentClient) ChangePrivateRecordSet(ctx context.Context, profile string, input model.ChangeRecordSetRequest) (model.ChangeRecordSetResponse, error) {
+	if input.Domain == nil {
+		return model.ChangeRecordSetResponse{}, fmt.Errorf("domain is required")
+	}
+	if len(input.Changes) == 0 {
+		return model.ChangeRecordSetResponse{}, fmt.Errorf("changes is required")
+	}
+	live, err := c.DescribePrivateRecordList(ctx, profile, model.DescribePrivateRecordListRequest{Domain: input.Domain})
 	if err != nil {
+		return model.ChangeRecordSetResponse{}, err
+	}
+
+	var deletes []model.Record
+	var creates []model.CreateRecordRequest
+	deleted := map[string]bool{}
+	for _, change := range input.Changes {
+		record := change.Record
+		if record.SubDomain == nil || record.RecordType == nil {
+			return model.ChangeRecordSetResponse{}, fmt.Errorf("subDomain and recordType are required")
+		}
+		switch change.Action {
+		case model.RecordChangeCreate, model.RecordChangeUpsert, model.RecordChangeDelete:
+		default:
+			return model.ChangeRecordSetResponse{}, fmt.Errorf("unsupported action %s", change.Action)
+		}
+		if change.Action != model.RecordChangeCreate {
+			matches := matchTencentRecords(live.RecordList, record)
+			if len(matches) == 0 && change.Action == model.RecordChangeDelete {
+				return model.ChangeRecordSetResponse{}, model.NewRecordNotFoundError(model.TENCENT)
+			}
+			for _, match := range matches {
+				if !deleted[*match.RecordId] {
+					deleted[*match.RecordId] = true
+					deletes = append(deletes, match)
+				}
+			}
+		}
+		if change.Action != model.RecordChangeDelete {
+			creates = append(creates, model.CreateRecordRequest{
+				Domain:     input.Domain,
+				SubDomain:  record.SubDomain,
+				RecordType: record.RecordType,
+				Value:      record.Value,
+				Values:     record.Values,
+				TTL:        record.TTL,
+				Weight:     record.Weight,
+			})
+		}
+	}
+
+	if len(deletes) > 0 {
+		if err := c.deletePrivateRecords(ctx, profile, input.Domain, deletes); err != nil {
+			return model.ChangeRecordSetResponse{}, err
+		}
+	}
+	var created []model.Record
+	var recordIds []*string
+	for _, create := range creates {
+		resp, err := c.CreatePrivateRecord(ctx, profile, create)
+		if err != nil {
+			return model.ChangeRecordSetResponse{}, c.rollbackPrivateRecords(ctx, profile, input.Domain, live.RecordList, deletes, created, err)
+		}
+		recordIds = append(recordIds, resp.RecordId)
+		// 多个值时只返回第一条记录的 ID，回滚时按名称和类型重新查询
+		created = append(created, model.Record{RecordId: resp.RecordId, SubDomain: create.SubDomain, RecordType: create.RecordType})
+	}
+	return model.ChangeRecordSetResponse{
+		Meta: recordIds,
+	}, nil
+}
+
+func (c *tencentClient) deletePrivateRecords(ctx context.Context, profile string, domain *string, records []model.Record) error {
+	var recordIds []*string
+	for _, record := range records {
+		recordIds = append(recordIds, record.RecordId)
+	}
+	return c.DeletePrivateRecord(ctx, profile, model.DeletePrivateRecordRequest{
+		Domain:    domain,
+		RecordIds: recordIds,
+	})
+}
+
+// rollbackPrivateRecords 删除 created 对应名称和类型下变更前 live 中没有的记录，再恢复 deletes，cause 为导致回滚的错误
+func (c *tencentClient) rollbackPrivateRecords(ctx context.Context, profile string, domain *string, live, deletes, created []model.Record, cause error) error {
+	ctx = context.WithoutCancel(ctx)
+	if len(created) > 0 {
+		current, err := c.DescribePrivateRecordList(ctx, profile, model.DescribePrivateRecordListRequest{Domain: domain})
+		if err != nil {
+			return fmt.Errorf("%w, rollback failed: %v", cause, err)
+		}
+		before := map[string]bool{}
+		for _, record := range live {
+			before[*record.RecordId] = true
+		}
+		var rollback []model.Record
+		for _, record := range created {
+			for _, match := range matchTencentRecords(current.RecordList, record) {
+				if !before[*match.RecordId] {
+					rollback = append(rollback, match)
+				}
+			}
+		}
+		if len(rollback) > 0 {
+			if err := c.deletePrivateRecords(ctx, profile, domain, rollback); err != nil {
+				return fmt.Errorf("%w, rollback failed: %v", cause, err)
+			}
+		}
+	}
+	for _, record := range deletes {
+		_, err := c.CreatePrivateRecord(ctx, profile, model.CreateRecordRequest{
+			Domain:     domain,
+			SubDomain:  record.SubDomain,
+			RecordType: record.RecordType,
+			Value:      record.Value,
+			TTL:        record.TTL,
+			Weight:     record.Weight,
+		})
+		if err != nil {
+			return fmt.Errorf("%w, rollback failed: %v", cause, err)
+		}
+	}
+	return fmt.Errorf("%w, changes have been rolled back", cause)
+}
+
+// CreatePrivateZone AccountId 不为空的 VPC 通过 AccountVpcSet 跨账号关联
+func (c *tencentClient) CreatePrivateZone(ctx context.Context, profile string, input model.CreatePrivateZoneRequest) (model.CreatePrivateZoneResponse, error) {
+	if err := input.Validate(); err != nil {
+		return model.CreatePrivateZoneResponse{}, err
+	}
+	client, err := c.io.GetTencentPrivateDNSClient(profile)
+	if err != nil {
+		return model.CreatePrivateZoneResponse{}, err
+	}
+	request := privatedns.NewCreatePrivateZoneRequest()
+	request.Domain = input.Domain
+	request.Remark = input.Remark
+	request.VpcSet, request.AccountVpcSet = model.ToTencentVpcSets(input.Vpcs)
+	response, err := client.CreatePrivateZoneWithContext(ctx, request)
+	if err != nil {
+		return model.CreatePrivateZoneResponse{}, model.WrapCloudError(model.TENCENT, err)
+	}
+	return model.CreatePrivateZoneResponse{
+		DomainId: response.Response.ZoneId,
+		Name:     response.Response.Domain,
+		Meta:     response.Response,
+	}, nil
+}
+
+func (c *tencentClient) DeletePrivateZone(ctx context.Context, profile string, input model.DeletePrivateZoneRequest) error {
+	if input.Domain == nil {
+		return fmt.Errorf("domain is required")
+	}
+	client, err := c.io.GetTencentPrivateDNSClient(profile)
+	if err != nil {
+		return err
+	}
+	zoneId, err := c.getDomainIdByname(ctx, profile, *input.Domain)
+	if err != nil {
+		return err
+	}
+	request := privatedns.NewDeletePrivateZoneRequest()
+	request.ZoneId = tea.String(zoneId)
+	if _, err := client.DeletePrivateZoneWithContext(ctx, request); err != nil {
+		return model.WrapCloudError(model.TENCENT, err)
+	}
+	return nil
+}
+
+// AddZoneVpcAssociation 同步等待关联完成
+func (c *tencentClient) AddZoneVpcAssociation(ctx context.Context, profile string, input model.ZoneVpcAssociationRequest) error {
+	if err := input.Validate(); err != nil {
 		return err
 	}
+	client, err := c.io.GetTencentPrivateDNSClient(profile)
+	if err != nil {
+		return err
+	}
+	zoneId, err := c.getDomainIdByname(ctx, profile, *input.Domain)
+	if err != nil {
+		return err
+	}
+	request := privatedns.NewAddSpecifyPrivateZoneVpcRequest()
+	request.ZoneId = tea.String(zoneId)
+	request.VpcSet, request.AccountVpcSet = model.ToTencentVpcSets(input.Vpcs)
+	request.Sync = tea.Bool(true)
+	if _, err := client.AddSpecifyPrivateZoneVpcWithContext(ctx, request); err != nil {
+		return model.WrapCloudError(model.TENCENT, err)
+	}
+	return nil
+}
+
+func (c *tencentClient) RemoveZoneVpcAssociation(ctx context.Context, profile string, input model.ZoneVpcAssociationRequest) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+	client, err := c.io.GetTencentPrivateDNSClient(profile)
+	if err != nil {
+		return err
+	}
+	zoneId, err := c.getDomainIdByname(ctx, profile, *input.Domain)
+	if err != nil {
+		return err
+	}
+	request := privatedns.NewDeleteSpecifyPrivateZoneVpcRequest()
+	request.ZoneId = tea.String(zoneId)
+	request.VpcSet, request.AccountVpcSet = model.ToTencentVpcSets(input.Vpcs)
+	request.Sync = tea.Bool(true)
+	if _, err := client.DeleteSpecifyPrivateZoneVpcWithContext(ctx, request); err != nil {
+		return model.WrapCloudError(model.TENCENT, err)
+	}
 	return nil
 }
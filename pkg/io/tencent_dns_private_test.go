@@ -1,6 +1,7 @@
 package io_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -11,7 +12,7 @@ import (
 // TEST DescribePrivateDomainList
 func TestDescribePrivateDomainList(t *testing.T) {
 	timeStart := time.Now()
-	resp, err := TencentIo.DescribePrivateDomainList("tencent", model.DescribeDomainListRequest{})
+	resp, err := TencentIo.DescribePrivateDomainList(context.Background(), "tencent", model.DescribeDomainListRequest{})
 	if err != nil {
 		t.Error(err)
 		return
@@ -22,7 +23,7 @@ func TestDescribePrivateDomainList(t *testing.T) {
 // TEST DescribePrivateRecordList
 func TestDescribePrivateRecordList(t *testing.T) {
 	timeStart := time.Now()
-	resp, err := TencentIo.DescribePrivateRecordList("tencent", model.DescribePrivateRecordListRequest{
+	resp, err := TencentIo.DescribePrivateRecordList(context.Background(), "tencent", model.DescribePrivateRecordListRequest{
 		Domain:  tea.String("zone-3m8hlc6o"),
 		Keyword: tea.String("zsj"),
 	})
@@ -36,7 +37,7 @@ func TestDescribePrivateRecordList(t *testing.T) {
 // TEST DescribePrivateRecordListWithPages
 func TestDescribePrivateRecordListWithPages(t *testing.T) {
 	timeStart := time.Now()
-	resp, err := TencentIo.DescribePrivateRecordListWithPages("tencent", model.DescribePrivateDnsRecordListWithPageRequest{
+	resp, err := TencentIo.DescribePrivateRecordListWithPages(context.Background(), "tencent", model.DescribePrivateDnsRecordListWithPageRequest{
 		Domain: tea.String("zone-3m8hlc6o"),
 		// Limit:  tea.Int64(2),
 		// Page:   tea.Int64(2),
@@ -51,7 +52,7 @@ func TestDescribePrivateRecordListWithPages(t *testing.T) {
 // TEST CreatePrivateRecord
 func TestCreatePrivateRecord(t *testing.T) {
 	timeStart := time.Now()
-	resp, err := TencentIo.CreatePrivateRecord("tencent", model.CreateRecordRequest{
+	resp, err := TencentIo.CreatePrivateRecord(context.Background(), "tencent", model.CreateRecordRequest{
 		Domain:     tea.String("zone-3m8hlc6o"),
 		SubDomain:  tea.String("zsj11"),
 		RecordType: tea.String("A"),
@@ -67,7 +68,7 @@ func TestCreatePrivateRecord(t *testing.T) {
 // TEST ModifyPrivateRecord
 func TestModifyPrivateRecord(t *testing.T) {
 	timeStart := time.Now()
-	err := TencentIo.ModifyPrivateRecord("tencent", model.ModifyRecordRequest{
+	err := TencentIo.ModifyPrivateRecord(context.Background(), "tencent", model.ModifyRecordRequest{
 		Domain:     tea.String("domain.com"),
 		RecordId:   tea.Uint64(1965530),
 		SubDomain:  tea.String("zsj"),
@@ -84,7 +85,7 @@ func TestModifyPrivateRecord(t *testing.T) {
 // TEST DeletePrivateRecord
 func TestDeletePrivateRecord(t *testing.T) {
 	timeStart := time.Now()
-	err := TencentIo.DeletePrivateRecord("tencent", model.DeletePrivateRecordRequest{
+	err := TencentIo.DeletePrivateRecord(context.Background(), "tencent", model.DeletePrivateRecordRequest{
 		Domain:   tea.String("domain.com"),
 		RecordId: tea.String("1965587"),
 		// RecordIds: []*string{tea.String("1965530")},
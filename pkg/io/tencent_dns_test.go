@@ -1,6 +1,7 @@
 package io_test
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
@@ -16,7 +17,7 @@ var region = "" // 腾讯云不需要region
 // TEST DescribeDomainList
 func TestDescribeDomainList(t *testing.T) {
 	timeStart := time.Now()
-	resp, err := TencentIo.DescribeDomainList("tencent", "", model.DescribeDomainListRequest{
+	resp, err := TencentIo.DescribeDomainList(context.Background(), "tencent", "", model.DescribeDomainListRequest{
 		DomainKeyword: tea.String(os.Getenv("TEST_TENCENT_DOMAIN")),
 	})
 	if err != nil {
@@ -30,7 +31,7 @@ func TestDns(t *testing.T) {
 
 	{
 		// TEST DescribeDomainList
-		_, err := TencentIo.DescribeDomainList(profile, region, model.DescribeDomainListRequest{})
+		_, err := TencentIo.DescribeDomainList(context.Background(), profile, region, model.DescribeDomainListRequest{})
 		if err != nil {
 			t.Error(err)
 			return
@@ -40,7 +41,7 @@ func TestDns(t *testing.T) {
 
 	{
 		// TEST DescribeRecordList
-		_, err := TencentIo.DescribeRecordList(profile, region, model.DescribeRecordListRequest{
+		_, err := TencentIo.DescribeRecordList(context.Background(), profile, region, model.DescribeRecordListRequest{
 			Domain:  tea.String(os.Getenv("TEST_TENCENT_DOMAIN")),
 			Keyword: tea.String("test"),
 		})
@@ -49,7 +50,7 @@ func TestDns(t *testing.T) {
 
 	{
 		// TEST DescribeRecordList
-		_, err := TencentIo.DescribeRecordListWithPages(profile, region, model.DescribeRecordListWithPageRequest{
+		_, err := TencentIo.DescribeRecordListWithPages(context.Background(), profile, region, model.DescribeRecordListWithPageRequest{
 			Domain: tea.String(os.Getenv("TEST_TENCENT_DOMAIN")),
 			Limit:  tea.Int64(2),
 			Page:   tea.Int64(2),
@@ -60,7 +61,7 @@ func TestDns(t *testing.T) {
 
 func TestList(t *testing.T) {
 	// TEST DescribeRecordList
-	resp, err := TencentIo.DescribeRecordList(profile, region, model.DescribeRecordListRequest{
+	resp, err := TencentIo.DescribeRecordList(context.Background(), profile, region, model.DescribeRecordListRequest{
 		Domain:  tea.String(os.Getenv("TEST_TENCENT_DOMAIN")),
 		Keyword: tea.String("test"),
 	})
@@ -70,7 +71,7 @@ func TestList(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	// TEST CreateRecord
-	resp, err := TencentIo.CreateRecord(profile, region, model.CreateRecordRequest{
+	resp, err := TencentIo.CreateRecord(context.Background(), profile, region, model.CreateRecordRequest{
 		Domain:     tea.String(os.Getenv("TEST_TENCENT_DOMAIN")),
 		SubDomain:  tea.String("zsj.test"),
 		RecordType: tea.String("A"),
@@ -82,7 +83,7 @@ func TestCreate(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	// TEST DeleteRecord
-	_, err := TencentIo.DeleteRecord(profile, region, model.DeleteRecordRequest{
+	_, err := TencentIo.DeleteRecord(context.Background(), profile, region, model.DeleteRecordRequest{
 		Domain:     tea.String(os.Getenv("TEST_TENCENT_DOMAIN")),
 		SubDomain:  tea.String("zsj.test"),
 		RecordType: tea.String("A"),
@@ -0,0 +1,161 @@
+package io
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+const tencentDomainVersion = "2018-08-08"
+
+// DescribeDomainNameList 的域名，AutoRenew 0 手动续费，1 自动续费，2 到期不续费
+type tencentDomainSummary struct {
+	DomainId       *string `json:"DomainId"`
+	DomainName     *string `json:"DomainName"`
+	AutoRenew      *int64  `json:"AutoRenew"`
+	CreationDate   *string `json:"CreationDate"`
+	ExpirationDate *string `json:"ExpirationDate"`
+	BuyStatus      *string `json:"BuyStatus"`
+}
+
+// DescribeDomainSimpleInfo 的域名，包含 NS、转移锁和实名的注册人
+type tencentDomainDetail struct {
+	tencentDomainSummary
+	DomainStatus       []string `json:"DomainStatus"`
+	NameServer         []string `json:"NameServer"`
+	LockTransfer       *bool    `json:"LockTransfer"`
+	RegistrantName     *string  `json:"RegistrantName"`
+	RegistrantNameCN   *string  `json:"RegistrantNameCN"`
+	OrganizationName   *string  `json:"OrganizationName"`
+	OrganizationNameCN *string  `json:"OrganizationNameCN"`
+	Email              *string  `json:"Email"`
+	Telephone          *string  `json:"Telephone"`
+	CountryCode        *string  `json:"CountryCode"`
+}
+
+// DescribeRegisteredDomains 腾讯云域名注册服务，每页最多 100 个
+func (c *tencentClient) DescribeRegisteredDomains(ctx context.Context, profile string, input model.DescribeRegisteredDomainsRequest) ([]model.RegisteredDomain, error) {
+	client, err := c.io.GetTencentDomainClient(profile)
+	if err != nil {
+		return nil, err
+	}
+	var domains []model.RegisteredDomain
+	for offset := 0; ; offset += 100 {
+		var resp struct {
+			DomainSet  []tencentDomainSummary `json:"DomainSet"`
+			TotalCount int                    `json:"TotalCount"`
+		}
+		err = sendTencentDomainRequest(ctx, client, "DescribeDomainNameList", map[string]interface{}{
+			"Offset": offset,
+			"Limit":  100,
+		}, &resp)
+		if err != nil {
+			return nil, err
+		}
+		for _, summary := range resp.DomainSet {
+			domain := newRegisteredDomainFromTencent(summary)
+			if input.Detail {
+				detail, err := c.describeRegisteredDomain(ctx, client, tea.StringValue(summary.DomainName))
+				if err != nil {
+					return nil, err
+				}
+				domain.TransferLock = detail.TransferLock
+				domain.Status = detail.Status
+				domain.Nameservers = detail.Nameservers
+				domain.Registrant = detail.Registrant
+			}
+			domains = append(domains, domain)
+		}
+		if len(resp.DomainSet) == 0 || offset+len(resp.DomainSet) >= resp.TotalCount {
+			break
+		}
+	}
+	return domains, nil
+}
+
+func (c *tencentClient) DescribeRegisteredDomain(ctx context.Context, profile, domain string) (model.RegisteredDomain, error) {
+	client, err := c.io.GetTencentDomainClient(profile)
+	if err != nil {
+		return model.RegisteredDomain{}, err
+	}
+	return c.describeRegisteredDomain(ctx, client, domain)
+}
+
+func (c *tencentClient) describeRegisteredDomain(ctx context.Context, client *common.Client, domain string) (model.RegisteredDomain, error) {
+	var resp struct {
+		DomainInfo *tencentDomainDetail `json:"DomainInfo"`
+	}
+	err := sendTencentDomainRequest(ctx, client, "DescribeDomainSimpleInfo", map[string]interface{}{
+		"Domain": domain,
+	}, &resp)
+	if err != nil {
+		return model.RegisteredDomain{}, err
+	}
+	if resp.DomainInfo == nil {
+		return model.RegisteredDomain{}, model.NewCloudError(model.TENCENT, model.ErrorCategoryNotFound, "DomainNotFound", fmt.Sprintf("domain %s not found", domain))
+	}
+	detail := resp.DomainInfo
+	result := newRegisteredDomainFromTencent(detail.tencentDomainSummary)
+	if result.Name == nil {
+		result.Name = tea.String(domain)
+	}
+	result.TransferLock = detail.LockTransfer
+	result.Status = detail.DomainStatus
+	result.Nameservers = detail.NameServer
+	result.Registrant = &model.DomainContact{
+		Name:         firstNonEmpty(detail.RegistrantName, detail.RegistrantNameCN),
+		Organization: firstNonEmpty(detail.OrganizationName, detail.OrganizationNameCN),
+		Email:        detail.Email,
+		Phone:        detail.Telephone,
+		CountryCode:  detail.CountryCode,
+	}
+	result.Meta = detail
+	return result, nil
+}
+
+func newRegisteredDomainFromTencent(summary tencentDomainSummary) model.RegisteredDomain {
+	domain := model.RegisteredDomain{
+		Name:           summary.DomainName,
+		CreationDate:   parseTencentDomainTime(summary.CreationDate),
+		ExpirationDate: parseTencentDomainTime(summary.ExpirationDate),
+		Meta:           summary,
+	}
+	if summary.AutoRenew != nil {
+		domain.AutoRenew = tea.Bool(*summary.AutoRenew == 1)
+	}
+	return domain
+}
+
+// 域名服务的时间为北京时间，有的接口只返回日期
+func parseTencentDomainTime(value *string) *time.Time {
+	if value == nil {
+		return nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02"} {
+		t, err := time.ParseInLocation(layout, strings.TrimSpace(*value), time.FixedZone("CST", 8*3600))
+		if err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+func firstNonEmpty(values ...*string) *string {
+	for _, value := range values {
+		if tea.StringValue(value) != "" {
+			return value
+		}
+	}
+	return nil
+}
+
+// sendTencentDomainRequest 使用通用请求调用域名服务，out 对应返回中的 Response
+func sendTencentDomainRequest(ctx context.Context, client *common.Client, action string, params map[string]interface{}, out interface{}) error {
+	return sendTencentCommonRequest(ctx, client, "domain", tencentDomainVersion, action, params, out)
+}
@@ -1,6 +1,7 @@
 package io
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -11,8 +12,8 @@ import (
 	emr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/emr/v20190103"
 )
 
-// EMR 腾讯云因为数据量少所以递归查询所有结果返回
-func (c *tencentClient) QueryEmrCluster(input model.EmrFilter) (model.FilterEmrResponse, error) {
+// QueryEmrCluster 每页 100 个，ClusterStates 在返回后过滤，所以一页可能少于 100 个
+func (c *tencentClient) QueryEmrCluster(ctx context.Context, input model.EmrFilter) (model.FilterEmrResponse, error) {
 	if input.Region == nil && input.Profile == nil {
 		return model.FilterEmrResponse{}, fmt.Errorf("region or profile is empty")
 	}
@@ -22,12 +23,17 @@ func (c *tencentClient) QueryEmrCluster(input model.EmrFilter) (model.FilterEmrR
 	}
 	request := emr.NewDescribeInstancesListRequest()
 	request.DisplayStrategy = tea.String("clusterList")
-	request.Limit = tea.Uint64(100) // TODO: 处理分页，目前不会超过 100 个。
-	var clusters []model.EmrCluster
-	response, err := client.DescribeInstancesList(request)
+	request.Limit = tea.Uint64(100)
+	offset, err := model.ParseOffsetMarker(input.NextMarker)
 	if err != nil {
 		return model.FilterEmrResponse{}, err
 	}
+	request.Offset = tea.Uint64(cast.ToUint64(offset))
+	var clusters []model.EmrCluster
+	response, err := client.DescribeInstancesListWithContext(ctx, request)
+	if err != nil {
+		return model.FilterEmrResponse{}, model.WrapCloudError(model.TENCENT, err)
+	}
 	for _, cluster := range response.Response.InstancesList {
 		state := model.FmtTencentState(tea.Int64(cast.ToInt64(cluster.Status)))
 		if len(input.ClusterStates) != 0 {
@@ -48,11 +54,11 @@ func (c *tencentClient) QueryEmrCluster(input model.EmrFilter) (model.FilterEmrR
 	}
 	return model.FilterEmrResponse{
 		Clusters:   clusters,
-		NextMarker: nil,
+		NextMarker: model.NextOffsetMarker(offset, len(response.Response.InstancesList), tea.Int64Value(response.Response.TotalCnt)),
 	}, nil
 }
 
-func (c *tencentClient) DescribeEmrCluster(input model.DescribeInput) ([]model.DescribeEmrCluster, error) {
+func (c *tencentClient) DescribeEmrCluster(ctx context.Context, input model.DescribeInput) ([]model.DescribeEmrCluster, error) {
 	if input.Region == nil && input.Profile == nil {
 		return nil, fmt.Errorf("region or profile is empty")
 	}
@@ -64,9 +70,9 @@ func (c *tencentClient) DescribeEmrCluster(input model.DescribeInput) ([]model.D
 	request.DisplayStrategy = tea.String("clusterList")
 	request.ProjectId = tea.Int64(-1) // 默认-1 查询所有
 	request.InstanceIds = input.IDS
-	response, err := client.DescribeInstances(request)
+	response, err := client.DescribeInstancesWithContext(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, model.WrapCloudError(model.TENCENT, err)
 	}
 	var clusters []model.DescribeEmrCluster
 	for _, cluster := range response.Response.ClusterList {
@@ -84,7 +90,7 @@ func (c *tencentClient) DescribeEmrCluster(input model.DescribeInput) ([]model.D
 	return clusters, nil
 }
 
-func (c *tencentClient) CreateEmrCluster(profile, region string, input model.CreateEmrClusterInput) (model.CreateEmrClusterResponse, error) {
+func (c *tencentClient) CreateEmrCluster(ctx context.Context, profile, region string, input model.CreateEmrClusterInput) (model.CreateEmrClusterResponse, error) {
 	client, err := c.io.GetTencentEmrClient(profile, region)
 	if err != nil {
 		return model.CreateEmrClusterResponse{}, err
@@ -94,9 +100,9 @@ func (c *tencentClient) CreateEmrCluster(profile, region string, input model.Cre
 		return model.CreateEmrClusterResponse{}, err
 	}
 	fmt.Println(tea.Prettify(req))
-	response, err := client.CreateInstance(req)
+	response, err := client.CreateInstanceWithContext(ctx, req)
 	if err != nil {
-		return model.CreateEmrClusterResponse{}, fmt.Errorf("create emr cluster error: %s", err)
+		return model.CreateEmrClusterResponse{}, fmt.Errorf("create emr cluster error: %w", model.WrapCloudError(model.TENCENT, err))
 	}
 	return model.CreateEmrClusterResponse{ID: *response.Response.InstanceId}, nil
 }
@@ -0,0 +1,117 @@
+package io
+
+import (
+	"context"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// DescribeImages ImageIds 和 Filters 不能同时使用，名称和操作系统在本地过滤
+func (c *tencentClient) DescribeImages(ctx context.Context, profile, region string, input model.DescribeImagesRequest) ([]model.Image, error) {
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	request := cvm.NewDescribeImagesRequest()
+	request.Limit = tea.Uint64(100)
+	if len(input.ImageIds) > 0 {
+		request.ImageIds = common.StringPtrs(input.ImageIds)
+	} else {
+		var visibility model.ImageVisibility
+		if input.Visibility != nil {
+			visibility = *input.Visibility
+		}
+		request.Filters = []*cvm.Filter{
+			{Name: tea.String("image-type"), Values: common.StringPtrs(visibility.ToTencentImageTypes())},
+		}
+	}
+
+	var images []model.Image
+	for offset := uint64(0); ; offset += 100 {
+		request.Offset = tea.Uint64(offset)
+		response, err := client.DescribeImagesWithContext(ctx, request)
+		if err != nil {
+			return nil, model.WrapCloudError(model.TENCENT, err)
+		}
+		for _, image := range response.Response.ImageSet {
+			m := model.NewImageFromTencent(image)
+			if input.Match(m) {
+				images = append(images, m)
+			}
+		}
+		if len(response.Response.ImageSet) == 0 || int64(offset)+int64(len(response.Response.ImageSet)) >= tea.Int64Value(response.Response.TotalCount) {
+			break
+		}
+	}
+	return images, nil
+}
+
+func (c *tencentClient) CreateImage(ctx context.Context, profile, region string, input model.CreateImageRequest) (model.CreateImageResponse, error) {
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return model.CreateImageResponse{}, err
+	}
+	response, err := client.CreateImageWithContext(ctx, input.ToTencentCreateImageRequest())
+	if err != nil {
+		return model.CreateImageResponse{}, model.WrapCloudError(model.TENCENT, err)
+	}
+	return model.CreateImageResponse{ImageId: response.Response.ImageId, Meta: response.ToJsonString()}, nil
+}
+
+// CopyImage 使用 SyncImages 同步到其他地域，ImageSetRequired 返回目标地域的镜像 ID
+func (c *tencentClient) CopyImage(ctx context.Context, profile, region string, input model.CopyImageRequest) (model.CopyImageResponse, error) {
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return model.CopyImageResponse{}, err
+	}
+	request := cvm.NewSyncImagesRequest()
+	request.ImageIds = []*string{input.ImageId}
+	request.DestinationRegions = common.StringPtrs(input.DestinationRegions)
+	request.ImageName = input.Name
+	request.ImageSetRequired = tea.Bool(true)
+	response, err := client.SyncImagesWithContext(ctx, request)
+	if err != nil {
+		return model.CopyImageResponse{}, model.WrapCloudError(model.TENCENT, err)
+	}
+	resp := model.CopyImageResponse{Meta: response.ToJsonString()}
+	for _, image := range response.Response.ImageSet {
+		resp.Images = append(resp.Images, model.CopiedImage{Region: tea.StringValue(image.Region), ImageId: image.ImageId})
+	}
+	return resp, nil
+}
+
+func (c *tencentClient) ShareImage(ctx context.Context, profile, region string, input model.ShareImageRequest) error {
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return err
+	}
+	request := cvm.NewModifyImageSharePermissionRequest()
+	request.ImageId = input.ImageId
+	request.AccountIds = common.StringPtrs(input.AccountIds)
+	request.Permission = tea.String("SHARE")
+	if input.Unshare {
+		request.Permission = tea.String("CANCEL")
+	}
+	if _, err := client.ModifyImageSharePermissionWithContext(ctx, request); err != nil {
+		return model.WrapCloudError(model.TENCENT, err)
+	}
+	return nil
+}
+
+func (c *tencentClient) DeleteImage(ctx context.Context, profile, region string, input model.DeleteImageRequest) error {
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return err
+	}
+	request := cvm.NewDeleteImagesRequest()
+	request.ImageIds = []*string{input.ImageId}
+	request.DeleteBindedSnap = tea.Bool(input.DeleteSnapshots)
+	if _, err := client.DeleteImagesWithContext(ctx, request); err != nil {
+		return model.WrapCloudError(model.TENCENT, err)
+	}
+	return nil
+}
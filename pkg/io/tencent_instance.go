@@ -1,17 +1,18 @@
 package io
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
-	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	tencentTag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
-// Instance
-func (c *tencentClient) DescribeInstances(profile, region string, input model.DescribeInstancesInput) (model.InstanceResponse, error) {
+// DescribeInstances Size 和 NextMarker 都为空时逐页查询全部，否则只返回一页，每页最多 100 个
+func (c *tencentClient) DescribeInstances(ctx context.Context, profile, region string, input model.DescribeInstancesInput) (model.InstanceResponse, error) {
 	var instances []model.Instance
 
 	client, err := c.io.GetTencentCvmClient(profile, region)
@@ -27,28 +28,24 @@ func (c *tencentClient) DescribeInstances(profile, region string, input model.De
 			Values: filter.Values,
 		})
 	}
-	var pageSize int64 = 20
-	if input.Size != nil {
-		pageSize = *input.Size
-		request.Limit = input.Size
-	}
-
-	response, err := client.DescribeInstances(request)
+	offset, err := model.ParseOffsetMarker(input.NextMarker)
 	if err != nil {
 		return model.InstanceResponse{}, err
 	}
-
-	total_cvm := *response.Response.TotalCount
-	pages_all := total_cvm / pageSize
-	pages := *common.Int64Ptr(0)
-	for pages <= pages_all {
-		request.Limit = common.Int64Ptr(pageSize)
-		if pages > 0 {
-			request.Offset = common.Int64Ptr(pageSize*pages - 1)
+	request.Limit = common.Int64Ptr(100)
+	if input.Size != nil {
+		if *input.Size <= 0 {
+			return model.InstanceResponse{}, fmt.Errorf("%w: invalid size %d", model.ErrInvalidInput, *input.Size)
 		}
-		response, err := client.DescribeInstances(request)
+		request.Limit = common.Int64Ptr(min(*input.Size, 100))
+	}
+	onePage := input.Size != nil || input.NextMarker != nil
+
+	for {
+		request.Offset = common.Int64Ptr(offset)
+		response, err := client.DescribeInstancesWithContext(ctx, request)
 		if err != nil {
-			return model.InstanceResponse{}, err
+			return model.InstanceResponse{}, model.WrapCloudError(model.TENCENT, err)
 		}
 		for _, instanceSet := range response.Response.InstanceSet {
 			instances = append(instances, model.Instance{
@@ -65,26 +62,25 @@ func (c *tencentClient) DescribeInstances(profile, region string, input model.De
 				Platform:   instanceSet.OsName,
 			})
 		}
-		pages = pages + 1
+		nextMarker := model.NextOffsetMarker(offset, len(response.Response.InstanceSet), tea.Int64Value(response.Response.TotalCount))
+		if onePage || nextMarker == nil {
+			return model.InstanceResponse{
+				Instances:  instances,
+				NextMarker: nextMarker,
+			}, nil
+		}
+		offset += int64(len(response.Response.InstanceSet))
 	}
-
-	return model.InstanceResponse{
-		Instances:  instances,
-		NextMarker: nil,
-	}, nil
 }
 
-func (c *tencentClient) CreateInstance(profile, region string, input model.CreateInstanceInput) (model.CreateInstanceResponse, error) {
+func (c *tencentClient) CreateInstance(ctx context.Context, profile, region string, input model.CreateInstanceInput) (model.CreateInstanceResponse, error) {
 	client, err := c.io.GetTencentCvmClient(profile, region)
 	if err != nil {
 		return model.CreateInstanceResponse{}, err
 	}
-	response, err := client.RunInstances(input.ToTencentRunInstancesRequest())
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return model.CreateInstanceResponse{}, fmt.Errorf("an api error has returned: %s", err)
-	}
+	response, err := client.RunInstancesWithContext(ctx, input.ToTencentRunInstancesRequest())
 	if err != nil {
-		return model.CreateInstanceResponse{}, err
+		return model.CreateInstanceResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	return model.CreateInstanceResponse{
 		Meta:        response.ToJsonString(),
@@ -92,104 +88,81 @@ func (c *tencentClient) CreateInstance(profile, region string, input model.Creat
 	}, nil
 }
 
-// 查询可用区列表
-func (c *tencentClient) QueryRegions(profile, region string) (*cvm.DescribeZonesResponse, error) {
-	svc, err := c.io.GetTencentCvmClient(profile, region)
-	if err != nil {
-		return nil, err
-	}
-	// 实例化一个请求对象,每个接口都会对应一个request对象
-	request := cvm.NewDescribeZonesRequest()
-	// 返回的resp是一个DescribeZonesResponse的实例，与请求对象对应
-	response, err := svc.DescribeZones(request)
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return nil, fmt.Errorf("an api error has returned: %s", err)
-	}
-	if err != nil {
-		return nil, err
-	}
-	return response, nil
-}
-
-func (c *tencentClient) ModifyInstance(profile, region string, input model.ModifyInstanceInput) (model.ModifyInstanceResponse, error) {
+func (c *tencentClient) ModifyInstance(ctx context.Context, profile, region string, input model.ModifyInstanceInput) (model.ModifyInstanceResponse, error) {
 	switch input.Action {
 	case model.StartInstance:
-		return c.StartInstance(profile, region, input.InstanceIDs)
+		return c.StartInstance(ctx, profile, region, input.InstanceIDs)
 	case model.StopInstance:
-		return c.StopInstance(profile, region, input.InstanceIDs)
+		return c.StopInstance(ctx, profile, region, input.InstanceIDs)
 	case model.RebootInstance:
-		return c.RebootInstance(profile, region, input.InstanceIDs)
+		return c.RebootInstance(ctx, profile, region, input.InstanceIDs)
 	case model.ResetInstance:
-		return c.ResetInstance(profile, region, input.InstanceIDs)
+		return c.ResetInstance(ctx, profile, region, input.InstanceIDs)
 	case model.ChangeInstanceType:
 		if input.InstanceType == nil {
 			return model.ModifyInstanceResponse{}, fmt.Errorf("instance type is required")
 		}
-		return c.ChangeInstanceType(profile, region, input.InstanceIDs, input.InstanceType)
+		return c.ChangeInstanceType(ctx, profile, region, input.InstanceIDs, input.InstanceType)
+	case model.ChangeInstanceTags:
+		if len(input.Tags) == 0 {
+			return model.ModifyInstanceResponse{}, fmt.Errorf("tags is required")
+		}
+		return c.changeInstanceTags(ctx, profile, region, input.InstanceIDs, input.Tags)
 	default:
 		return model.ModifyInstanceResponse{}, fmt.Errorf("unsupported action: %s", input.Action)
 	}
 }
 
-func (c *tencentClient) StartInstance(profile, region string, instances []*string) (model.ModifyInstanceResponse, error) {
+func (c *tencentClient) StartInstance(ctx context.Context, profile, region string, instances []*string) (model.ModifyInstanceResponse, error) {
 	client, err := c.io.GetTencentCvmClient(profile, region)
 	if err != nil {
 		return model.ModifyInstanceResponse{}, err
 	}
 	request := cvm.NewStartInstancesRequest()
 	request.InstanceIds = instances
-	response, err := client.StartInstances(request)
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return model.ModifyInstanceResponse{}, fmt.Errorf("an api error has returned: %s", err)
-	}
+	response, err := client.StartInstancesWithContext(ctx, request)
 	if err != nil {
-		return model.ModifyInstanceResponse{}, err
+		return model.ModifyInstanceResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	return model.ModifyInstanceResponse{
 		Meta: response.ToJsonString(),
 	}, nil
 }
 
-func (c *tencentClient) StopInstance(profile, region string, instances []*string) (model.ModifyInstanceResponse, error) {
+func (c *tencentClient) StopInstance(ctx context.Context, profile, region string, instances []*string) (model.ModifyInstanceResponse, error) {
 	client, err := c.io.GetTencentCvmClient(profile, region)
 	if err != nil {
 		return model.ModifyInstanceResponse{}, err
 	}
 	request := cvm.NewStopInstancesRequest()
 	request.InstanceIds = instances
-	response, err := client.StopInstances(request)
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return model.ModifyInstanceResponse{}, fmt.Errorf("an api error has returned: %s", err)
-	}
+	response, err := client.StopInstancesWithContext(ctx, request)
 	if err != nil {
-		return model.ModifyInstanceResponse{}, err
+		return model.ModifyInstanceResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	return model.ModifyInstanceResponse{
 		Meta: response.ToJsonString(),
 	}, nil
 }
 
-func (c *tencentClient) RebootInstance(profile, region string, instances []*string) (model.ModifyInstanceResponse, error) {
+func (c *tencentClient) RebootInstance(ctx context.Context, profile, region string, instances []*string) (model.ModifyInstanceResponse, error) {
 	client, err := c.io.GetTencentCvmClient(profile, region)
 	if err != nil {
 		return model.ModifyInstanceResponse{}, err
 	}
 	request := cvm.NewRebootInstancesRequest()
 	request.InstanceIds = instances
-	response, err := client.RebootInstances(request)
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return model.ModifyInstanceResponse{}, fmt.Errorf("an api error has returned: %s", err)
-	}
+	response, err := client.RebootInstancesWithContext(ctx, request)
 	if err != nil {
-		return model.ModifyInstanceResponse{}, err
+		return model.ModifyInstanceResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	return model.ModifyInstanceResponse{
 		Meta: response.ToJsonString(),
 	}, nil
 }
 
-func (c *tencentClient) ResetInstance(profile, region string, instanceIDs []*string) (model.ModifyInstanceResponse, error) {
-	resp, err := c.DescribeInstances(profile, region, model.DescribeInstancesInput{
+func (c *tencentClient) ResetInstance(ctx context.Context, profile, region string, instanceIDs []*string) (model.ModifyInstanceResponse, error) {
+	resp, err := c.DescribeInstances(ctx, profile, region, model.DescribeInstancesInput{
 		InstanceIds: instanceIDs,
 	})
 	if err != nil {
@@ -210,12 +183,9 @@ func (c *tencentClient) ResetInstance(profile, region string, instanceIDs []*str
 		KeyIds: instance.KeyIDs,
 		// KeepImageLogin: tea.String("TRUE"), // 不支持公有镜像
 	}
-	response, err := client.ResetInstance(request)
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return model.ModifyInstanceResponse{}, fmt.Errorf("an api error has returned: %s", err)
-	}
+	response, err := client.ResetInstanceWithContext(ctx, request)
 	if err != nil {
-		return model.ModifyInstanceResponse{}, err
+		return model.ModifyInstanceResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	return model.ModifyInstanceResponse{
 		Meta: response.ToJsonString(),
@@ -223,7 +193,7 @@ func (c *tencentClient) ResetInstance(profile, region string, instanceIDs []*str
 }
 
 // 默认关闭强制关机
-func (c *tencentClient) ChangeInstanceType(profile, region string, instances []*string, instanceType *string) (model.ModifyInstanceResponse, error) {
+func (c *tencentClient) ChangeInstanceType(ctx context.Context, profile, region string, instances []*string, instanceType *string) (model.ModifyInstanceResponse, error) {
 	client, err := c.io.GetTencentCvmClient(profile, region)
 	if err != nil {
 		return model.ModifyInstanceResponse{}, err
@@ -232,29 +202,51 @@ func (c *tencentClient) ChangeInstanceType(profile, region string, instances []*
 	request.InstanceIds = instances
 	request.InstanceType = instanceType
 	request.ForceStop = tea.Bool(false)
-	response, err := client.ResetInstancesType(request)
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return model.ModifyInstanceResponse{}, fmt.Errorf("an api error has returned: %s", err)
+	response, err := client.ResetInstancesTypeWithContext(ctx, request)
+	if err != nil {
+		return model.ModifyInstanceResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
+	return model.ModifyInstanceResponse{
+		Meta: response.ToJsonString(),
+	}, nil
+}
+
+// changeInstanceTags 通过标签服务绑定，已有的标签键修改为新的值；每次只能绑定一个标签，最多 50 个实例
+func (c *tencentClient) changeInstanceTags(ctx context.Context, profile, region string, instances []*string, tags model.Tags) (model.ModifyInstanceResponse, error) {
+	client, err := c.io.GetTencentTagsClient(profile, region)
 	if err != nil {
 		return model.ModifyInstanceResponse{}, err
 	}
+	var metas []string
+	for _, tag := range tags {
+		for start := 0; start < len(instances); start += 50 {
+			request := tencentTag.NewAttachResourcesTagRequest()
+			request.ServiceType = tea.String("cvm")
+			request.ResourcePrefix = tea.String("instance")
+			request.ResourceRegion = tea.String(region)
+			request.ResourceIds = instances[start:min(start+50, len(instances))]
+			request.TagKey = tea.String(tag.Key)
+			request.TagValue = tea.String(tag.Value)
+			response, err := client.AttachResourcesTagWithContext(ctx, request)
+			if err != nil {
+				return model.ModifyInstanceResponse{Meta: metas}, model.WrapCloudError(model.TENCENT, err)
+			}
+			metas = append(metas, response.ToJsonString())
+		}
+	}
 	return model.ModifyInstanceResponse{
-		Meta: response.ToJsonString(),
+		Meta: metas,
 	}, nil
 }
 
-func (c *tencentClient) DeleteInstance(profile, region string, input model.DeleteInstanceInput) (model.DeleteInstanceResponse, error) {
+func (c *tencentClient) DeleteInstance(ctx context.Context, profile, region string, input model.DeleteInstanceInput) (model.DeleteInstanceResponse, error) {
 	client, err := c.io.GetTencentCvmClient(profile, region)
 	if err != nil {
 		return model.DeleteInstanceResponse{}, err
 	}
-	response, err := client.TerminateInstances(input.ToTencentTerminateInstancesRequest())
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return model.DeleteInstanceResponse{}, fmt.Errorf("an api error has returned: %s", err)
-	}
+	response, err := client.TerminateInstancesWithContext(ctx, input.ToTencentTerminateInstancesRequest())
 	if err != nil {
-		return model.DeleteInstanceResponse{}, err
+		return model.DeleteInstanceResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	return model.DeleteInstanceResponse{
 		Meta: response.ToJsonString(),
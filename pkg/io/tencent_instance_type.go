@@ -0,0 +1,54 @@
+package io
+
+import (
+	"context"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// DescribeInstanceTypes 按量计费的机型配置，同一机型在各个可用区的配置合并为一个
+func (c *tencentClient) DescribeInstanceTypes(ctx context.Context, profile, region string, filter model.InstanceTypeFilter) ([]model.InstanceType, error) {
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	request := cvm.NewDescribeZoneInstanceConfigInfosRequest()
+	request.Filters = []*cvm.Filter{
+		{Name: tea.String("instance-charge-type"), Values: common.StringPtrs([]string{"POSTPAID_BY_HOUR"})},
+	}
+	if filter.Zone != nil {
+		request.Filters = append(request.Filters, &cvm.Filter{Name: tea.String("zone"), Values: []*string{filter.Zone}})
+	}
+	if filter.Family != nil {
+		request.Filters = append(request.Filters, &cvm.Filter{Name: tea.String("instance-family"), Values: []*string{filter.Family}})
+	}
+	if len(filter.InstanceTypes) > 0 {
+		request.Filters = append(request.Filters, &cvm.Filter{Name: tea.String("instance-type"), Values: common.StringPtrs(filter.InstanceTypes)})
+	}
+	response, err := client.DescribeZoneInstanceConfigInfosWithContext(ctx, request)
+	if err != nil {
+		return nil, model.WrapCloudError(model.TENCENT, err)
+	}
+
+	var names []string
+	items := make(map[string][]*cvm.InstanceTypeQuotaItem)
+	for _, item := range response.Response.InstanceTypeQuotaSet {
+		name := tea.StringValue(item.InstanceType)
+		if _, ok := items[name]; !ok {
+			names = append(names, name)
+		}
+		items[name] = append(items[name], item)
+	}
+	var types []model.InstanceType
+	for _, name := range names {
+		t := model.NewInstanceTypeFromTencent(items[name])
+		if filter.Match(t) {
+			types = append(types, t)
+		}
+	}
+	return types, nil
+}
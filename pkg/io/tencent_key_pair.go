@@ -0,0 +1,133 @@
+package io
+
+import (
+	"context"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// DescribeKeyPairs 腾讯云的密钥对所有地域通用，region 只用于选择接入点
+func (c *tencentClient) DescribeKeyPairs(ctx context.Context, profile, region string, input model.DescribeKeyPairsRequest) ([]model.KeyPair, error) {
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	request := cvm.NewDescribeKeyPairsRequest()
+	request.Limit = tea.Int64(100)
+	if len(input.KeyIds) > 0 {
+		request.KeyIds = common.StringPtrs(input.KeyIds)
+	} else if input.Name != nil {
+		request.Filters = []*cvm.Filter{{Name: tea.String("key-name"), Values: []*string{input.Name}}}
+	}
+
+	var keys []model.KeyPair
+	for offset := int64(0); ; offset += 100 {
+		request.Offset = tea.Int64(offset)
+		response, err := client.DescribeKeyPairsWithContext(ctx, request)
+		if err != nil {
+			return nil, model.WrapCloudError(model.TENCENT, err)
+		}
+		for _, key := range response.Response.KeyPairSet {
+			// key-name 为模糊匹配
+			if input.Name != nil && tea.StringValue(key.KeyName) != *input.Name {
+				continue
+			}
+			keys = append(keys, model.NewKeyPairFromTencent(key))
+		}
+		if len(response.Response.KeyPairSet) == 0 || offset+int64(len(response.Response.KeyPairSet)) >= tea.Int64Value(response.Response.TotalCount) {
+			break
+		}
+	}
+	return keys, nil
+}
+
+func (c *tencentClient) CreateKeyPair(ctx context.Context, profile, region string, input model.CreateKeyPairRequest) (model.CreateKeyPairResponse, error) {
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return model.CreateKeyPairResponse{}, err
+	}
+	request := cvm.NewCreateKeyPairRequest()
+	request.KeyName = input.Name
+	request.ProjectId = tea.Int64(0)
+	request.TagSpecification = model.ToTencentKeyPairTags(input.Tags)
+	response, err := client.CreateKeyPairWithContext(ctx, request)
+	if err != nil {
+		return model.CreateKeyPairResponse{}, model.WrapCloudError(model.TENCENT, err)
+	}
+	key := response.Response.KeyPair
+	return model.CreateKeyPairResponse{
+		KeyPair:    model.NewKeyPairFromTencent(key),
+		PrivateKey: key.PrivateKey,
+	}, nil
+}
+
+func (c *tencentClient) ImportKeyPair(ctx context.Context, profile, region string, input model.ImportKeyPairRequest) (model.KeyPair, error) {
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return model.KeyPair{}, err
+	}
+	request := cvm.NewImportKeyPairRequest()
+	request.KeyName = input.Name
+	request.ProjectId = tea.Int64(0)
+	request.PublicKey = input.PublicKey
+	request.TagSpecification = model.ToTencentKeyPairTags(input.Tags)
+	response, err := client.ImportKeyPairWithContext(ctx, request)
+	if err != nil {
+		return model.KeyPair{}, model.WrapCloudError(model.TENCENT, err)
+	}
+	return model.KeyPair{
+		KeyId:     response.Response.KeyId,
+		Name:      input.Name,
+		PublicKey: input.PublicKey,
+		Tags:      &input.Tags,
+		Meta:      response.ToJsonString(),
+	}, nil
+}
+
+func (c *tencentClient) DeleteKeyPair(ctx context.Context, profile, region string, input model.DeleteKeyPairRequest) error {
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return err
+	}
+	request := cvm.NewDeleteKeyPairsRequest()
+	request.KeyIds = []*string{input.KeyId}
+	if _, err := client.DeleteKeyPairsWithContext(ctx, request); err != nil {
+		return model.WrapCloudError(model.TENCENT, err)
+	}
+	return nil
+}
+
+// AssociateKeyPairs 实例需要处于关机状态，ForceStop 时会先强制关机
+func (c *tencentClient) AssociateKeyPairs(ctx context.Context, profile, region string, input model.KeyPairAssociationRequest) error {
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return err
+	}
+	request := cvm.NewAssociateInstancesKeyPairsRequest()
+	request.KeyIds = input.KeyIds
+	request.InstanceIds = input.InstanceIds
+	request.ForceStop = tea.Bool(input.ForceStop)
+	if _, err := client.AssociateInstancesKeyPairsWithContext(ctx, request); err != nil {
+		return model.WrapCloudError(model.TENCENT, err)
+	}
+	return nil
+}
+
+func (c *tencentClient) DisassociateKeyPairs(ctx context.Context, profile, region string, input model.KeyPairAssociationRequest) error {
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return err
+	}
+	request := cvm.NewDisassociateInstancesKeyPairsRequest()
+	request.KeyIds = input.KeyIds
+	request.InstanceIds = input.InstanceIds
+	request.ForceStop = tea.Bool(input.ForceStop)
+	if _, err := client.DisassociateInstancesKeyPairsWithContext(ctx, request); err != nil {
+		return model.WrapCloudError(model.TENCENT, err)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package io
 
 import (
+	"context"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/spf13/cast"
 	ocr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ocr/v20181119"
@@ -10,7 +11,7 @@ import (
 )
 
 // CommonOCR
-func (c *tencentClient) CommonOCR(profile, region string, input model.OcrRequest) (model.OcrResponse, error) {
+func (c *tencentClient) CommonOCR(ctx context.Context, profile, region string, input model.OcrRequest) (model.OcrResponse, error) {
 	client, err := c.io.GetTencentOcrClient(profile, region)
 	if err != nil {
 		return model.OcrResponse{}, err
@@ -26,9 +27,9 @@ func (c *tencentClient) CommonOCR(profile, region string, input model.OcrRequest
 		request.LanguageType = input.LanguageType
 	}
 
-	response, err := client.GeneralBasicOCR(request)
+	response, err := client.GeneralBasicOCRWithContext(ctx, request)
 	if err != nil {
-		return model.OcrResponse{}, err
+		return model.OcrResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	var textDetections []model.TextDetection
 	for _, textDetection := range response.Response.TextDetections {
@@ -61,7 +62,7 @@ func (c *tencentClient) CommonOCR(profile, region string, input model.OcrRequest
 }
 
 // CreatePicture
-func (c *tencentClient) CreatePicture(profile, region string, input model.CreatePictureRequest) (model.CreatePictureResponse, error) {
+func (c *tencentClient) CreatePicture(ctx context.Context, profile, region string, input model.CreatePictureRequest) (model.CreatePictureResponse, error) {
 	client, err := c.io.GetTencentOcrTiiaClient(profile, region)
 	if err != nil {
 		return model.CreatePictureResponse{}, err
@@ -75,9 +76,9 @@ func (c *tencentClient) CreatePicture(profile, region string, input model.Create
 	request.ImageBase64 = input.ImageBase64
 	request.Tags = input.Tags
 
-	response, err := client.CreateImage(request)
+	response, err := client.CreateImageWithContext(ctx, request)
 	if err != nil {
-		return model.CreatePictureResponse{}, err
+		return model.CreatePictureResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	var object model.Object
 	if response.Response.Object != nil {
@@ -141,7 +142,7 @@ func tencentAttrsToModelAttrs(attrs []*tiia.Attribute) []*model.Attr {
 }
 
 // GetPictureByName
-func (c *tencentClient) GetPictureByName(profile, region string, input model.CommonPictureRequest) (model.GetPictureByNameResponse, error) {
+func (c *tencentClient) GetPictureByName(ctx context.Context, profile, region string, input model.CommonPictureRequest) (model.GetPictureByNameResponse, error) {
 	client, err := c.io.GetTencentOcrTiiaClient(profile, region)
 	if err != nil {
 		return model.GetPictureByNameResponse{}, err
@@ -152,9 +153,9 @@ func (c *tencentClient) GetPictureByName(profile, region string, input model.Com
 	request.GroupId = input.GroupId
 	request.PicName = input.PicName
 	// 返回的resp是一个DescribeImagesResponse的实例，与请求对象对应
-	response, err := client.DescribeImages(request)
+	response, err := client.DescribeImagesWithContext(ctx, request)
 	if err != nil {
-		return model.GetPictureByNameResponse{}, err
+		return model.GetPictureByNameResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	var imageInfos []model.ImageInfo
 	for _, imageInfo := range response.Response.ImageInfos {
@@ -175,7 +176,7 @@ func (c *tencentClient) GetPictureByName(profile, region string, input model.Com
 }
 
 // QueryPicture
-func (c *tencentClient) QueryPicture(profile, region string, input model.QueryPictureRequest) (model.QueryPictureResponse, error) {
+func (c *tencentClient) QueryPicture(ctx context.Context, profile, region string, input model.QueryPictureRequest) (model.QueryPictureResponse, error) {
 	client, err := c.io.GetTencentOcrTiiaClient(profile, region)
 	if err != nil {
 		return model.QueryPictureResponse{}, err
@@ -184,9 +185,9 @@ func (c *tencentClient) QueryPicture(profile, region string, input model.QueryPi
 	request := tiia.NewDescribeGroupsRequest()
 
 	// 返回的resp是一个DescribeGroupsResponse的实例，与请求对象对应
-	response, err := client.DescribeGroups(request)
+	response, err := client.DescribeGroupsWithContext(ctx, request)
 	if err != nil {
-		return model.QueryPictureResponse{}, err
+		return model.QueryPictureResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	var groupInfos []model.Group
 	for _, groupInfo := range response.Response.Groups {
@@ -208,7 +209,7 @@ func (c *tencentClient) QueryPicture(profile, region string, input model.QueryPi
 }
 
 // DeletePicture
-func (c *tencentClient) DeletePicture(profile, region string, input model.CommonPictureRequest) (model.CommonPictureResponse, error) {
+func (c *tencentClient) DeletePicture(ctx context.Context, profile, region string, input model.CommonPictureRequest) (model.CommonPictureResponse, error) {
 	client, err := c.io.GetTencentOcrTiiaClient(profile, region)
 	if err != nil {
 		return model.CommonPictureResponse{}, err
@@ -219,9 +220,9 @@ func (c *tencentClient) DeletePicture(profile, region string, input model.Common
 	request.GroupId = input.GroupId
 	request.PicName = input.PicName
 	// 返回的resp是一个DeleteImageResponse的实例，与请求对象对应
-	response, err := client.DeleteImages(request)
+	response, err := client.DeleteImagesWithContext(ctx, request)
 	if err != nil {
-		return model.CommonPictureResponse{}, err
+		return model.CommonPictureResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	return model.CommonPictureResponse{
 		RequestId: response.Response.RequestId,
@@ -229,7 +230,7 @@ func (c *tencentClient) DeletePicture(profile, region string, input model.Common
 }
 
 // UpdatePicture
-func (c *tencentClient) UpdatePicture(profile, region string, input model.UpdatePictureRequest) (model.CommonPictureResponse, error) {
+func (c *tencentClient) UpdatePicture(ctx context.Context, profile, region string, input model.UpdatePictureRequest) (model.CommonPictureResponse, error) {
 	client, err := c.io.GetTencentOcrTiiaClient(profile, region)
 	if err != nil {
 		return model.CommonPictureResponse{}, err
@@ -241,9 +242,9 @@ func (c *tencentClient) UpdatePicture(profile, region string, input model.Update
 	request.PicName = input.PicName
 	request.Tags = input.Tags
 	// 返回的resp是一个ModifyImageResponse的实例，与请求对象对应
-	response, err := client.UpdateImage(request)
+	response, err := client.UpdateImageWithContext(ctx, request)
 	if err != nil {
-		return model.CommonPictureResponse{}, err
+		return model.CommonPictureResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	return model.CommonPictureResponse{
 		RequestId: response.Response.RequestId,
@@ -251,7 +252,7 @@ func (c *tencentClient) UpdatePicture(profile, region string, input model.Update
 }
 
 // SearchPicture
-func (c *tencentClient) SearchPicture(profile, region string, input model.SearchPictureRequest) (model.SearchPictureResponse, error) {
+func (c *tencentClient) SearchPicture(ctx context.Context, profile, region string, input model.SearchPictureRequest) (model.SearchPictureResponse, error) {
 	client, err := c.io.GetTencentOcrTiiaClient(profile, region)
 	if err != nil {
 		return model.SearchPictureResponse{}, err
@@ -276,9 +277,9 @@ func (c *tencentClient) SearchPicture(profile, region string, input model.Search
 	request.EnableDetect = input.EnableDetect
 	request.CategoryId = input.CategoryId
 	// 返回的resp是一个SearchImageResponse的实例，与请求对象对应
-	response, err := client.SearchImage(request)
+	response, err := client.SearchImageWithContext(ctx, request)
 	if err != nil {
-		return model.SearchPictureResponse{}, err
+		return model.SearchPictureResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	var imageInfos []model.ImageInfo
 	for _, imageInfo := range response.Response.ImageInfos {
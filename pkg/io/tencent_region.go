@@ -0,0 +1,45 @@
+package io
+
+import (
+	"context"
+
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// ListRegions CVM 支持的地域，region 为调用接口的地域，为空时使用 ap-guangzhou
+func (c *tencentClient) ListRegions(ctx context.Context, profile, region string) ([]model.Region, error) {
+	if region == "" {
+		region = "ap-guangzhou"
+	}
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.DescribeRegionsWithContext(ctx, cvm.NewDescribeRegionsRequest())
+	if err != nil {
+		return nil, model.WrapCloudError(model.TENCENT, err)
+	}
+	regions := make([]model.Region, 0, len(resp.Response.RegionSet))
+	for _, r := range resp.Response.RegionSet {
+		regions = append(regions, model.NewRegionFromTencent(r))
+	}
+	return regions, nil
+}
+
+func (c *tencentClient) ListZones(ctx context.Context, profile, region string) ([]model.Zone, error) {
+	client, err := c.io.GetTencentCvmClient(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.DescribeZonesWithContext(ctx, cvm.NewDescribeZonesRequest())
+	if err != nil {
+		return nil, model.WrapCloudError(model.TENCENT, err)
+	}
+	zones := make([]model.Zone, 0, len(resp.Response.ZoneSet))
+	for _, z := range resp.Response.ZoneSet {
+		zones = append(zones, model.NewZoneFromTencent(region, z))
+	}
+	return zones, nil
+}
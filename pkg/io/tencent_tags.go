@@ -1,13 +1,14 @@
 package io
 
 import (
+	"context"
 	"strings"
 
 	tencentTag "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tag/v20180813"
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
-func (c *tencentClient) CreateTags(profile, region string, input model.CreateTagsInput) error {
+func (c *tencentClient) CreateTags(ctx context.Context, profile, region string, input model.CreateTagsInput) error {
 	svc, err := c.io.GetTencentTagsClient(profile, region)
 	if err != nil {
 		return err
@@ -16,18 +17,18 @@ func (c *tencentClient) CreateTags(profile, region string, input model.CreateTag
 	for _, tag := range input.Tags {
 		request.TagKey = &tag.Key
 		request.TagValue = &tag.Value
-		_, err = svc.CreateTag(request)
+		_, err = svc.CreateTagWithContext(ctx, request)
 		if err != nil {
 			if strings.Contains(err.Error(), "Message=tagKey-tagValue have exists.") {
 				continue
 			}
-			return err
+			return model.WrapCloudError(model.TENCENT, err)
 		}
 	}
 	return nil
 }
 
-func (c *tencentClient) AddTagsToResource(profile, region string, input model.AddTagsInput) error {
+func (c *tencentClient) AddTagsToResource(ctx context.Context, profile, region string, input model.AddTagsInput) error {
 	svc, err := c.io.GetTencentTagsClient(profile, region)
 	if err != nil {
 		return err
@@ -35,11 +36,11 @@ func (c *tencentClient) AddTagsToResource(profile, region string, input model.Ad
 	request := tencentTag.NewTagResourcesRequest()
 	request.ResourceList = input.ResourceList
 	request.Tags = input.Tags.ToTencentTags()
-	_, err = svc.TagResources(request)
-	return err
+	_, err = svc.TagResourcesWithContext(ctx, request)
+	return model.WrapCloudError(model.TENCENT, err)
 }
 
-func (c *tencentClient) RemoveTagsFromResource(profile, region string, input model.RemoveTagsInput) error {
+func (c *tencentClient) RemoveTagsFromResource(ctx context.Context, profile, region string, input model.RemoveTagsInput) error {
 	svc, err := c.io.GetTencentTagsClient(profile, region)
 	if err != nil {
 		return err
@@ -47,11 +48,11 @@ func (c *tencentClient) RemoveTagsFromResource(profile, region string, input mod
 	request := tencentTag.NewUnTagResourcesRequest()
 	request.ResourceList = input.ResourceList
 	request.TagKeys = input.Keys
-	_, err = svc.UnTagResources(request)
-	return err
+	_, err = svc.UnTagResourcesWithContext(ctx, request)
+	return model.WrapCloudError(model.TENCENT, err)
 }
 
-func (c *tencentClient) ModifyTagsForResource(profile, region string, input model.ModifyTagsInput) error {
+func (c *tencentClient) ModifyTagsForResource(ctx context.Context, profile, region string, input model.ModifyTagsInput) error {
 	svc, err := c.io.GetTencentTagsClient(profile, region)
 	if err != nil {
 		return err
@@ -60,6 +61,6 @@ func (c *tencentClient) ModifyTagsForResource(profile, region string, input mode
 	request.Resource = input.Resource
 	request.TagKey = input.Key
 	request.TagValue = input.Value
-	_, err = svc.UpdateResourceTagValue(request)
-	return err
+	_, err = svc.UpdateResourceTagValueWithContext(ctx, request)
+	return model.WrapCloudError(model.TENCENT, err)
 }
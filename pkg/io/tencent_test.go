@@ -1,6 +1,7 @@
 package io_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -48,7 +49,7 @@ func TestQueryTencentEmrCluster(t *testing.T) {
 			model.EMRClusterRunning,
 		},
 	}
-	instances, err := TencentIo.QueryEmrCluster(filter)
+	instances, err := TencentIo.QueryEmrCluster(context.Background(), filter)
 	if err != nil {
 		t.Error(err)
 		return
@@ -61,7 +62,7 @@ func TestQueryTencentEmrCluster(t *testing.T) {
 
 func TestDescribeTencentEmrCluster(t *testing.T) {
 	timeStart := time.Now()
-	instances, err := TencentIo.DescribeEmrCluster(model.DescribeInput{
+	instances, err := TencentIo.DescribeEmrCluster(context.Background(), model.DescribeInput{
 		Profile: tea.String("tencent"),
 		Region:  tea.String("ap-shanghai"),
 		// IDS:     []*string{tea.String("emr-alhn4h4s")},
@@ -112,7 +113,7 @@ func TestCreateEmrCluster(t *testing.T) {
 			},
 		},
 	}
-	instances, err := TencentIo.CreateEmrCluster("tencent", "ap-shanghai", input)
+	instances, err := TencentIo.CreateEmrCluster(context.Background(), "tencent", "ap-shanghai", input)
 	if err != nil {
 		t.Error(err)
 		return
@@ -123,7 +124,7 @@ func TestCreateEmrCluster(t *testing.T) {
 func TestListInstance(t *testing.T) {
 	timeStart := time.Now()
 	filter := model.InstanceFilter{}
-	instances, err := TencentIo.DescribeInstances("tencent", "ap-beijing", filter.ToTxDescribeInstancesInput())
+	instances, err := TencentIo.DescribeInstances(context.Background(), "tencent", "ap-beijing", filter.ToTxDescribeInstancesInput())
 	if err != nil {
 		t.Error(err)
 		return
@@ -136,7 +137,7 @@ func TestDescribeInstances(t *testing.T) {
 		timeStart := time.Now()
 		filter := model.InstanceFilter{}
 		filter.PrivateIp = tea.String(os.Getenv("TEST_TENCENT_PRIVATE_IP"))
-		instances, err := TencentIo.DescribeInstances("tencent", "ap-shanghai", filter.ToTxDescribeInstancesInput())
+		instances, err := TencentIo.DescribeInstances(context.Background(), "tencent", "ap-shanghai", filter.ToTxDescribeInstancesInput())
 		if err != nil {
 			t.Error(err)
 			return
@@ -147,7 +148,7 @@ func TestDescribeInstances(t *testing.T) {
 		timeStart := time.Now()
 		filter := model.InstanceFilter{}
 		filter.PublicIp = tea.String(os.Getenv("TEST_TENCENT_PUBLIC_IP"))
-		instances, err := TencentIo.DescribeInstances("tencent", "ap-shanghai", filter.ToTxDescribeInstancesInput())
+		instances, err := TencentIo.DescribeInstances(context.Background(), "tencent", "ap-shanghai", filter.ToTxDescribeInstancesInput())
 		if err != nil {
 			t.Error(err)
 			return
@@ -158,7 +159,7 @@ func TestDescribeInstances(t *testing.T) {
 		timeStart := time.Now()
 		filter := model.InstanceFilter{}
 		filter.Owner = tea.String("zhoushoujian")
-		instances, err := TencentIo.DescribeInstances("tencent", "ap-shanghai", filter.ToTxDescribeInstancesInput())
+		instances, err := TencentIo.DescribeInstances(context.Background(), "tencent", "ap-shanghai", filter.ToTxDescribeInstancesInput())
 		if err != nil {
 			t.Error(err)
 			return
@@ -169,7 +170,7 @@ func TestDescribeInstances(t *testing.T) {
 		timeStart := time.Now()
 		filter := model.InstanceFilter{}
 		filter.IDs = []*string{tea.String(os.Getenv("TEST_TENCENT_ID"))}
-		instances, err := TencentIo.DescribeInstances("tencent", "ap-shanghai", filter.ToTxDescribeInstancesInput())
+		instances, err := TencentIo.DescribeInstances(context.Background(), "tencent", "ap-shanghai", filter.ToTxDescribeInstancesInput())
 		if err != nil {
 			t.Error(err)
 			return
@@ -180,7 +181,7 @@ func TestDescribeInstances(t *testing.T) {
 		timeStart := time.Now()
 		filter := model.InstanceFilter{}
 		filter.Name = tea.String(os.Getenv("TEST_TENCENT_NAME"))
-		instances, err := TencentIo.DescribeInstances("tencent", "ap-shanghai", filter.ToTxDescribeInstancesInput())
+		instances, err := TencentIo.DescribeInstances(context.Background(), "tencent", "ap-shanghai", filter.ToTxDescribeInstancesInput())
 		if err != nil {
 			t.Error(err)
 			return
@@ -191,7 +192,7 @@ func TestDescribeInstances(t *testing.T) {
 		timeStart := time.Now()
 		filter := model.InstanceFilter{}
 		filter.Status = model.InstanceStatusStopped.TString()
-		instances, err := TencentIo.DescribeInstances("tencent", "ap-shanghai", filter.ToTxDescribeInstancesInput())
+		instances, err := TencentIo.DescribeInstances(context.Background(), "tencent", "ap-shanghai", filter.ToTxDescribeInstancesInput())
 		if err != nil {
 			t.Error(err)
 			return
@@ -209,7 +210,7 @@ func TestCreateTags(t *testing.T) {
 			},
 		},
 	}
-	err := TencentIo.CreateTags("tencent", "ap-shanghai", input)
+	err := TencentIo.CreateTags(context.Background(), "tencent", "ap-shanghai", input)
 	if err != nil {
 		t.Error(err)
 		return
@@ -218,7 +219,7 @@ func TestCreateTags(t *testing.T) {
 }
 
 func TestCreateInstance(t *testing.T) {
-	resp, err := TencentIo.CreateInstance("tencent", "ap-shanghai", model.CreateInstanceInput{
+	resp, err := TencentIo.CreateInstance(context.Background(), "tencent", "ap-shanghai", model.CreateInstanceInput{
 		Name:             tea.String("multi-cloud-sdk-test"),
 		ImageID:          tea.String("img-hdt9xxkt"),
 		InstanceType:     tea.String("SA5.MEDIUM2"),
@@ -240,7 +241,7 @@ func TestModifyInstance(t *testing.T) {
 
 	// // StartInstance
 	// {
-	// 	resp, err := TencentIo.ModifyInstance("tencent", "ap-shanghai", model.ModifyInstanceInput{
+	// 	resp, err := TencentIo.ModifyInstance(context.Background(), "tencent", "ap-shanghai", model.ModifyInstanceInput{
 	// 		Action:      model.StartInstance,
 	// 		InstanceIDs: instancesIds,
 	// 	})
@@ -253,7 +254,7 @@ func TestModifyInstance(t *testing.T) {
 	// }
 	// // RebootInstance
 	// {
-	// 	resp, err := TencentIo.ModifyInstance("tencent", "ap-shanghai", model.ModifyInstanceInput{
+	// 	resp, err := TencentIo.ModifyInstance(context.Background(), "tencent", "ap-shanghai", model.ModifyInstanceInput{
 	// 		Action:      model.RebootInstance,
 	// 		InstanceIDs: instancesIds,
 	// 	})
@@ -267,7 +268,7 @@ func TestModifyInstance(t *testing.T) {
 
 	// ResetInstance
 	{
-		resp, err := TencentIo.ModifyInstance("tencent", "ap-shanghai", model.ModifyInstanceInput{
+		resp, err := TencentIo.ModifyInstance(context.Background(), "tencent", "ap-shanghai", model.ModifyInstanceInput{
 			Action:      model.ResetInstance,
 			InstanceIDs: instancesIds,
 		})
@@ -281,7 +282,7 @@ func TestModifyInstance(t *testing.T) {
 
 	// StopInstance
 	// {
-	// 	resp, err := TencentIo.ModifyInstance("tencent", "ap-shanghai", model.ModifyInstanceInput{
+	// 	resp, err := TencentIo.ModifyInstance(context.Background(), "tencent", "ap-shanghai", model.ModifyInstanceInput{
 	// 		Action:      model.StopInstance,
 	// 		InstanceIDs: instancesIds,
 	// 	})
@@ -295,7 +296,7 @@ func TestModifyInstance(t *testing.T) {
 
 	// ChangeInstanceType
 	// {
-	// 	resp, err := TencentIo.ModifyInstance("tencent", "ap-shanghai", model.ModifyInstanceInput{
+	// 	resp, err := TencentIo.ModifyInstance(context.Background(), "tencent", "ap-shanghai", model.ModifyInstanceInput{
 	// 		Action:       model.ChangeInstanceType,
 	// 		InstanceIDs:  instancesIds,
 	// 		InstanceType: tea.String("SA5.MEDIUM2"),
@@ -309,7 +310,7 @@ func TestModifyInstance(t *testing.T) {
 }
 
 func TestChangeInstanceType(t *testing.T) {
-	resp, err := TencentIo.ModifyInstance("tencent", "ap-shanghai", model.ModifyInstanceInput{
+	resp, err := TencentIo.ModifyInstance(context.Background(), "tencent", "ap-shanghai", model.ModifyInstanceInput{
 		Action:       model.ChangeInstanceType,
 		InstanceIDs:  []*string{tea.String("ins-k7fdkyi1")},
 		InstanceType: tea.String("SA5.2XLARGE32"),
@@ -323,7 +324,7 @@ func TestChangeInstanceType(t *testing.T) {
 
 // TestResetInstance
 func TestResetInstance(t *testing.T) {
-	resp, err := TencentIo.ModifyInstance("tencent", "ap-shanghai", model.ModifyInstanceInput{
+	resp, err := TencentIo.ModifyInstance(context.Background(), "tencent", "ap-shanghai", model.ModifyInstanceInput{
 		Action:      model.ResetInstance,
 		InstanceIDs: []*string{tea.String("ins-xx")},
 	})
@@ -335,7 +336,7 @@ func TestResetInstance(t *testing.T) {
 }
 
 func TestDeleteInstance(t *testing.T) {
-	resp, err := TencentIo.DeleteInstance("tencent", "ap-shanghai", model.DeleteInstanceInput{
+	resp, err := TencentIo.DeleteInstance(context.Background(), "tencent", "ap-shanghai", model.DeleteInstanceInput{
 		InstanceIds: []*string{tea.String("ins-xx")},
 	})
 	if err != nil {
@@ -347,7 +348,7 @@ func TestDeleteInstance(t *testing.T) {
 
 // TEST CreateSecurityGroupWithPolicies
 func TestCreateSecurityGroupWithPolicies(t *testing.T) {
-	resp, err := TencentIo.CreateSecurityGroupWithPolicies("tencent", "ap-beijing", model.CreateSecurityGroupWithPoliciesInput{
+	resp, err := TencentIo.CreateSecurityGroupWithPolicies(context.Background(), "tencent", "ap-beijing", model.CreateSecurityGroupWithPoliciesInput{
 		GroupName:        tea.String("-test"),
 		GroupDescription: tea.String("multi-cloud-sdk-test"),
 		PolicySet: model.PolicySet{
@@ -407,7 +408,7 @@ func TestCreateSecurityGroupWithPolicies1(t *testing.T) {
 			Action:            tea.String("ACCEPT"),
 		})
 	}
-	resp, err := TencentIo.CreateSecurityGroupWithPolicies("tencent", "na-ashburn", model.CreateSecurityGroupWithPoliciesInput{
+	resp, err := TencentIo.CreateSecurityGroupWithPolicies(context.Background(), "tencent", "na-ashburn", model.CreateSecurityGroupWithPoliciesInput{
 		GroupName:        tea.String("0066_aws_cp_ftp"),
 		GroupDescription: tea.String("multi-cloud-sdk"),
 		PolicySet: model.PolicySet{
@@ -447,7 +448,7 @@ func TestCreateSecurityGroupPolicies(t *testing.T) {
 		})
 	}
 	fmt.Println(tea.Prettify(ingress))
-	resp, err := TencentIo.CreateSecurityGroupPolicies("tencent", "ap-beijing", model.CreateSecurityGroupPoliciesInput{
+	resp, err := TencentIo.CreateSecurityGroupPolicies(context.Background(), "tencent", "ap-beijing", model.CreateSecurityGroupPoliciesInput{
 		SecurityGroupId: tea.String("sg-xxx"),
 		PolicySet: model.PolicySet{
 			Ingress: ingress,
@@ -0,0 +1,217 @@
+package io
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+const tencentCbsVersion = "2017-03-12"
+
+// DescribeDisks 返回的云硬盘，DiskState 为 UNATTACHED、ATTACHING、ATTACHED、DETACHING、EXPANDING、ROLLBACKING、TORECYCLE、DUMPING
+type tencentDisk struct {
+	DiskId                *string `json:"DiskId"`
+	DiskName              *string `json:"DiskName"`
+	DiskUsage             *string `json:"DiskUsage"` // SYSTEM_DISK 或 DATA_DISK
+	DiskType              *string `json:"DiskType"`
+	DiskSize              *int64  `json:"DiskSize"`
+	DiskState             *string `json:"DiskState"`
+	Attached              *bool   `json:"Attached"`
+	InstanceId            *string `json:"InstanceId"`
+	DeleteWithInstance    *bool   `json:"DeleteWithInstance"`
+	Encrypt               *bool   `json:"Encrypt"`
+	ThroughputPerformance *int64  `json:"ThroughputPerformance"`
+	CreateTime            *string `json:"CreateTime"`
+	Placement             *struct {
+		Zone *string `json:"Zone"`
+	} `json:"Placement"`
+	Tags []*struct {
+		Key   *string `json:"Key"`
+		Value *string `json:"Value"`
+	} `json:"Tags"`
+}
+
+func newVolumeFromTencent(disk *tencentDisk) model.Volume {
+	volume := model.Volume{
+		VolumeId:   disk.DiskId,
+		Name:       disk.DiskName,
+		SizeGiB:    tea.Int64Value(disk.DiskSize),
+		Type:       disk.DiskType,
+		Throughput: tea.Int64Value(disk.ThroughputPerformance),
+		Encrypted:  tea.BoolValue(disk.Encrypt),
+		SystemDisk: tea.StringValue(disk.DiskUsage) == "SYSTEM_DISK",
+		Tags:       &model.Tags{},
+		Meta:       disk,
+	}
+	if disk.Placement != nil {
+		volume.Zone = disk.Placement.Zone
+	}
+	switch tea.StringValue(disk.DiskState) {
+	case "UNATTACHED":
+		volume.State = model.VolumeStateAvailable
+	case "ATTACHED":
+		volume.State = model.VolumeStateInUse
+	case "TORECYCLE":
+		volume.State = model.VolumeStateDeleting
+	default:
+		volume.State = model.VolumeStatePending
+	}
+	if tea.BoolValue(disk.Attached) && tea.StringValue(disk.InstanceId) != "" {
+		volume.Attachments = []model.VolumeAttachment{{
+			InstanceId:         disk.InstanceId,
+			DeleteWithInstance: tea.BoolValue(disk.DeleteWithInstance),
+		}}
+	}
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", tea.StringValue(disk.CreateTime), time.FixedZone("CST", 8*3600)); err == nil {
+		volume.CreatedTime = &t
+	}
+	for _, tag := range disk.Tags {
+		*volume.Tags = append(*volume.Tags, model.Tag{Key: tea.StringValue(tag.Key), Value: tea.StringValue(tag.Value)})
+	}
+	return volume
+}
+
+func (c *tencentClient) DescribeVolumes(ctx context.Context, profile, region string, input model.DescribeVolumesRequest) ([]model.Volume, error) {
+	client, err := c.io.GetTencentCbsClient(profile, region)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]interface{}{"Limit": 100}
+	if len(input.VolumeIds) > 0 {
+		params["DiskIds"] = input.VolumeIds
+	}
+	var filters []map[string]interface{}
+	if input.InstanceId != nil {
+		filters = append(filters, map[string]interface{}{"Name": "instance-id", "Values": []string{*input.InstanceId}})
+	}
+	if input.Zone != nil {
+		filters = append(filters, map[string]interface{}{"Name": "zone", "Values": []string{*input.Zone}})
+	}
+	if len(filters) > 0 {
+		params["Filters"] = filters
+	}
+
+	var volumes []model.Volume
+	for offset := 0; ; offset += 100 {
+		params["Offset"] = offset
+		var resp struct {
+			TotalCount int            `json:"TotalCount"`
+			DiskSet    []*tencentDisk `json:"DiskSet"`
+		}
+		if err := sendTencentCommonRequest(ctx, client, "cbs", tencentCbsVersion, "DescribeDisks", params, &resp); err != nil {
+			return nil, err
+		}
+		for _, disk := range resp.DiskSet {
+			volumes = append(volumes, newVolumeFromTencent(disk))
+		}
+		if len(resp.DiskSet) == 0 || offset+len(resp.DiskSet) >= resp.TotalCount {
+			break
+		}
+	}
+	return volumes, nil
+}
+
+// CreateVolume 按量计费，腾讯云不支持指定 IOPS
+func (c *tencentClient) CreateVolume(ctx context.Context, profile, region string, input model.CreateVolumeRequest) (model.CreateVolumeResponse, error) {
+	if input.Iops != nil {
+		return model.CreateVolumeResponse{}, fmt.Errorf("%w: tencent cbs does not support iops", model.ErrInvalidInput)
+	}
+	client, err := c.io.GetTencentCbsClient(profile, region)
+	if err != nil {
+		return model.CreateVolumeResponse{}, err
+	}
+	params := map[string]interface{}{
+		"Placement":      map[string]interface{}{"Zone": tea.StringValue(input.Zone)},
+		"DiskChargeType": "POSTPAID_BY_HOUR",
+		"DiskType":       string(model.TencenteDiskTypeCLOUD_PREMIUM),
+		"DiskCount":      1,
+	}
+	if input.Type != nil {
+		params["DiskType"] = *input.Type
+	}
+	if input.SizeGiB > 0 {
+		params["DiskSize"] = input.SizeGiB
+	}
+	if input.Name != nil {
+		params["DiskName"] = *input.Name
+	}
+	if input.SnapshotId != nil {
+		params["SnapshotId"] = *input.SnapshotId
+	}
+	if input.Throughput != nil {
+		params["ThroughputPerformance"] = *input.Throughput
+	}
+	if input.Encrypted || input.KmsKeyId != nil {
+		params["Encrypt"] = "ENCRYPT"
+	}
+	if input.KmsKeyId != nil {
+		params["KmsKeyId"] = *input.KmsKeyId
+	}
+	if len(input.Tags) > 0 {
+		var tags []map[string]string
+		for _, tag := range input.Tags {
+			tags = append(tags, map[string]string{"Key": tag.Key, "Value": tag.Value})
+		}
+		params["Tags"] = tags
+	}
+	var resp struct {
+		DiskIdSet []*string `json:"DiskIdSet"`
+	}
+	if err := sendTencentCommonRequest(ctx, client, "cbs", tencentCbsVersion, "CreateDisks", params, &resp); err != nil {
+		return model.CreateVolumeResponse{}, err
+	}
+	if len(resp.DiskIdSet) == 0 {
+		return model.CreateVolumeResponse{}, fmt.Errorf("tencent CreateDisks returned no disk id")
+	}
+	return model.CreateVolumeResponse{VolumeId: resp.DiskIdSet[0], Meta: resp}, nil
+}
+
+func (c *tencentClient) ResizeVolume(ctx context.Context, profile, region string, input model.ResizeVolumeRequest) error {
+	client, err := c.io.GetTencentCbsClient(profile, region)
+	if err != nil {
+		return err
+	}
+	return sendTencentCommonRequest(ctx, client, "cbs", tencentCbsVersion, "ResizeDisk", map[string]interface{}{
+		"DiskId":   tea.StringValue(input.VolumeId),
+		"DiskSize": input.SizeGiB,
+	}, &struct{}{})
+}
+
+func (c *tencentClient) AttachVolume(ctx context.Context, profile, region string, input model.AttachVolumeRequest) error {
+	client, err := c.io.GetTencentCbsClient(profile, region)
+	if err != nil {
+		return err
+	}
+	return sendTencentCommonRequest(ctx, client, "cbs", tencentCbsVersion, "AttachDisks", map[string]interface{}{
+		"DiskIds":            []string{tea.StringValue(input.VolumeId)},
+		"InstanceId":         tea.StringValue(input.InstanceId),
+		"DeleteWithInstance": input.DeleteWithInstance,
+	}, &struct{}{})
+}
+
+func (c *tencentClient) DetachVolume(ctx context.Context, profile, region string, input model.DetachVolumeRequest) error {
+	client, err := c.io.GetTencentCbsClient(profile, region)
+	if err != nil {
+		return err
+	}
+	params := map[string]interface{}{"DiskIds": []string{tea.StringValue(input.VolumeId)}}
+	if input.InstanceId != nil {
+		params["InstanceId"] = *input.InstanceId
+	}
+	return sendTencentCommonRequest(ctx, client, "cbs", tencentCbsVersion, "DetachDisks", params, &struct{}{})
+}
+
+// DeleteVolume 按量计费的云硬盘直接销毁
+func (c *tencentClient) DeleteVolume(ctx context.Context, profile, region string, input model.DeleteVolumeRequest) error {
+	client, err := c.io.GetTencentCbsClient(profile, region)
+	if err != nil {
+		return err
+	}
+	return sendTencentCommonRequest(ctx, client, "cbs", tencentCbsVersion, "TerminateDisks", map[string]interface{}{
+		"DiskIds": []string{tea.StringValue(input.VolumeId)},
+	}, &struct{}{})
+}
@@ -1,17 +1,16 @@
 package io
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/spf13/cast"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
-	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	tencentVpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
-func (c *tencentClient) QueryVPC(profile, region string, input model.CommonFilter) ([]model.VPC, error) {
+func (c *tencentClient) QueryVPC(ctx context.Context, profile, region string, input model.CommonFilter) ([]model.VPC, error) {
 	client, err := c.io.GetTencentVpcClient(profile, region)
 	if err != nil {
 		return nil, err
@@ -25,9 +24,9 @@ func (c *tencentClient) QueryVPC(profile, region string, input model.CommonFilte
 			},
 		}
 	}
-	response, err := client.DescribeVpcs(request)
+	response, err := client.DescribeVpcsWithContext(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, model.WrapCloudError(model.TENCENT, err)
 	}
 	var vpcs []model.VPC
 	for _, vpc := range response.Response.VpcSet {
@@ -46,7 +45,7 @@ func (c *tencentClient) QueryVPC(profile, region string, input model.CommonFilte
 }
 
 // QuerySubnet
-func (c *tencentClient) QuerySubnet(profile, region string, input model.CommonFilter) ([]model.Subnet, error) {
+func (c *tencentClient) QuerySubnet(ctx context.Context, profile, region string, input model.CommonFilter) ([]model.Subnet, error) {
 	client, err := c.io.GetTencentVpcClient(profile, region)
 	if err != nil {
 		return nil, err
@@ -60,9 +59,9 @@ func (c *tencentClient) QuerySubnet(profile, region string, input model.CommonFi
 			},
 		}
 	}
-	response, err := client.DescribeSubnets(request)
+	response, err := client.DescribeSubnetsWithContext(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, model.WrapCloudError(model.TENCENT, err)
 	}
 	var subnets []model.Subnet
 	for _, subnet := range response.Response.SubnetSet {
@@ -88,7 +87,7 @@ func (c *tencentClient) QuerySubnet(profile, region string, input model.CommonFi
 }
 
 // QueryEIP
-func (c *tencentClient) QueryEIP(profile, region string, input model.CommonFilter) ([]model.EIP, error) {
+func (c *tencentClient) QueryEIP(ctx context.Context, profile, region string, input model.CommonFilter) ([]model.EIP, error) {
 	client, err := c.io.GetTencentVpcClient(profile, region)
 	if err != nil {
 		return nil, err
@@ -102,9 +101,9 @@ func (c *tencentClient) QueryEIP(profile, region string, input model.CommonFilte
 			},
 		}
 	}
-	response, err := client.DescribeAddresses(request)
+	response, err := client.DescribeAddressesWithContext(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, model.WrapCloudError(model.TENCENT, err)
 	}
 	var eips []model.EIP
 	for _, eip := range response.Response.AddressSet {
@@ -128,7 +127,7 @@ func (c *tencentClient) QueryEIP(profile, region string, input model.CommonFilte
 }
 
 // QueryNAT
-func (c *tencentClient) QueryNAT(profile, region string, input model.CommonFilter) ([]model.NAT, error) {
+func (c *tencentClient) QueryNAT(ctx context.Context, profile, region string, input model.CommonFilter) ([]model.NAT, error) {
 	client, err := c.io.GetTencentVpcClient(profile, region)
 	if err != nil {
 		return nil, err
@@ -142,9 +141,9 @@ func (c *tencentClient) QueryNAT(profile, region string, input model.CommonFilte
 			},
 		}
 	}
-	response, err := client.DescribeNatGateways(request)
+	response, err := client.DescribeNatGatewaysWithContext(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, model.WrapCloudError(model.TENCENT, err)
 	}
 	var nats []model.NAT
 	for _, nat := range response.Response.NatGatewaySet {
@@ -166,7 +165,7 @@ func (c *tencentClient) QueryNAT(profile, region string, input model.CommonFilte
 	return nats, nil
 }
 
-func (c *tencentClient) CreateSecurityGroupWithPolicies(profile, region string, input model.CreateSecurityGroupWithPoliciesInput) (model.CreateSecurityGroupWithPoliciesResponse, error) {
+func (c *tencentClient) CreateSecurityGroupWithPolicies(ctx context.Context, profile, region string, input model.CreateSecurityGroupWithPoliciesInput) (model.CreateSecurityGroupWithPoliciesResponse, error) {
 	client, err := c.io.GetTencentVpcClient(profile, region)
 	if err != nil {
 		return model.CreateSecurityGroupWithPoliciesResponse{}, err
@@ -176,16 +175,16 @@ func (c *tencentClient) CreateSecurityGroupWithPolicies(profile, region string,
 	request.GroupDescription = input.GroupDescription
 	// request.ProjectId
 	request.SecurityGroupPolicySet = input.PolicySet.ToTencentPolicySet()
-	response, err := client.CreateSecurityGroupWithPolicies(request)
+	response, err := client.CreateSecurityGroupWithPoliciesWithContext(ctx, request)
 	if err != nil {
-		return model.CreateSecurityGroupWithPoliciesResponse{}, err
+		return model.CreateSecurityGroupWithPoliciesResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	return model.CreateSecurityGroupWithPoliciesResponse{
 		Data: response,
 	}, nil
 }
 
-func (c *tencentClient) CreateSecurityGroupPolicies(profile, region string, input model.CreateSecurityGroupPoliciesInput) (model.CreateSecurityGroupPoliciesResponse, error) {
+func (c *tencentClient) CreateSecurityGroupPolicies(ctx context.Context, profile, region string, input model.CreateSecurityGroupPoliciesInput) (model.CreateSecurityGroupPoliciesResponse, error) {
 	client, err := c.io.GetTencentVpcClient(profile, region)
 	if err != nil {
 		return model.CreateSecurityGroupPoliciesResponse{}, err
@@ -196,12 +195,9 @@ func (c *tencentClient) CreateSecurityGroupPolicies(profile, region string, inpu
 	request.SecurityGroupId = input.SecurityGroupId
 
 	// 返回的resp是一个CreateSecurityGroupPoliciesResponse的实例，与请求对象对应
-	response, err := client.CreateSecurityGroupPolicies(request)
-	if _, ok := err.(*errors.TencentCloudSDKError); ok {
-		return model.CreateSecurityGroupPoliciesResponse{}, fmt.Errorf("an api error has returned: %s", err)
-	}
+	response, err := client.CreateSecurityGroupPoliciesWithContext(ctx, request)
 	if err != nil {
-		return model.CreateSecurityGroupPoliciesResponse{}, err
+		return model.CreateSecurityGroupPoliciesResponse{}, model.WrapCloudError(model.TENCENT, err)
 	}
 	return model.CreateSecurityGroupPoliciesResponse{
 		Result: response,
@@ -1,12 +1,15 @@
 package model
 
 import (
+	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53domains"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	dnspod "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dnspod/v20210323"
 	tencentEmr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/emr/v20190103"
@@ -36,12 +39,21 @@ type ClientIo interface {
 	GetTencentOcrTiiaClient(profile, region string) (*tiia.Client, error)
 	GetTencentDnsPodClient(profile string) (*dnspod.Client, error)
 	GetTencentPrivateDNSClient(profile string) (*privatedns.Client, error)
+	GetTencentDomainClient(profile string) (*common.Client, error)
+	GetTencentCbsClient(profile, region string) (*common.Client, error)
+
+	// 阿里云 ECS/VPC/AliDNS 使用通用的 OpenAPI 客户端，按产品设置好 endpoint
+	GetAliyunEcsClient(profile, region string) (*openapi.Client, error)
+	GetAliyunVpcClient(profile, region string) (*openapi.Client, error)
+	GetAliyunDnsClient(profile string) (*openapi.Client, error)
+	GetAliyunOssClient(profile, region string) (*oss.Client, error)
 }
 
 type ProfileConfig struct {
-	Name string `mapstructure:"name" binding:"required"`
-	AK   string `mapstructure:"ak" binding:"required"`
-	SK   string `mapstructure:"sk" binding:"required"`
-	// Regions []string `mapstructure:"regions" binding:"required"` // init clinet must have region
-	Cloud Cloud `mapstructure:"cloud" binding:"required"`
+	Name    string   `mapstructure:"name" binding:"required"`
+	AK      string   `mapstructure:"ak" binding:"required"`
+	SK      string   `mapstructure:"sk" binding:"required"`
+	Regions []string `mapstructure:"regions"` // 可选，多地域并发查询时默认查询的地域
+	Region  string   `mapstructure:"region"`  // 可选，没有 region 参数的全局服务使用，比如 aws route53 私有域，中国区填 cn-northwest-1，默认 us-east-1
+	Cloud   Cloud    `mapstructure:"cloud" binding:"required"`
 }
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"reflect"
+	"sort"
+)
+
+// NotImplementedReporter CloudIO 的实现可以同时实现这个接口，返回未实现的操作（CloudIO 方法名）
+// 未实现的操作调用时返回 ErrNotImplemented
+type NotImplementedReporter interface {
+	NotImplemented() []string
+}
+
+// RegionlessReporter CloudIO 的实现可以同时实现这个接口，返回资源所有地域通用的操作（CloudIO 方法名），
+// 比如腾讯云的密钥对，这些操作在一个账号下执行一次即可
+type RegionlessReporter interface {
+	Regionless() []string
+}
+
+// Capabilities 某个云支持的操作，操作名为 CloudIO 的方法名，比如 CreateInstance
+type Capabilities struct {
+	Cloud          Cloud    `json:"cloud"`
+	Supported      []string `json:"supported"`
+	NotImplemented []string `json:"not_implemented"`
+	Regionless     []string `json:"regionless"`
+}
+
+// Supports 操作是否已实现
+func (c Capabilities) Supports(operation string) bool {
+	i := sort.SearchStrings(c.Supported, operation)
+	return i < len(c.Supported) && c.Supported[i] == operation
+}
+
+// IsRegionless 操作的资源是否所有地域通用
+func (c Capabilities) IsRegionless(operation string) bool {
+	i := sort.SearchStrings(c.Regionless, operation)
+	return i < len(c.Regionless) && c.Regionless[i] == operation
+}
+
+// CloudIOOperations 返回 CloudIO 的全部操作名，按字母排序
+func CloudIOOperations() []string {
+	t := reflect.TypeOf((*CloudIO)(nil)).Elem()
+	operations := make([]string, 0, t.NumMethod())
+	for i := 0; i < t.NumMethod(); i++ {
+		operations = append(operations, t.Method(i).Name)
+	}
+	sort.Strings(operations)
+	return operations
+}
+
+// NewCapabilities 根据 provider 上报的未实现操作计算支持的操作，没有实现 NotImplementedReporter 视为全部支持
+func NewCapabilities(cloud Cloud, provider CloudIO) Capabilities {
+	notImplemented := map[string]bool{}
+	if reporter, ok := provider.(NotImplementedReporter); ok {
+		for _, operation := range reporter.NotImplemented() {
+			notImplemented[operation] = true
+		}
+	}
+	capabilities := Capabilities{Cloud: cloud, Supported: []string{}, NotImplemented: []string{}, Regionless: []string{}}
+	if reporter, ok := provider.(RegionlessReporter); ok {
+		capabilities.Regionless = append(capabilities.Regionless, reporter.Regionless()...)
+		sort.Strings(capabilities.Regionless)
+	}
+	for _, operation := range CloudIOOperations() {
+		if notImplemented[operation] {
+			capabilities.NotImplemented = append(capabilities.NotImplemented, operation)
+			continue
+		}
+		capabilities.Supported = append(capabilities.Supported, operation)
+	}
+	return capabilities
+}
@@ -11,6 +11,7 @@ func (i Cloud) ToString() string {
 const (
 	AWS     Cloud = "aws"
 	TENCENT Cloud = "tencent"
+	ALIYUN  Cloud = "aliyun"
 )
 
 type InstanceStatus string
@@ -36,6 +37,8 @@ const (
 	InstanceStatusTerminated InstanceStatus = "TERMINATED"
 	// STOPPING
 	InstanceStatusStopping InstanceStatus = "STOPPING"
+	// LAUNCH_FAILED 腾讯云创建失败
+	InstanceStatusLaunchFailed InstanceStatus = "LAUNCH_FAILED"
 )
 
 func ToInstanceStatus(s string) InstanceStatus {
@@ -54,6 +57,8 @@ func ToInstanceStatus(s string) InstanceStatus {
 		return InstanceStatusTerminated
 	case "STOPPING", "SHUTTING-DOWN":
 		return InstanceStatusStopping
+	case "LAUNCH_FAILED":
+		return InstanceStatusLaunchFailed
 	default:
 		fmt.Printf("unknown instance status: [%s]\n", s)
 		return InstanceStatusUnknown
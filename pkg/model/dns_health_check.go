@@ -0,0 +1,174 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+type HealthCheckType string
+
+const (
+	HealthCheckHTTP  HealthCheckType = "HTTP"
+	HealthCheckHTTPS HealthCheckType = "HTTPS"
+	HealthCheckTCP   HealthCheckType = "TCP"
+)
+
+// HealthCheck 通过 RoutingPolicy.HealthCheckId 关联到 failover、weighted 等记录
+type HealthCheck struct {
+	Id               *string         `json:"id"`
+	Name             *string         `json:"name"` // aws 为 Name 标签
+	Type             HealthCheckType `json:"type"`
+	IPAddress        *string         `json:"ip_address"`
+	Domain           *string         `json:"domain"` // 检查的域名，HTTP 时同时作为 Host
+	Port             *int64          `json:"port"`
+	Path             *string         `json:"path"`              // HTTP、HTTPS 的路径
+	Interval         *int64          `json:"interval"`          // 检查间隔，单位秒
+	FailureThreshold *int64          `json:"failure_threshold"` // 连续失败多少次判定为不健康
+	Disabled         *bool           `json:"disabled"`
+	Meta             interface{}     `json:"meta"`
+}
+
+type CreateHealthCheckRequest struct {
+	Name             *string         `json:"name"`
+	Type             HealthCheckType `json:"type" binding:"required"`
+	IPAddress        *string         `json:"ip_address"` // IPAddress 和 Domain 至少一个
+	Domain           *string         `json:"domain"`
+	Port             *int64          `json:"port"` // 默认 HTTP 80，HTTPS 443，TCP 必填
+	Path             *string         `json:"path"`
+	Interval         *int64          `json:"interval"`          // aws 只支持 10、30，默认 30，创建后不能修改
+	FailureThreshold *int64          `json:"failure_threshold"` // 默认 3
+}
+
+func (r CreateHealthCheckRequest) Validate() error {
+	switch r.Type {
+	case HealthCheckHTTP, HealthCheckHTTPS:
+	case HealthCheckTCP:
+		if r.Port == nil {
+			return fmt.Errorf("port is required for TCP health check")
+		}
+	default:
+		return fmt.Errorf("health check type must be HTTP, HTTPS or TCP, got %q", r.Type)
+	}
+	if r.IPAddress == nil && r.Domain == nil {
+		return fmt.Errorf("ip address or domain is required")
+	}
+	return nil
+}
+
+type CreateHealthCheckResponse struct {
+	Id   *string     `json:"id"`
+	Meta interface{} `json:"meta"`
+}
+
+// ModifyHealthCheckRequest 为空的字段不修改，类型和检查间隔不能修改
+type ModifyHealthCheckRequest struct {
+	Id               *string `json:"id" binding:"required"`
+	Name             *string `json:"name"`
+	IPAddress        *string `json:"ip_address"`
+	Domain           *string `json:"domain"`
+	Port             *int64  `json:"port"`
+	Path             *string `json:"path"`
+	FailureThreshold *int64  `json:"failure_threshold"`
+	Disabled         *bool   `json:"disabled"`
+}
+
+type HealthCheckState string
+
+const (
+	HealthCheckHealthy   HealthCheckState = "Healthy"
+	HealthCheckUnhealthy HealthCheckState = "Unhealthy"
+	HealthCheckUnknown   HealthCheckState = "Unknown" // 还没有检查结果
+)
+
+type HealthCheckStatus struct {
+	Id           *string                  `json:"id"`
+	Status       HealthCheckState         `json:"status"`
+	Observations []HealthCheckObservation `json:"observations"` // 各个检查点的结果
+}
+
+type HealthCheckObservation struct {
+	Region    *string `json:"region"`
+	IPAddress *string `json:"ip_address"` // 检查点的地址
+	Healthy   bool    `json:"healthy"`
+	Message   *string `json:"message"`
+}
+
+// ToAwsHealthCheckConfig 补全默认的端口、间隔和失败次数
+func (r CreateHealthCheckRequest) ToAwsHealthCheckConfig() *route53.HealthCheckConfig {
+	config := &route53.HealthCheckConfig{
+		Type:                     aws.String(string(r.Type)),
+		IPAddress:                r.IPAddress,
+		FullyQualifiedDomainName: r.Domain,
+		Port:                     r.Port,
+		ResourcePath:             r.Path,
+		RequestInterval:          r.Interval,
+		FailureThreshold:         r.FailureThreshold,
+	}
+	if config.Port == nil {
+		config.Port = aws.Int64(80)
+		if r.Type == HealthCheckHTTPS {
+			config.Port = aws.Int64(443)
+		}
+	}
+	if config.RequestInterval == nil {
+		config.RequestInterval = aws.Int64(30)
+	}
+	if config.FailureThreshold == nil {
+		config.FailureThreshold = aws.Int64(3)
+	}
+	return config
+}
+
+// NewHealthCheckFromAws name 来自 Name 标签
+func NewHealthCheckFromAws(healthCheck *route53.HealthCheck, name *string) HealthCheck {
+	config := healthCheck.HealthCheckConfig
+	if config == nil {
+		config = &route53.HealthCheckConfig{}
+	}
+	return HealthCheck{
+		Id:               healthCheck.Id,
+		Name:             name,
+		Type:             HealthCheckType(aws.StringValue(config.Type)),
+		IPAddress:        config.IPAddress,
+		Domain:           config.FullyQualifiedDomainName,
+		Port:             config.Port,
+		Path:             config.ResourcePath,
+		Interval:         config.RequestInterval,
+		FailureThreshold: config.FailureThreshold,
+		Disabled:         config.Disabled,
+		Meta:             healthCheck,
+	}
+}
+
+// NewHealthCheckStatusFromAws 和 Route53 一致，超过 18% 的检查点健康即视为健康
+func NewHealthCheckStatusFromAws(id *string, observations []*route53.HealthCheckObservation) HealthCheckStatus {
+	status := HealthCheckStatus{Id: id, Status: HealthCheckUnknown}
+	healthy := 0
+	for _, observation := range observations {
+		var message *string
+		if observation.StatusReport != nil {
+			message = observation.StatusReport.Status
+		}
+		ok := strings.HasPrefix(tea.StringValue(message), "Success")
+		if ok {
+			healthy++
+		}
+		status.Observations = append(status.Observations, HealthCheckObservation{
+			Region:    observation.Region,
+			IPAddress: observation.IPAddress,
+			Healthy:   ok,
+			Message:   message,
+		})
+	}
+	if len(observations) > 0 {
+		status.Status = HealthCheckUnhealthy
+		if float64(healthy)/float64(len(observations)) > 0.18 {
+			status.Status = HealthCheckHealthy
+		}
+	}
+	return status
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+type DescribeRecordLineListRequest struct {
+	Domain      *string `json:"domain" binding:"required"`
+	DomainGrade *string `json:"domain_grade"` // 腾讯云域名套餐，比如 DP_FREE，不传时使用域名当前的套餐
+}
+
+type DescribeRecordLineListResponse struct {
+	Lines []RecordLineInfo `json:"lines"`
+	Meta  interface{}      `json:"meta"`
+}
+
+// RecordLineInfo 创建记录时 LineId 对应 RecordLineId，Name 对应 RecordLine
+type RecordLineInfo struct {
+	Name   *string `json:"name"`    // 比如 电信、境外，aws 为国家或者大洲的名称
+	LineId *string `json:"line_id"` // 腾讯云比如 10=0，阿里云为线路代码比如 telecom，aws 比如 country/CN
+}
+
+// RecordLineGroup 同一线路的记录
+type RecordLineGroup struct {
+	RecordLine   *string  `json:"record_line"`
+	RecordLineId *string  `json:"record_line_id"`
+	Records      []Record `json:"records"`
+}
+
+// GroupRecordsByLine 按线路 ID 分组，没有线路 ID 时按线路名称，都没有的记录在同一组，组的顺序为第一次出现的顺序
+func GroupRecordsByLine(records []Record) []RecordLineGroup {
+	var groups []RecordLineGroup
+	index := map[string]int{}
+	for _, record := range records {
+		key := tea.StringValue(record.RecordLineId)
+		if key == "" {
+			key = "name:" + tea.StringValue(record.RecordLine)
+		}
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, RecordLineGroup{RecordLine: record.RecordLine, RecordLineId: record.RecordLineId})
+		}
+		groups[i].Records = append(groups[i].Records, record)
+	}
+	return groups
+}
+
+// LineId aws 地理位置对应的线路 ID：默认为 *，大洲为 continent/AS，国家为 country/CN，美国的州为 country/US/WA
+func (g Geolocation) LineId() string {
+	switch {
+	case tea.StringValue(g.CountryCode) == "*":
+		return "*"
+	case g.ContinentCode != nil:
+		return "continent/" + *g.ContinentCode
+	case g.SubdivisionCode != nil:
+		return fmt.Sprintf("country/%s/%s", tea.StringValue(g.CountryCode), *g.SubdivisionCode)
+	default:
+		return "country/" + tea.StringValue(g.CountryCode)
+	}
+}
+
+// ParseGeolocationLineId LineId 的反向转换
+func ParseGeolocationLineId(lineId string) (*Geolocation, error) {
+	if lineId == "*" {
+		return &Geolocation{CountryCode: tea.String("*")}, nil
+	}
+	parts := strings.Split(lineId, "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "continent" && parts[1] != "":
+		return &Geolocation{ContinentCode: tea.String(parts[1])}, nil
+	case len(parts) == 2 && parts[0] == "country" && parts[1] != "":
+		return &Geolocation{CountryCode: tea.String(parts[1])}, nil
+	case len(parts) == 3 && parts[0] == "country" && parts[1] != "" && parts[2] != "":
+		return &Geolocation{CountryCode: tea.String(parts[1]), SubdivisionCode: tea.String(parts[2])}, nil
+	}
+	return nil, fmt.Errorf("invalid aws record line id %q, expected *, continent/<code>, country/<code> or country/<code>/<subdivision>", lineId)
+}
+
+// ResolveAwsRecordLine aws 没有线路，设置了 RecordLineId 时转换为地理位置解析，SetIdentifier 默认为线路 ID
+func (r Record) ResolveAwsRecordLine() (Record, error) {
+	if r.RecordLineId == nil {
+		return r, nil
+	}
+	geo, err := ParseGeolocationLineId(*r.RecordLineId)
+	if err != nil {
+		return r, err
+	}
+	policy := RoutingPolicy{Type: RoutingPolicyGeolocation}
+	if r.RoutingPolicy != nil {
+		if r.RoutingPolicy.Type != RoutingPolicyGeolocation {
+			return r, fmt.Errorf("record line can not be used with %s routing policy", r.RoutingPolicy.Type)
+		}
+		policy = *r.RoutingPolicy
+	}
+	policy.Geolocation = geo
+	r.RoutingPolicy = &policy
+	if r.SetIdentifier == nil {
+		r.SetIdentifier = r.RecordLineId
+	}
+	return r, nil
+}
+
+// MatchRecordLine 按线路筛选记录，recordLineId 优先，都为空时匹配全部
+func (r Record) MatchRecordLine(recordLine, recordLineId *string) bool {
+	if recordLineId != nil {
+		return tea.StringValue(r.RecordLineId) == *recordLineId
+	}
+	return recordLine == nil || tea.StringValue(r.RecordLine) == *recordLine
+}
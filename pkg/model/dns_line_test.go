@@ -0,0 +1,60 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+func TestGeolocationLineId(t *testing.T) {
+	for _, lineId := range []string{"*", "continent/AS", "country/CN", "country/US/WA"} {
+		geo, err := model.ParseGeolocationLineId(lineId)
+		assert.Nil(t, err, lineId)
+		assert.Equal(t, lineId, geo.LineId())
+	}
+	for _, lineId := range []string{"", "CN", "country/", "province/GD", "10=0"} {
+		_, err := model.ParseGeolocationLineId(lineId)
+		assert.NotNil(t, err, lineId)
+	}
+}
+
+func TestResolveAwsRecordLine(t *testing.T) {
+	record, err := model.Record{RecordType: tea.String("A"), RecordLineId: tea.String("country/CN")}.ResolveAwsRecordLine()
+	assert.Nil(t, err)
+	assert.Equal(t, model.RoutingPolicyGeolocation, record.RoutingPolicy.Type)
+	assert.Equal(t, "CN", tea.StringValue(record.RoutingPolicy.Geolocation.CountryCode))
+	assert.Equal(t, "country/CN", tea.StringValue(record.SetIdentifier))
+
+	recordSet := record.ToAwsResourceRecordSet("www.example.com.")
+	assert.Equal(t, "CN", tea.StringValue(recordSet.GeoLocation.CountryCode))
+	assert.Equal(t, "country/CN", tea.StringValue(model.NewRecordFromAwsResourceRecordSet(recordSet).RecordLineId))
+
+	_, err = model.Record{
+		RecordLineId:  tea.String("country/CN"),
+		RoutingPolicy: &model.RoutingPolicy{Type: model.RoutingPolicyWeighted},
+	}.ResolveAwsRecordLine()
+	assert.NotNil(t, err)
+
+	// 没有线路时不变
+	record, err = model.Record{RecordType: tea.String("A")}.ResolveAwsRecordLine()
+	assert.Nil(t, err)
+	assert.Nil(t, record.RoutingPolicy)
+}
+
+func TestGroupRecordsByLine(t *testing.T) {
+	groups := model.GroupRecordsByLine([]model.Record{
+		{SubDomain: tea.String("www"), RecordLine: tea.String("默认"), RecordLineId: tea.String("0")},
+		{SubDomain: tea.String("www"), RecordLine: tea.String("电信"), RecordLineId: tea.String("10=0")},
+		{SubDomain: tea.String("api"), RecordLine: tea.String("默认"), RecordLineId: tea.String("0")},
+		{SubDomain: tea.String("mail")},
+	})
+	assert.Len(t, groups, 3)
+	assert.Equal(t, "0", tea.StringValue(groups[0].RecordLineId))
+	assert.Len(t, groups[0].Records, 2)
+	assert.Equal(t, "电信", tea.StringValue(groups[1].RecordLine))
+	assert.Nil(t, groups[2].RecordLine)
+	assert.Equal(t, "mail", tea.StringValue(groups[2].Records[0].SubDomain))
+}
@@ -0,0 +1,325 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"gopkg.in/yaml.v2"
+)
+
+// DnsZoneSpec 声明式描述一个域名下期望的记录，yaml 和 json 都可以
+type DnsZoneSpec struct {
+	Domain  string          `json:"domain" yaml:"domain"`
+	Private bool            `json:"private" yaml:"private"` // 私有域
+	Prune   bool            `json:"prune" yaml:"prune"`     // 删除 Records 中没有的记录，默认不处理；SOA、根域名的 NS 和 aws 别名记录始终不处理
+	Records []DnsRecordSpec `json:"records" yaml:"records"`
+}
+
+// DnsRecordSpec 同名同类型同 SetIdentifier 同线路只能有一条
+type DnsRecordSpec struct {
+	Name          string   `json:"name" yaml:"name"` // 相对域名，根域名为 @
+	Type          string   `json:"type" yaml:"type"`
+	TTL           uint64   `json:"ttl" yaml:"ttl"` // 为 0 时创建使用云的默认值，比较时忽略
+	Values        []string `json:"values" yaml:"values"`
+	Weight        *uint64  `json:"weight" yaml:"weight"`
+	SetIdentifier string   `json:"set_identifier" yaml:"set_identifier"` // aws
+	RecordLine    string   `json:"record_line" yaml:"record_line"`       // 腾讯云、阿里云，为空是默认线路
+
+	RoutingPolicy *RoutingPolicy `json:"routing_policy" yaml:"routing_policy"` // aws，为空时修改保留线上的解析策略
+	AliasTarget   *AliasTarget   `json:"alias_target" yaml:"alias_target"`     // aws 别名记录，设置后不需要 Values
+}
+
+// ParseDnsZoneSpec json 是 yaml 的子集，统一按 yaml 解析
+func ParseDnsZoneSpec(data []byte) (DnsZoneSpec, error) {
+	var spec DnsZoneSpec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return DnsZoneSpec{}, err
+	}
+	return spec, spec.Validate()
+}
+
+func (s DnsZoneSpec) Validate() error {
+	if s.Domain == "" {
+		return fmt.Errorf("domain is required")
+	}
+	keys := map[string]bool{}
+	for i, record := range s.Records {
+		if record.Name == "" || record.Type == "" || (len(record.Values) == 0 && record.AliasTarget == nil) {
+			return fmt.Errorf("records[%d]: name, type and values are required", i)
+		}
+		key := record.key()
+		if keys[key] {
+			return fmt.Errorf("records[%d]: duplicate record %s", i, record.describe())
+		}
+		keys[key] = true
+	}
+	return nil
+}
+
+func (r DnsRecordSpec) key() string {
+	return dnsPlanKey(r.Name, r.Type, r.SetIdentifier, r.RecordLine)
+}
+
+func (r DnsRecordSpec) describe() string {
+	return dnsPlanDescribe(r.Name, r.Type, r.SetIdentifier, r.RecordLine)
+}
+
+type DnsChangeAction string
+
+const (
+	DnsChangeCreate DnsChangeAction = "create"
+	DnsChangeUpdate DnsChangeAction = "update"
+	DnsChangeDelete DnsChangeAction = "delete"
+)
+
+type DnsChange struct {
+	Action DnsChangeAction `json:"action"`
+	Before []Record        `json:"before"` // 线上的记录，腾讯云、阿里云多个值是多条记录；create 时为空
+	After  *Record         `json:"after"`  // delete 时为空
+}
+
+type DnsPlan struct {
+	Domain  string      `json:"domain"`
+	Private bool        `json:"private"`
+	Changes []DnsChange `json:"changes"`
+}
+
+// ApplyDnsPlanResponse 遇到第一个失败的变更就停止，之后的变更在 Skipped 中返回
+type ApplyDnsPlanResponse struct {
+	Applied []DnsChange       `json:"applied"`
+	Failed  []DnsChangeFailed `json:"failed"`
+	Skipped []DnsChange       `json:"skipped"`
+}
+
+type DnsChangeFailed struct {
+	Change DnsChange `json:"change"`
+	Error  string    `json:"error"`
+	// PartiallyApplied 删除后重建时旧记录已经删除、新记录没有创建成功，线上记录当前缺失，需要重新 apply
+	PartiallyApplied bool `json:"partially_applied"`
+}
+
+// NewDnsPlan 对比期望的记录和线上的记录，live 为 DescribeRecordList 或者 DescribePrivateRecordList 返回的记录
+// cloud 决定 TXT 是否加引号，aws 的 TXT 值需要带引号
+func NewDnsPlan(cloud Cloud, spec DnsZoneSpec, live []Record) (DnsPlan, error) {
+	if err := spec.Validate(); err != nil {
+		return DnsPlan{}, err
+	}
+	plan := DnsPlan{Domain: spec.Domain, Private: spec.Private}
+
+	// 线上的记录按 名称|类型|SetIdentifier|线路 分组，腾讯云多个值的记录合并到一组
+	var keys []string
+	groups := map[string][]Record{}
+	for _, record := range live {
+		key := dnsPlanKey(tea.StringValue(record.SubDomain), tea.StringValue(record.RecordType), tea.StringValue(record.SetIdentifier), tea.StringValue(record.RecordLine))
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], record)
+	}
+
+	managed := map[string]bool{}
+	for _, recordSpec := range spec.Records {
+		key := recordSpec.key()
+		managed[key] = true
+		after := recordSpec.toRecord(cloud)
+		before, ok := groups[key]
+		if !ok {
+			plan.Changes = append(plan.Changes, DnsChange{Action: DnsChangeCreate, After: after})
+			continue
+		}
+		current := MergeRecords(before)
+		if after.TTL == nil {
+			after.TTL = current.TTL
+		}
+		if after.Weight == nil {
+			after.Weight = current.Weight
+		}
+		// 没有声明的解析策略和线路 ID 沿用线上的，UPSERT 时不会把 aws 的加权、故障转移等记录改成简单记录
+		if after.RoutingPolicy == nil {
+			after.RoutingPolicy = current.RoutingPolicy
+		}
+		if after.RecordLineId == nil {
+			after.RecordLineId = current.RecordLineId
+		}
+		if tea.Uint64Value(after.TTL) == tea.Uint64Value(current.TTL) &&
+			tea.Uint64Value(after.Weight) == tea.Uint64Value(current.Weight) &&
+			reflect.DeepEqual(after.RoutingPolicy, current.RoutingPolicy) &&
+			dnsPlanValuesEqual(*after, current) {
+			continue
+		}
+		plan.Changes = append(plan.Changes, DnsChange{Action: DnsChangeUpdate, Before: before, After: after})
+	}
+
+	if spec.Prune {
+		for _, key := range keys {
+			if managed[key] {
+				continue
+			}
+			record := groups[key][0]
+			recordType := tea.StringValue(record.RecordType)
+			if recordType == "SOA" || record.AliasTarget != nil || (recordType == "NS" && dnsPlanName(tea.StringValue(record.SubDomain)) == "@") {
+				continue
+			}
+			plan.Changes = append(plan.Changes, DnsChange{Action: DnsChangeDelete, Before: groups[key]})
+		}
+	}
+	return plan, nil
+}
+
+// String 以 diff 的形式展示，+ 新建，~ 修改，- 删除
+func (p DnsPlan) String() string {
+	if len(p.Changes) == 0 {
+		return fmt.Sprintf("%s: no changes\n", p.Domain)
+	}
+	var b strings.Builder
+	for _, change := range p.Changes {
+		switch change.Action {
+		case DnsChangeCreate:
+			fmt.Fprintf(&b, "+ %s %s\n", describeDnsPlanRecord(*change.After), formatDnsPlanRecord(*change.After))
+		case DnsChangeUpdate:
+			before := MergeRecords(change.Before)
+			fmt.Fprintf(&b, "~ %s %s -> %s\n", describeDnsPlanRecord(*change.After), formatDnsPlanRecord(before), formatDnsPlanRecord(*change.After))
+		case DnsChangeDelete:
+			before := MergeRecords(change.Before)
+			fmt.Fprintf(&b, "- %s %s\n", describeDnsPlanRecord(before), formatDnsPlanRecord(before))
+		}
+	}
+	return b.String()
+}
+
+func (r DnsRecordSpec) toRecord(cloud Cloud) *Record {
+	record := &Record{
+		SubDomain:  tea.String(dnsPlanName(r.Name)),
+		RecordType: tea.String(strings.ToUpper(r.Type)),
+		Weight:     r.Weight,
+	}
+	if r.TTL != 0 {
+		record.TTL = tea.Uint64(r.TTL)
+	}
+	if r.SetIdentifier != "" {
+		record.SetIdentifier = tea.String(r.SetIdentifier)
+	}
+	if r.RecordLine != "" {
+		record.RecordLine = tea.String(r.RecordLine)
+	}
+	if r.RoutingPolicy != nil {
+		policy := *r.RoutingPolicy
+		record.RoutingPolicy = &policy
+	}
+	for _, value := range r.Values {
+		if cloud == AWS && *record.RecordType == "TXT" && !strings.HasPrefix(value, `"`) {
+			value = quoteTxtValue(value)
+		}
+		record.Values = append(record.Values, tea.String(value))
+	}
+	if r.AliasTarget != nil {
+		// 别名记录和线上一样，Value 为别名的 DNSName，没有 Values
+		alias := *r.AliasTarget
+		record.AliasTarget = &alias
+		record.Values = nil
+		record.Value = alias.DNSName
+		return record
+	}
+	record.Value = record.Values[0]
+	return record
+}
+
+// dnsPlanValuesEqual 别名记录比较别名的目标，其他记录比较值
+func dnsPlanValuesEqual(after, current Record) bool {
+	if after.AliasTarget != nil || current.AliasTarget != nil {
+		if after.AliasTarget == nil || current.AliasTarget == nil {
+			return false
+		}
+		a, b := after.AliasTarget, current.AliasTarget
+		return RecordValuesEqual("CNAME", []*string{a.DNSName}, []*string{b.DNSName}) &&
+			tea.StringValue(a.HostedZoneId) == tea.StringValue(b.HostedZoneId) &&
+			tea.BoolValue(a.EvaluateTargetHealth) == tea.BoolValue(b.EvaluateTargetHealth)
+	}
+	return RecordValuesEqual(tea.StringValue(after.RecordType), after.Values, current.Values)
+}
+
+// MergeRecords 同名同类型的多条记录合并为一条多值记录，其他字段取第一条；
+// 腾讯云、阿里云一个值一条记录，aws 加权记录按 SetIdentifier 分多条
+func MergeRecords(records []Record) Record {
+	merged := records[0]
+	merged.Values = nil
+	for _, record := range records {
+		merged.Values = append(merged.Values, record.GetValues()...)
+	}
+	return merged
+}
+
+func dnsPlanKey(name, recordType, setIdentifier, recordLine string) string {
+	// 腾讯云默认线路为 默认，阿里云为 default，和不传线路视为相同
+	if recordLine == "默认" || recordLine == "default" {
+		recordLine = ""
+	}
+	return strings.Join([]string{dnsPlanName(name), strings.ToUpper(recordType), setIdentifier, recordLine}, "|")
+}
+
+// dnsPlanName 根域名 aws 为空，腾讯云为 @，统一为 @
+func dnsPlanName(name string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == "" {
+		return "@"
+	}
+	return name
+}
+
+func dnsPlanDescribe(name, recordType, setIdentifier, recordLine string) string {
+	desc := fmt.Sprintf("%s %s", dnsPlanName(name), strings.ToUpper(recordType))
+	if setIdentifier != "" {
+		desc += " set=" + setIdentifier
+	}
+	if recordLine != "" {
+		desc += " line=" + recordLine
+	}
+	return desc
+}
+
+func describeDnsPlanRecord(r Record) string {
+	return dnsPlanDescribe(tea.StringValue(r.SubDomain), tea.StringValue(r.RecordType), tea.StringValue(r.SetIdentifier), tea.StringValue(r.RecordLine))
+}
+
+func formatDnsPlanRecord(r Record) string {
+	s := fmt.Sprintf("ttl=%d", tea.Uint64Value(r.TTL))
+	if r.Weight != nil {
+		s += fmt.Sprintf(" weight=%d", *r.Weight)
+	}
+	if r.AliasTarget != nil {
+		return s + " alias=" + tea.StringValue(r.AliasTarget.DNSName)
+	}
+	return s + " [" + strings.Join(tea.StringSliceValue(r.GetValues()), ", ") + "]"
+}
+
+// RecordValuesEqual 比较记录的值，忽略顺序，域名忽略大小写和结尾的点，TXT 忽略引号
+func RecordValuesEqual(recordType string, a, b []*string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	normalize := func(values []*string) []string {
+		var result []string
+		for _, value := range values {
+			v := tea.StringValue(value)
+			switch {
+			case zoneNameTypes[recordType]:
+				v = strings.ToLower(strings.TrimSuffix(v, "."))
+			case recordType == "TXT":
+				v = UnquoteTxtValue(v)
+			}
+			result = append(result, v)
+		}
+		sort.Strings(result)
+		return result
+	}
+	x, y := normalize(a), normalize(b)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
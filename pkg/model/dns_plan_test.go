@@ -0,0 +1,88 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+func TestParseDnsZoneSpec(t *testing.T) {
+	spec, err := model.ParseDnsZoneSpec([]byte(`
+domain: example.com
+prune: true
+records:
+  - name: www
+    type: A
+    ttl: 300
+    values: [203.0.113.10, 203.0.113.11]
+  - name: api
+    type: A
+    values: [203.0.113.20]
+    weight: 80
+    set_identifier: blue
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, "example.com", spec.Domain)
+	assert.True(t, spec.Prune)
+	assert.Len(t, spec.Records, 2)
+	assert.Equal(t, uint64(80), tea.Uint64Value(spec.Records[1].Weight))
+	assert.Equal(t, "blue", spec.Records[1].SetIdentifier)
+
+	spec, err = model.ParseDnsZoneSpec([]byte(`{"domain": "example.com", "records": [{"name": "@", "type": "TXT", "values": ["v=spf1 -all"]}]}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "TXT", spec.Records[0].Type)
+
+	_, err = model.ParseDnsZoneSpec([]byte(`
+domain: example.com
+records:
+  - {name: www, type: A, values: [203.0.113.10]}
+  - {name: WWW, type: a, values: [203.0.113.11]}
+`))
+	assert.Contains(t, err.Error(), "duplicate record www A")
+}
+
+func TestNewDnsPlan(t *testing.T) {
+	// 腾讯云一条记录一个值，线路为 默认
+	live := []model.Record{
+		{RecordId: tea.String("1"), SubDomain: tea.String("@"), RecordType: tea.String("NS"), TTL: tea.Uint64(86400), Value: tea.String("f1g1ns1.dnspod.net."), RecordLine: tea.String("默认")},
+		{RecordId: tea.String("2"), SubDomain: tea.String("www"), RecordType: tea.String("A"), TTL: tea.Uint64(600), Value: tea.String("203.0.113.10"), RecordLine: tea.String("默认")},
+		{RecordId: tea.String("3"), SubDomain: tea.String("www"), RecordType: tea.String("A"), TTL: tea.Uint64(600), Value: tea.String("203.0.113.11"), RecordLine: tea.String("默认")},
+		{RecordId: tea.String("4"), SubDomain: tea.String("api"), RecordType: tea.String("CNAME"), TTL: tea.Uint64(600), Value: tea.String("www.example.com."), RecordLine: tea.String("默认")},
+		{RecordId: tea.String("5"), SubDomain: tea.String("old"), RecordType: tea.String("A"), TTL: tea.Uint64(600), Value: tea.String("203.0.113.99"), RecordLine: tea.String("默认")},
+	}
+	spec := model.DnsZoneSpec{
+		Domain: "example.com",
+		Records: []model.DnsRecordSpec{
+			// 顺序不同、不写 TTL 视为没有变化
+			{Name: "www", Type: "A", Values: []string{"203.0.113.11", "203.0.113.10"}},
+			{Name: "api", Type: "CNAME", TTL: 300, Values: []string{"WWW.example.com"}},
+			{Name: "new", Type: "TXT", Values: []string{"hello"}},
+		},
+	}
+	plan, err := model.NewDnsPlan(model.TENCENT, spec, live)
+	assert.Nil(t, err)
+	assert.Len(t, plan.Changes, 2)
+	assert.Equal(t, model.DnsChangeUpdate, plan.Changes[0].Action)
+	assert.Equal(t, "4", tea.StringValue(plan.Changes[0].Before[0].RecordId))
+	assert.Equal(t, model.DnsChangeCreate, plan.Changes[1].Action)
+	assert.Equal(t, "hello", tea.StringValue(plan.Changes[1].After.Value))
+	assert.Equal(t, "~ api CNAME ttl=600 [www.example.com.] -> ttl=300 [WWW.example.com]\n+ new TXT ttl=0 [hello]\n", plan.String())
+
+	// prune 时删除不在 spec 中的记录，根域名的 NS 不处理
+	spec.Prune = true
+	plan, err = model.NewDnsPlan(model.AWS, spec, live)
+	assert.Nil(t, err)
+	assert.Len(t, plan.Changes, 3)
+	assert.Equal(t, `"hello"`, tea.StringValue(plan.Changes[1].After.Value))
+	assert.Equal(t, model.DnsChangeDelete, plan.Changes[2].Action)
+	assert.Equal(t, "5", tea.StringValue(plan.Changes[2].Before[0].RecordId))
+
+	spec.Records = spec.Records[:1]
+	spec.Prune = false
+	plan, err = model.NewDnsPlan(model.TENCENT, spec, live)
+	assert.Nil(t, err)
+	assert.Equal(t, "example.com: no changes\n", plan.String())
+}
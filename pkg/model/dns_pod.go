@@ -1,7 +1,18 @@
 package model
 
+import (
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/spf13/cast"
+)
+
 type DescribeDomainListRequest struct {
 	DomainKeyword *string `json:"keyword"`
+	// WithVpcs 私有域返回关联的 VPC 和状态，aws 需要逐个 GetHostedZone 查询，默认不查询；腾讯云总是返回
+	WithVpcs bool `json:"with_vpcs"`
 }
 
 type DescribeDomainListResponse struct {
@@ -21,9 +32,10 @@ type DomainCountInfo struct {
 }
 
 type DescribeRecordListWithPageRequest struct {
-	Domain *string `json:"domain" binding:"required"` // 支持使用域名或者ID
-	Limit  *int64  `json:"limit"`                     // 分页 默认100
-	Page   *int64  `json:"page"`                      // 页码
+	Domain     *string `json:"domain" binding:"required"` // 支持使用域名或者ID
+	Limit      *int64  `json:"limit"`                     // 分页 默认100
+	Page       *int64  `json:"page"`                      // 页码，aws 需要从头逐页翻，建议使用 NextMarker
+	NextMarker *string `json:"next_marker"`               // 上一页返回的游标，优先于 Page
 }
 
 type DescribeRecordListRequest struct {
@@ -32,13 +44,15 @@ type DescribeRecordListRequest struct {
 }
 
 type DescribeRecordListResponse struct {
-	Total      int64    `json:"total"`
-	RecordList []Record `json:"record_list"`
+	Total      int64             `json:"total"`
+	RecordList []Record          `json:"record_list"`
+	LineGroups []RecordLineGroup `json:"line_groups"` // RecordList 按线路分组
 }
 
 type ListRecordsPageResponse struct {
 	PrePage    *int64   `json:"pre_page"`
 	NextPage   *int64   `json:"next_page"`
+	NextMarker *string  `json:"next_marker"` // 没有下一页时为 nil
 	RecordList []Record `json:"record_list"`
 }
 
@@ -55,6 +69,31 @@ type CreateRecordRequest struct {
 	Value      *string `json:"value" binding:"required"`       //记录值，如 IP。
 	TTL        *uint64 `json:"ttl"`                            //记录生效时间，默认（aws 300）（腾讯 600），最大值604800秒。
 	Info       *string `json:"info"`                           //备注，主要描述创建原因用途（aws不支持，tencent支持，但是private dns 不支持）
+
+	Values        []*string      `json:"values"`         //多个记录值，设置后忽略 Value。腾讯云每个值创建一条记录
+	RecordLine    *string        `json:"record_line"`    //腾讯云、阿里云的线路，默认 默认/default
+	RecordLineId  *string        `json:"record_line_id"` //线路 ID，优先于 RecordLine；aws 为地理位置线路，比如 country/CN，见 DescribeRecordLineList
+	Weight        *uint64        `json:"weight"`         //记录权重，aws 需要同时设置 SetIdentifier
+	SetIdentifier *string        `json:"set_identifier"` //aws 同名同类型的多条记录通过 SetIdentifier 区分
+	RoutingPolicy *RoutingPolicy `json:"routing_policy"` //aws 解析策略，腾讯云只支持线路和权重
+	AliasTarget   *AliasTarget   `json:"alias_target"`   //aws 别名记录，设置后忽略 Value 和 TTL，其他云不支持
+}
+
+func (r CreateRecordRequest) ToRecord() Record {
+	return Record{
+		SubDomain:     r.SubDomain,
+		RecordType:    r.RecordType,
+		Value:         r.Value,
+		Values:        r.Values,
+		TTL:           r.TTL,
+		RecordLine:    r.RecordLine,
+		RecordLineId:  r.RecordLineId,
+		Weight:        r.Weight,
+		Remark:        r.Info,
+		SetIdentifier: r.SetIdentifier,
+		RoutingPolicy: r.RoutingPolicy,
+		AliasTarget:   r.AliasTarget,
+	}
 }
 
 type CreateRecordResponse struct {
@@ -72,11 +111,35 @@ type ModifyRecordRequest struct {
 	Weight     *uint64 `json:"weight"`                         //记录权重，值为1-100。
 	Status     *bool   `json:"status"`                         //AWS该参数无效。腾讯该参数为是否启用，true 启用，false 禁用。
 	Info       *string `json:"info"`                           //备注，主要描述修改原因用途（aws不支持，tencent支持）
+
+	Values        []*string      `json:"values"`         //多个记录值，设置后忽略 Value。腾讯云一条记录只有一个值
+	RecordLine    *string        `json:"record_line"`    //腾讯云、阿里云按线路找到要修改的记录，不传时修改同名同类型的第一条
+	RecordLineId  *string        `json:"record_line_id"` //线路 ID，优先于 RecordLine
+	SetIdentifier *string        `json:"set_identifier"` //aws 同名同类型的多条记录通过 SetIdentifier 区分
+	RoutingPolicy *RoutingPolicy `json:"routing_policy"` //aws 解析策略，腾讯云只支持线路和权重
+	AliasTarget   *AliasTarget   `json:"alias_target"`   //aws 别名记录，设置后忽略 Value 和 TTL，其他云不支持
+}
+
+func (r ModifyRecordRequest) ToRecord() Record {
+	return Record{
+		SubDomain:     r.SubDomain,
+		RecordType:    r.RecordType,
+		Value:         r.Value,
+		Values:        r.Values,
+		TTL:           r.TTL,
+		RecordLine:    r.RecordLine,
+		RecordLineId:  r.RecordLineId,
+		Weight:        r.Weight,
+		Remark:        r.Info,
+		SetIdentifier: r.SetIdentifier,
+		RoutingPolicy: r.RoutingPolicy,
+		AliasTarget:   r.AliasTarget,
+	}
 }
 
 type Record struct {
 	RecordId   *string `json:"record_id"`
-	Value      *string `json:"value"` // aws []string 腾讯 string，aws取 1 个可能会有 bug.
+	Value      *string `json:"value"` // 第一个记录值，全部的值见 Values
 	SubDomain  *string `json:"sub_domain"`
 	RecordLine *string `json:"record_line"`
 	RecordType *string `json:"record_type"`
@@ -87,12 +150,220 @@ type Record struct {
 	DomainId   *uint64 `json:"domain_id"`
 	Remark     *string `json:"remark"`
 	// Meta       interface{} `json:"meta"`
+
+	Values        []*string      `json:"values"`         // aws 一个记录集可以有多个值，腾讯云一条记录一个值
+	RecordLineId  *string        `json:"record_line_id"` // 腾讯云为线路 ID，阿里云为线路代码，aws 地理位置解析为 country/CN 这样的线路 ID
+	SetIdentifier *string        `json:"set_identifier"` // aws 加权、延迟等策略的记录标识
+	RoutingPolicy *RoutingPolicy `json:"routing_policy"` // 简单解析为 nil
+	AliasTarget   *AliasTarget   `json:"alias_target"`   // aws 别名记录，Value 为别名的 DNSName，没有 TTL
+}
+
+// AliasTarget aws 别名记录的目标，比如 ELB、CloudFront、S3 网站或者同一个 hosted zone 的其他记录
+type AliasTarget struct {
+	DNSName              *string `json:"dns_name" yaml:"dns_name"`                             // 比如 my-lb-123.cn-northwest-1.elb.amazonaws.com.cn
+	HostedZoneId         *string `json:"hosted_zone_id" yaml:"hosted_zone_id"`                 // 目标资源所在的 hosted zone，ELB、CloudFront 各有固定的 ID
+	EvaluateTargetHealth *bool   `json:"evaluate_target_health" yaml:"evaluate_target_health"` // 默认 false
+}
+
+// RoutingPolicyType 对应 aws route53 的 routing policy
+type RoutingPolicyType string
+
+const (
+	RoutingPolicySimple      RoutingPolicyType = "simple"
+	RoutingPolicyWeighted    RoutingPolicyType = "weighted"
+	RoutingPolicyLatency     RoutingPolicyType = "latency"
+	RoutingPolicyGeolocation RoutingPolicyType = "geolocation"
+	RoutingPolicyFailover    RoutingPolicyType = "failover"
+	RoutingPolicyMultiValue  RoutingPolicyType = "multivalue"
+)
+
+// RoutingPolicy 权重统一使用 Record.Weight，腾讯云的线路使用 Record.RecordLine
+type RoutingPolicy struct {
+	Type          RoutingPolicyType `json:"type" yaml:"type"`
+	Region        *string           `json:"region" yaml:"region"`                   // latency，比如 cn-northwest-1
+	Geolocation   *Geolocation      `json:"geolocation" yaml:"geolocation"`         // geolocation
+	Failover      *string           `json:"failover" yaml:"failover"`               // failover，PRIMARY 或者 SECONDARY
+	HealthCheckId *string           `json:"health_check_id" yaml:"health_check_id"` // 健康检查 ID，failover 的 PRIMARY 必须设置
+}
+
+type Geolocation struct {
+	ContinentCode   *string `json:"continent_code" yaml:"continent_code"`     // 比如 AS
+	CountryCode     *string `json:"country_code" yaml:"country_code"`         // 比如 CN，* 表示默认
+	SubdivisionCode *string `json:"subdivision_code" yaml:"subdivision_code"` // 美国的州，比如 WA
+}
+
+// GetValues 兼容只设置了 Value 的情况
+func (r Record) GetValues() []*string {
+	if len(r.Values) > 0 {
+		return r.Values
+	}
+	if r.Value != nil {
+		return []*string{r.Value}
+	}
+	return nil
+}
+
+// ToAwsResourceRecordSet name 为完整域名，以 . 结尾
+func (r Record) ToAwsResourceRecordSet(name string) *route53.ResourceRecordSet {
+	recordSet := &route53.ResourceRecordSet{
+		Name:          aws.String(name),
+		Type:          r.RecordType,
+		SetIdentifier: r.SetIdentifier,
+	}
+	if r.AliasTarget != nil {
+		// 别名记录不能设置 TTL 和记录值
+		recordSet.AliasTarget = &route53.AliasTarget{
+			DNSName:              r.AliasTarget.DNSName,
+			HostedZoneId:         r.AliasTarget.HostedZoneId,
+			EvaluateTargetHealth: aws.Bool(aws.BoolValue(r.AliasTarget.EvaluateTargetHealth)),
+		}
+	} else {
+		if r.TTL != nil {
+			recordSet.TTL = aws.Int64(cast.ToInt64(*r.TTL))
+		}
+		for _, value := range r.GetValues() {
+			recordSet.ResourceRecords = append(recordSet.ResourceRecords, &route53.ResourceRecord{Value: value})
+		}
+	}
+	if r.Weight != nil {
+		recordSet.Weight = aws.Int64(cast.ToInt64(*r.Weight))
+	}
+	if r.RoutingPolicy == nil {
+		return recordSet
+	}
+	recordSet.HealthCheckId = r.RoutingPolicy.HealthCheckId
+	switch r.RoutingPolicy.Type {
+	case RoutingPolicyLatency:
+		recordSet.Region = r.RoutingPolicy.Region
+	case RoutingPolicyGeolocation:
+		if geo := r.RoutingPolicy.Geolocation; geo != nil {
+			recordSet.GeoLocation = &route53.GeoLocation{
+				ContinentCode:   geo.ContinentCode,
+				CountryCode:     geo.CountryCode,
+				SubdivisionCode: geo.SubdivisionCode,
+			}
+		}
+	case RoutingPolicyFailover:
+		recordSet.Failover = r.RoutingPolicy.Failover
+	case RoutingPolicyMultiValue:
+		recordSet.MultiValueAnswer = aws.Bool(true)
+	}
+	return recordSet
+}
+
+// NewRecordFromAwsResourceRecordSet 不处理记录名，SubDomain 和 RecordId 由调用方设置
+func NewRecordFromAwsResourceRecordSet(recordSet *route53.ResourceRecordSet) Record {
+	record := Record{
+		RecordType:    recordSet.Type,
+		SetIdentifier: recordSet.SetIdentifier,
+	}
+	if alias := recordSet.AliasTarget; alias != nil {
+		record.Value = alias.DNSName
+		record.AliasTarget = &AliasTarget{
+			DNSName:              alias.DNSName,
+			HostedZoneId:         alias.HostedZoneId,
+			EvaluateTargetHealth: alias.EvaluateTargetHealth,
+		}
+	} else {
+		record.TTL = tea.Uint64(cast.ToUint64(aws.Int64Value(recordSet.TTL)))
+	}
+	for _, value := range recordSet.ResourceRecords {
+		record.Values = append(record.Values, value.Value)
+	}
+	if len(record.Values) > 0 {
+		record.Value = record.Values[0]
+	}
+	if recordSet.Weight != nil {
+		record.Weight = tea.Uint64(cast.ToUint64(*recordSet.Weight))
+	}
+	policy := RoutingPolicy{Type: RoutingPolicySimple, HealthCheckId: recordSet.HealthCheckId}
+	switch {
+	case recordSet.Weight != nil:
+		policy.Type = RoutingPolicyWeighted
+	case recordSet.Region != nil:
+		policy.Type = RoutingPolicyLatency
+		policy.Region = recordSet.Region
+	case recordSet.GeoLocation != nil:
+		policy.Type = RoutingPolicyGeolocation
+		policy.Geolocation = &Geolocation{
+			ContinentCode:   recordSet.GeoLocation.ContinentCode,
+			CountryCode:     recordSet.GeoLocation.CountryCode,
+			SubdivisionCode: recordSet.GeoLocation.SubdivisionCode,
+		}
+		record.RecordLineId = tea.String(policy.Geolocation.LineId())
+	case recordSet.Failover != nil:
+		policy.Type = RoutingPolicyFailover
+		policy.Failover = recordSet.Failover
+	case aws.BoolValue(recordSet.MultiValueAnswer):
+		policy.Type = RoutingPolicyMultiValue
+	}
+	if policy.Type != RoutingPolicySimple || policy.HealthCheckId != nil {
+		record.RoutingPolicy = &policy
+	}
+	return record
 }
 
 type DeleteRecordRequest struct {
 	Domain     *string `json:"domain" binding:"required"`
 	SubDomain  *string `json:"sub_domain" binding:"required"`
 	RecordType *string `json:"record_type" binding:"required"`
+	// 以下为可选条件，不传时删除同名同类型的全部记录
+	SetIdentifier *string `json:"set_identifier"` // aws
+	RecordLine    *string `json:"record_line"`    // 腾讯云、阿里云
+	RecordLineId  *string `json:"record_line_id"` // 腾讯云、阿里云
+}
+
+type RecordChangeAction string
+
+const (
+	RecordChangeCreate RecordChangeAction = "CREATE"
+	RecordChangeUpsert RecordChangeAction = "UPSERT" // 有同名同类型的记录时替换，没有时新建
+	RecordChangeDelete RecordChangeAction = "DELETE"
+)
+
+// RecordChange DELETE 按 SubDomain、RecordType 以及可选的 SetIdentifier、RecordLine 找到线上的记录，不需要填写值
+type RecordChange struct {
+	Action RecordChangeAction `json:"action"`
+	Record Record             `json:"record"`
+}
+
+type ChangeRecordSetRequest struct {
+	Domain  *string        `json:"domain" binding:"required"` // 支持使用域名或者ID
+	Changes []RecordChange `json:"changes" binding:"required"`
+	Comment *string        `json:"comment"`
+}
+
+type ChangeRecordSetResponse struct {
+	ChangeId *string     `json:"change_id"` // aws 为 ChangeInfo.Id
+	Meta     interface{} `json:"meta"`
+}
+
+type RecordChangeStatus string
+
+const (
+	RecordChangePending RecordChangeStatus = "PENDING"
+	RecordChangeInSync  RecordChangeStatus = "INSYNC"
+)
+
+// WaitForRecordChangeRequest 有 ChangeId 时 aws 轮询 GetChange，否则轮询记录列表直到记录出现并且值一致
+type WaitForRecordChangeRequest struct {
+	Domain     *string   `json:"domain" binding:"required"`
+	SubDomain  *string   `json:"sub_domain" binding:"required"`
+	RecordType *string   `json:"record_type" binding:"required"`
+	Values     []*string `json:"values"`    // 期望的值，为空时只等待记录出现
+	ChangeId   *string   `json:"change_id"` // aws CreateRecord 返回的 RecordId 或者 ChangeRecordSet 返回的 ChangeId
+	Private    bool      `json:"private"`
+	// CheckNameservers 记录生效后再直接查询域名的权威 DNS，直到每个 NS 都返回期望的值，私有域不支持
+	CheckNameservers bool          `json:"check_nameservers"`
+	Nameservers      []string      `json:"nameservers"` // 为空时使用根域名的 NS 记录，可以是 host 或者 host:port
+	Timeout          time.Duration `json:"timeout"`     // 默认 5 分钟
+	Interval         time.Duration `json:"interval"`    // 默认 5 秒
+}
+
+type WaitForRecordChangeResponse struct {
+	Status      RecordChangeStatus `json:"status"`
+	Record      Record             `json:"record"`      // 生效的记录
+	Nameservers []string           `json:"nameservers"` // 已确认的权威 DNS
 }
 
 type CommonDnsResponse struct {
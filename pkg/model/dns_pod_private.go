@@ -1,22 +1,132 @@
 package model
 
+import (
+	"fmt"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/service/route53"
+	privatedns "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/privatedns/v20201028"
+)
+
 type DescribePrivateDomainListResponse struct {
 	DomainList []PrivateDomain `json:"domain_list"`
 	TotalCount *int64          `json:"total_count"`
 }
 
 type PrivateDomain struct {
-	DomainId    *string `json:"domain_id"`
-	Name        *string `json:"name"`
-	RecordCount *int64  `json:"record_count"`
-	VpcSet      any     `json:"vpc_set"`
-	Status      *string `json:"status"` // 私有域绑定VPC状态，未关联vpc：SUSPEND，已关联VPC：ENABLED，关联VPC失败：FAILED
-	Tags        any     `json:"tags"`
+	DomainId    *string          `json:"domain_id"`
+	Name        *string          `json:"name"`
+	RecordCount *int64           `json:"record_count"`
+	VpcSet      []PrivateZoneVpc `json:"vpc_set"`
+	Status      *string          `json:"status"` // 私有域绑定VPC状态，未关联vpc：SUSPEND，已关联VPC：ENABLED，关联VPC失败：FAILED
+	Tags        any              `json:"tags"`
 }
-type DescribePrivateDnsRecordListWithPageRequest struct {
+
+// PrivateZoneVpc 私有域关联的 VPC，AccountId 不为空时为其他账号的 VPC
+type PrivateZoneVpc struct {
+	VpcId     *string `json:"vpc_id" binding:"required"`
+	Region    *string `json:"region" binding:"required"`
+	AccountId *string `json:"account_id"` // 腾讯云为 VPC 所属账号的 uin，aws 为账号 ID
+	Profile   *string `json:"profile"`    // aws 跨账号时 VPC 所属账号的配置，为空时只授权，需要对方账号完成关联
+}
+
+type CreatePrivateZoneRequest struct {
+	Domain *string          `json:"domain" binding:"required"`
+	Remark *string          `json:"remark"` // aws 对应 hosted zone 的 Comment
+	Vpcs   []PrivateZoneVpc `json:"vpcs"`   // aws 至少一个，并且是当前账号的 VPC，其他账号的 VPC 创建后使用 AddZoneVpcAssociation 关联
+}
+
+type CreatePrivateZoneResponse struct {
+	DomainId *string     `json:"domain_id"`
+	Name     *string     `json:"name"`
+	Meta     interface{} `json:"meta"`
+}
+
+// DeletePrivateZoneRequest 私有域还有解析记录时删除会失败
+type DeletePrivateZoneRequest struct {
 	Domain *string `json:"domain" binding:"required"` // 支持使用域名或者ID
-	Limit  *int64  `json:"limit"`                     // 分页 默认100
-	Page   *int64  `json:"page"`                      // 页码
+}
+
+type ZoneVpcAssociationRequest struct {
+	Domain *string          `json:"domain" binding:"required"` // 支持使用域名或者ID
+	Vpcs   []PrivateZoneVpc `json:"vpcs" binding:"required"`
+}
+
+func (r ZoneVpcAssociationRequest) Validate() error {
+	if r.Domain == nil {
+		return fmt.Errorf("domain is required")
+	}
+	if len(r.Vpcs) == 0 {
+		return fmt.Errorf("vpcs is required")
+	}
+	return validatePrivateZoneVpcs(r.Vpcs)
+}
+
+func (r CreatePrivateZoneRequest) Validate() error {
+	if r.Domain == nil {
+		return fmt.Errorf("domain is required")
+	}
+	return validatePrivateZoneVpcs(r.Vpcs)
+}
+
+func validatePrivateZoneVpcs(vpcs []PrivateZoneVpc) error {
+	for i, vpc := range vpcs {
+		if vpc.VpcId == nil || vpc.Region == nil {
+			return fmt.Errorf("vpcs[%d]: vpc id and region are required", i)
+		}
+	}
+	return nil
+}
+
+// NewPrivateZoneVpcsFromAws aws 的 VPC 不返回所属账号
+func NewPrivateZoneVpcsFromAws(vpcs []*route53.VPC) []PrivateZoneVpc {
+	result := make([]PrivateZoneVpc, 0, len(vpcs))
+	for _, vpc := range vpcs {
+		result = append(result, PrivateZoneVpc{VpcId: vpc.VPCId, Region: vpc.VPCRegion})
+	}
+	return result
+}
+
+func (v PrivateZoneVpc) ToAwsVpc() *route53.VPC {
+	return &route53.VPC{VPCId: v.VpcId, VPCRegion: v.Region}
+}
+
+// NewPrivateZoneVpcsFromTencent 当前账号的 VpcSet 和其他账号的 AccountVpcSet 合并
+func NewPrivateZoneVpcsFromTencent(zone *privatedns.PrivateZone) []PrivateZoneVpc {
+	result := make([]PrivateZoneVpc, 0, len(zone.VpcSet)+len(zone.AccountVpcSet))
+	for _, vpc := range zone.VpcSet {
+		result = append(result, PrivateZoneVpc{VpcId: vpc.UniqVpcId, Region: vpc.Region})
+	}
+	for _, vpc := range zone.AccountVpcSet {
+		result = append(result, PrivateZoneVpc{VpcId: vpc.UniqVpcId, Region: vpc.Region, AccountId: vpc.Uin})
+	}
+	return result
+}
+
+// ToTencentVpcSets 按 AccountId 拆分为当前账号的 VpcSet 和其他账号的 AccountVpcSet
+func ToTencentVpcSets(vpcs []PrivateZoneVpc) ([]*privatedns.VpcInfo, []*privatedns.AccountVpcInfo) {
+	var vpcSet []*privatedns.VpcInfo
+	var accountVpcSet []*privatedns.AccountVpcInfo
+	for _, vpc := range vpcs {
+		if tea.StringValue(vpc.AccountId) == "" {
+			vpcSet = append(vpcSet, &privatedns.VpcInfo{UniqVpcId: vpc.VpcId, Region: vpc.Region})
+			continue
+		}
+		accountVpcSet = append(accountVpcSet, &privatedns.AccountVpcInfo{UniqVpcId: vpc.VpcId, Region: vpc.Region, Uin: vpc.AccountId})
+	}
+	return vpcSet, accountVpcSet
+}
+
+// IsCrossAccount 设置了 AccountId 或者 Profile 时为其他账号的 VPC
+func (v PrivateZoneVpc) IsCrossAccount() bool {
+	return tea.StringValue(v.AccountId) != "" || tea.StringValue(v.Profile) != ""
+}
+
+type DescribePrivateDnsRecordListWithPageRequest struct {
+	Domain     *string `json:"domain" binding:"required"` // 支持使用域名或者ID
+	Limit      *int64  `json:"limit"`                     // 分页 默认100
+	Page       *int64  `json:"page"`                      // 页码
+	NextMarker *string `json:"next_marker"`               // 上一页返回的游标，优先于 Page
 }
 
 type DescribePrivateRecordListRequest struct {
@@ -0,0 +1,111 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+func TestAwsResourceRecordSetRoundTrip(t *testing.T) {
+	records := []model.Record{
+		{
+			RecordType: tea.String("A"),
+			TTL:        tea.Uint64(300),
+			Values:     []*string{tea.String("203.0.113.10"), tea.String("203.0.113.11")},
+		},
+		{
+			RecordType:    tea.String("A"),
+			TTL:           tea.Uint64(60),
+			Values:        []*string{tea.String("203.0.113.20")},
+			Weight:        tea.Uint64(80),
+			SetIdentifier: tea.String("blue"),
+			RoutingPolicy: &model.RoutingPolicy{Type: model.RoutingPolicyWeighted, HealthCheckId: tea.String("hc-1")},
+		},
+		{
+			RecordType:    tea.String("CNAME"),
+			TTL:           tea.Uint64(60),
+			Values:        []*string{tea.String("lb.cn-north-1.example.com")},
+			SetIdentifier: tea.String("cn-north-1"),
+			RoutingPolicy: &model.RoutingPolicy{Type: model.RoutingPolicyLatency, Region: tea.String("cn-north-1")},
+		},
+		{
+			RecordType:    tea.String("A"),
+			TTL:           tea.Uint64(60),
+			Values:        []*string{tea.String("203.0.113.30")},
+			SetIdentifier: tea.String("cn"),
+			RoutingPolicy: &model.RoutingPolicy{Type: model.RoutingPolicyGeolocation, Geolocation: &model.Geolocation{CountryCode: tea.String("CN")}},
+		},
+		{
+			RecordType:    tea.String("A"),
+			TTL:           tea.Uint64(60),
+			Values:        []*string{tea.String("203.0.113.40")},
+			SetIdentifier: tea.String("primary"),
+			RoutingPolicy: &model.RoutingPolicy{Type: model.RoutingPolicyFailover, Failover: tea.String("PRIMARY"), HealthCheckId: tea.String("hc-2")},
+		},
+	}
+	for _, record := range records {
+		recordSet := record.ToAwsResourceRecordSet("www.example.com.")
+		assert.Nil(t, recordSet.Validate())
+		got := model.NewRecordFromAwsResourceRecordSet(recordSet)
+		assert.Equal(t, record.Values, got.Values)
+		assert.Equal(t, record.Values[0], got.Value)
+		assert.Equal(t, record.TTL, got.TTL)
+		assert.Equal(t, record.Weight, got.Weight)
+		assert.Equal(t, record.SetIdentifier, got.SetIdentifier)
+		assert.Equal(t, record.RoutingPolicy, got.RoutingPolicy)
+	}
+}
+
+func TestRecordGetValues(t *testing.T) {
+	assert.Nil(t, model.Record{}.GetValues())
+	assert.Equal(t, []string{"a"}, tea.StringSliceValue(model.Record{Value: tea.String("a")}.GetValues()))
+	record := model.CreateRecordRequest{
+		Value:  tea.String("a"),
+		Values: []*string{tea.String("b"), tea.String("c")},
+	}.ToRecord()
+	assert.Equal(t, []string{"b", "c"}, aws.StringValueSlice(record.GetValues()))
+}
+
+func TestAwsAliasRecordSet(t *testing.T) {
+	record := model.Record{
+		RecordType: tea.String("A"),
+		TTL:        tea.Uint64(300),
+		AliasTarget: &model.AliasTarget{
+			DNSName:      tea.String("web-lb-123456.cn-northwest-1.elb.amazonaws.com.cn"),
+			HostedZoneId: tea.String("ZM7IZAIOVVDZF"),
+		},
+	}
+	recordSet := record.ToAwsResourceRecordSet("example.com.")
+	assert.Nil(t, recordSet.Validate())
+	assert.Nil(t, recordSet.TTL)
+	assert.Nil(t, recordSet.ResourceRecords)
+	assert.False(t, aws.BoolValue(recordSet.AliasTarget.EvaluateTargetHealth))
+
+	got := model.NewRecordFromAwsResourceRecordSet(recordSet)
+	assert.Nil(t, got.TTL)
+	assert.Equal(t, record.AliasTarget.DNSName, got.Value)
+	assert.Equal(t, "ZM7IZAIOVVDZF", tea.StringValue(got.AliasTarget.HostedZoneId))
+	assert.False(t, tea.BoolValue(got.AliasTarget.EvaluateTargetHealth))
+}
+
+func TestNewHealthCheckStatusFromAws(t *testing.T) {
+	observation := func(status string) *route53.HealthCheckObservation {
+		return &route53.HealthCheckObservation{StatusReport: &route53.StatusReport{Status: aws.String(status)}}
+	}
+	assert.Equal(t, model.HealthCheckUnknown, model.NewHealthCheckStatusFromAws(aws.String("hc"), nil).Status)
+
+	// 少于 18% 的检查点健康时为不健康
+	var observations []*route53.HealthCheckObservation
+	observations = append(observations, observation("Success: HTTP Status Code 200, OK"))
+	for i := 0; i < 5; i++ {
+		observations = append(observations, observation("Failure: Connection refused."))
+	}
+	assert.Equal(t, model.HealthCheckUnhealthy, model.NewHealthCheckStatusFromAws(aws.String("hc"), observations).Status)
+	observations = append(observations[:5], observation("Success: HTTP Status Code 200, OK"))
+	assert.Equal(t, model.HealthCheckHealthy, model.NewHealthCheckStatusFromAws(aws.String("hc"), observations).Status)
+}
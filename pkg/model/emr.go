@@ -31,7 +31,7 @@ type DescribeInput struct {
 }
 
 type FilterEmrResponse struct {
-	NextMarker *string
+	NextMarker *string // 没有下一页时为 nil
 	Clusters   []EmrCluster
 }
 
@@ -1,8 +1,229 @@
 package model
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	tcerr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	cos "github.com/tencentyun/cos-go-sdk-v5"
+)
 
 var (
 	ErrCloudNotSupported = fmt.Errorf("profile cloud not supported") // 配置文件中的云不能匹配到
 	ErrProfileNotFound   = fmt.Errorf("profile not found")
 )
+
+// ErrorCategory 各云错误码归一后的分类，调用方按分类判断而不是匹配错误字符串
+type ErrorCategory string
+
+const (
+	ErrorCategoryUnknown       ErrorCategory = "Unknown"
+	ErrorCategoryNotFound      ErrorCategory = "NotFound"
+	ErrorCategoryAlreadyExists ErrorCategory = "AlreadyExists"
+	ErrorCategoryThrottled     ErrorCategory = "Throttled"
+	ErrorCategoryAuthFailed    ErrorCategory = "AuthFailed"
+	ErrorCategoryInvalidInput  ErrorCategory = "InvalidInput"
+	ErrorCategoryUnsupported   ErrorCategory = "Unsupported"
+)
+
+// 分类对应的哨兵错误，用于 errors.Is(err, model.ErrNotFound)
+var (
+	ErrNotFound      = errors.New("resource not found")
+	ErrAlreadyExists = errors.New("resource already exists")
+	ErrThrottled     = errors.New("request throttled")
+	ErrAuthFailed    = errors.New("auth failed")
+	ErrInvalidInput  = errors.New("invalid input")
+	ErrUnsupported   = errors.New("unsupported operation")
+)
+
+// ErrTerminalState 等待时资源进入了不可能再到达目标状态的状态，比如创建失败
+var ErrTerminalState = errors.New("resource reached a terminal state")
+
+// ErrRecordNotFound 解析记录不存在，各云的 DescribeRecord 没有匹配到记录时都返回这个错误码
+var ErrRecordNotFound = &CloudError{Code: "RecordNotFound", Category: ErrorCategoryNotFound}
+
+// ErrNotImplemented 当前云没有实现的操作，属于 ErrorCategoryUnsupported，支持哪些操作见 CommonService.Capabilities
+var ErrNotImplemented = &CloudError{Code: "NotImplemented", Category: ErrorCategoryUnsupported}
+
+var categoryErrors = map[ErrorCategory]error{
+	ErrorCategoryNotFound:      ErrNotFound,
+	ErrorCategoryAlreadyExists: ErrAlreadyExists,
+	ErrorCategoryThrottled:     ErrThrottled,
+	ErrorCategoryAuthFailed:    ErrAuthFailed,
+	ErrorCategoryInvalidInput:  ErrInvalidInput,
+	ErrorCategoryUnsupported:   ErrUnsupported,
+}
+
+// CloudError 统一的云 API 错误，Err 保留原始的 sdk 错误，可以通过 errors.As 取回
+type CloudError struct {
+	Provider   Cloud
+	RequestId  string
+	Code       string
+	Message    string
+	HTTPStatus int // 0 表示 sdk 没有返回状态码
+	Category   ErrorCategory
+	Err        error
+}
+
+func (e *CloudError) Error() string {
+	msg := fmt.Sprintf("[%s] %s: %s", e.Provider, e.Code, e.Message)
+	if e.Code == "" {
+		msg = fmt.Sprintf("[%s] %s", e.Provider, e.Message)
+	}
+	if e.RequestId != "" {
+		msg += fmt.Sprintf(" (RequestId: %s)", e.RequestId)
+	}
+	return msg
+}
+
+func (e *CloudError) Unwrap() error {
+	return e.Err
+}
+
+// Is 同分类的哨兵错误以及分类相同的 CloudError 都视为匹配
+func (e *CloudError) Is(target error) bool {
+	if t, ok := target.(*CloudError); ok {
+		return t.Category == e.Category && (t.Provider == "" || t.Provider == e.Provider) && (t.Code == "" || t.Code == e.Code)
+	}
+	if categoryErr, ok := categoryErrors[e.Category]; ok {
+		return target == categoryErr
+	}
+	return false
+}
+
+// NewCloudError 用于 sdk 没有报错但是需要返回分类错误的场景，比如查询结果里没有匹配的记录
+func NewCloudError(provider Cloud, category ErrorCategory, code, message string) *CloudError {
+	return &CloudError{
+		Provider: provider,
+		Code:     code,
+		Message:  message,
+		Category: category,
+	}
+}
+
+func NewRecordNotFoundError(provider Cloud) *CloudError {
+	return NewCloudError(provider, ErrorCategoryNotFound, ErrRecordNotFound.Code, "record not found")
+}
+
+// NewNotImplementedError operation 为 CloudIO 的方法名
+func NewNotImplementedError(provider Cloud, operation string) *CloudError {
+	return NewCloudError(provider, ErrorCategoryUnsupported, ErrNotImplemented.Code, fmt.Sprintf("%s is not implemented for %s", operation, provider))
+}
+
+// ErrorCategoryOf 返回错误的分类，非 CloudError 返回 ErrorCategoryUnknown
+func ErrorCategoryOf(err error) ErrorCategory {
+	var cloudErr *CloudError
+	if errors.As(err, &cloudErr) {
+		return cloudErr.Category
+	}
+	return ErrorCategoryUnknown
+}
+
+// WrapCloudError 把各云 sdk 返回的错误转换为 CloudError，不认识的错误原样返回
+// 支持 awserr.Error、TencentCloudSDKError、cos.ErrorResponse、tea.SDKError、oss.ServiceError
+func WrapCloudError(provider Cloud, err error) error {
+	if err == nil {
+		return nil
+	}
+	var cloudErr *CloudError
+	if errors.As(err, &cloudErr) {
+		return err
+	}
+	e := &CloudError{Provider: provider, Err: err}
+
+	var awsErr awserr.Error
+	var tencentErr *tcerr.TencentCloudSDKError
+	var cosErr *cos.ErrorResponse
+	var teaErr *tea.SDKError
+	var ossErr oss.ServiceError
+	switch {
+	case errors.As(err, &awsErr):
+		e.Code = awsErr.Code()
+		e.Message = awsErr.Message()
+		var reqErr awserr.RequestFailure
+		if errors.As(err, &reqErr) {
+			e.HTTPStatus = reqErr.StatusCode()
+			e.RequestId = reqErr.RequestID()
+		}
+	case errors.As(err, &tencentErr):
+		e.Code = tencentErr.GetCode()
+		e.Message = tencentErr.GetMessage()
+		e.RequestId = tencentErr.GetRequestId()
+	case errors.As(err, &cosErr):
+		e.Code = cosErr.Code
+		e.Message = cosErr.Message
+		e.RequestId = cosErr.RequestID
+		if cosErr.Response != nil {
+			e.HTTPStatus = cosErr.Response.StatusCode
+		}
+	case errors.As(err, &teaErr):
+		e.Code = tea.StringValue(teaErr.Code)
+		e.Message = tea.StringValue(teaErr.Message)
+		e.HTTPStatus = tea.IntValue(teaErr.StatusCode)
+		// 阿里云的 RequestId 在 Data 的响应 body 里
+		var data map[string]any
+		if json.Unmarshal([]byte(tea.StringValue(teaErr.Data)), &data) == nil {
+			if requestId, ok := data["RequestId"].(string); ok {
+				e.RequestId = requestId
+			}
+		}
+	case errors.As(err, &ossErr):
+		e.Code = ossErr.Code
+		e.Message = ossErr.Message
+		e.RequestId = ossErr.RequestID
+		e.HTTPStatus = ossErr.StatusCode
+	default:
+		return err
+	}
+	e.Category = classifyCloudError(e.Code, e.HTTPStatus)
+	return e
+}
+
+// classifyCloudError 按错误码归类，三家云的错误码风格接近，优先看错误码，其次看 http 状态码
+func classifyCloudError(code string, status int) ErrorCategory {
+	switch {
+	// 鉴权类错误码里可能带 NotFound，比如腾讯云的 AuthFailure.SecretIdNotFound，需要先判断
+	case strings.HasPrefix(code, "AuthFailure"), strings.Contains(code, "AccessDenied"),
+		strings.Contains(code, "Unauthorized"), strings.Contains(code, "Forbidden"),
+		strings.Contains(code, "SignatureDoesNotMatch"), strings.Contains(code, "InvalidAccessKeyId"),
+		strings.Contains(code, "InvalidClientTokenId"), strings.Contains(code, "ExpiredToken"),
+		strings.Contains(code, "IncompleteSignature"):
+		return ErrorCategoryAuthFailed
+	case strings.Contains(code, "NotFound"), strings.Contains(code, "NotExist"),
+		strings.Contains(code, "NoExist"), strings.HasPrefix(code, "NoSuch"):
+		return ErrorCategoryNotFound
+	case strings.Contains(code, "Exist"), strings.Contains(code, "Duplicate"),
+		strings.Contains(code, "AlreadyOwned"):
+		return ErrorCategoryAlreadyExists
+	case strings.Contains(code, "Throttl"), strings.HasPrefix(code, "RequestLimitExceeded"),
+		strings.Contains(code, "TooManyRequests"), code == "PriorRequestNotComplete", code == "SlowDown":
+		return ErrorCategoryThrottled
+	case strings.Contains(code, "Unsupported"), strings.Contains(code, "NotSupport"),
+		strings.Contains(code, "NotImplemented"):
+		return ErrorCategoryUnsupported
+	case strings.HasPrefix(code, "Invalid"), strings.HasPrefix(code, "MissingParameter"),
+		strings.Contains(code, "ValidationError"), strings.Contains(code, "MalformedXML"):
+		return ErrorCategoryInvalidInput
+	}
+	switch status {
+	case http.StatusNotFound:
+		return ErrorCategoryNotFound
+	case http.StatusConflict:
+		return ErrorCategoryAlreadyExists
+	case http.StatusTooManyRequests:
+		return ErrorCategoryThrottled
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrorCategoryAuthFailed
+	case http.StatusBadRequest:
+		return ErrorCategoryInvalidInput
+	case http.StatusNotImplemented:
+		return ErrorCategoryUnsupported
+	}
+	return ErrorCategoryUnknown
+}
@@ -0,0 +1,123 @@
+package model_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+	tcerr "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+func TestWrapCloudError(t *testing.T) {
+	cases := []struct {
+		name      string
+		provider  model.Cloud
+		err       error
+		category  model.ErrorCategory
+		sentinel  error
+		code      string
+		requestId string
+		status    int
+	}{
+		{
+			name:      "aws hosted zone not found",
+			provider:  model.AWS,
+			err:       awserr.NewRequestFailure(awserr.New("NoSuchHostedZone", "No hosted zone found with ID: Z123", nil), http.StatusNotFound, "req-aws"),
+			category:  model.ErrorCategoryNotFound,
+			sentinel:  model.ErrNotFound,
+			code:      "NoSuchHostedZone",
+			requestId: "req-aws",
+			status:    http.StatusNotFound,
+		},
+		{
+			name:     "aws throttling",
+			provider: model.AWS,
+			err:      awserr.New("Throttling", "Rate exceeded", nil),
+			category: model.ErrorCategoryThrottled,
+			sentinel: model.ErrThrottled,
+			code:     "Throttling",
+		},
+		{
+			name:      "tencent record not found",
+			provider:  model.TENCENT,
+			err:       tcerr.NewTencentCloudSDKError("ResourceNotFound.NoDataOfRecord", "记录列表为空。", "req-tx"),
+			category:  model.ErrorCategoryNotFound,
+			sentinel:  model.ErrNotFound,
+			code:      "ResourceNotFound.NoDataOfRecord",
+			requestId: "req-tx",
+		},
+		{
+			name:      "tencent secret id not found is auth failure",
+			provider:  model.TENCENT,
+			err:       tcerr.NewTencentCloudSDKError("AuthFailure.SecretIdNotFound", "The SecretId is not found", "req-tx"),
+			category:  model.ErrorCategoryAuthFailed,
+			sentinel:  model.ErrAuthFailed,
+			code:      "AuthFailure.SecretIdNotFound",
+			requestId: "req-tx",
+		},
+		{
+			name:      "tencent record exists",
+			provider:  model.TENCENT,
+			err:       tcerr.NewTencentCloudSDKError("InvalidParameter.DomainRecordExist", "记录已经存在，无需再次添加。", "req-tx"),
+			category:  model.ErrorCategoryAlreadyExists,
+			sentinel:  model.ErrAlreadyExists,
+			code:      "InvalidParameter.DomainRecordExist",
+			requestId: "req-tx",
+		},
+		{
+			name:      "tencent invalid parameter",
+			provider:  model.TENCENT,
+			err:       tcerr.NewTencentCloudSDKError("InvalidParameter.SubdomainInvalid", "子域名不正确。", "req-tx"),
+			category:  model.ErrorCategoryInvalidInput,
+			sentinel:  model.ErrInvalidInput,
+			code:      "InvalidParameter.SubdomainInvalid",
+			requestId: "req-tx",
+		},
+		{
+			name:      "tencent unsupported operation",
+			provider:  model.TENCENT,
+			err:       tcerr.NewTencentCloudSDKError("UnsupportedOperation.InstanceStateRunning", "请求不支持`RUNNING`状态的实例。", "req-tx"),
+			category:  model.ErrorCategoryUnsupported,
+			sentinel:  model.ErrUnsupported,
+			code:      "UnsupportedOperation.InstanceStateRunning",
+			requestId: "req-tx",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// 外层再包一层，模拟调用方 fmt.Errorf("%w")
+			err := fmt.Errorf("call failed: %w", model.WrapCloudError(c.provider, c.err))
+			assert.True(t, errors.Is(err, c.sentinel))
+			assert.Equal(t, c.category, model.ErrorCategoryOf(err))
+
+			var cloudErr *model.CloudError
+			assert.True(t, errors.As(err, &cloudErr))
+			assert.Equal(t, c.provider, cloudErr.Provider)
+			assert.Equal(t, c.code, cloudErr.Code)
+			assert.Equal(t, c.requestId, cloudErr.RequestId)
+			assert.Equal(t, c.status, cloudErr.HTTPStatus)
+			// 原始 sdk 错误仍然可以取到
+			assert.True(t, errors.Is(err, c.err))
+		})
+	}
+}
+
+func TestWrapCloudErrorPassThrough(t *testing.T) {
+	assert.Nil(t, model.WrapCloudError(model.AWS, nil))
+
+	plain := errors.New("region is empty")
+	assert.Equal(t, plain, model.WrapCloudError(model.AWS, plain))
+	assert.Equal(t, model.ErrorCategoryUnknown, model.ErrorCategoryOf(plain))
+
+	// 已经是 CloudError 的不重复包装
+	notFound := model.NewRecordNotFoundError(model.TENCENT)
+	assert.Equal(t, error(notFound), model.WrapCloudError(model.AWS, notFound))
+	assert.True(t, errors.Is(notFound, model.ErrRecordNotFound))
+	assert.True(t, errors.Is(notFound, model.ErrNotFound))
+	assert.False(t, errors.Is(notFound, model.ErrThrottled))
+}
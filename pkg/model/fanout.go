@@ -0,0 +1,74 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FanOutRequest 并发查询多个账号、多个地域
+type FanOutRequest struct {
+	Profiles    []string `json:"profiles"`    // 为空时查询全部账号
+	Regions     []string `json:"regions"`     // 为空时使用账号配置的 Regions，没有配置时使用 Region
+	Concurrency int      `json:"concurrency"` // 同时查询的地域数，默认 8
+}
+
+// Scope 一次查询的账号和地域，桶按账号查询，Region 为空
+type Scope struct {
+	Profile string `json:"profile"`
+	Region  string `json:"region"`
+}
+
+func (s Scope) String() string {
+	if s.Region == "" {
+		return s.Profile
+	}
+	return s.Profile + "/" + s.Region
+}
+
+// ScopedItem 带上来源账号和地域的查询结果
+type ScopedItem[T any] struct {
+	Profile string `json:"profile"`
+	Region  string `json:"region"`
+	Item    T      `json:"item"`
+}
+
+// ScopeError 单个账号、地域查询失败，不影响其他的结果
+type ScopeError struct {
+	Scope
+	Err error `json:"-"`
+}
+
+func (e ScopeError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Scope, e.Err)
+}
+
+func (e ScopeError) Unwrap() error {
+	return e.Err
+}
+
+// FanOutResult Items 按账号、地域的顺序合并，Errors 为查询失败的范围
+type FanOutResult[T any] struct {
+	Items  []ScopedItem[T] `json:"items"`
+	Errors []ScopeError    `json:"errors"`
+}
+
+// Values 去掉账号和地域，只返回查询结果
+func (r FanOutResult[T]) Values() []T {
+	values := make([]T, 0, len(r.Items))
+	for _, item := range r.Items {
+		values = append(values, item.Item)
+	}
+	return values
+}
+
+// Err 全部成功时为 nil，否则合并所有失败，可以用 errors.Is 判断
+func (r FanOutResult[T]) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		errs = append(errs, e)
+	}
+	return errors.Join(errs...)
+}
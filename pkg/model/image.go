@@ -0,0 +1,287 @@
+package model
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+type ImageVisibility string
+
+const (
+	ImagePublic  ImageVisibility = "public"  // 云厂商和市场的公共镜像
+	ImagePrivate ImageVisibility = "private" // 当前账号创建的镜像
+	ImageShared  ImageVisibility = "shared"  // 其他账号共享给当前账号的镜像
+)
+
+type ImageState string
+
+const (
+	ImageStateAvailable ImageState = "available"
+	ImageStatePending   ImageState = "pending" // 创建、复制、导入中
+	ImageStateFailed    ImageState = "failed"
+)
+
+type Image struct {
+	ImageId      *string         `json:"image_id"`
+	Name         *string         `json:"name"`
+	Description  *string         `json:"description"`
+	OSName       *string         `json:"os_name"`  // 腾讯云为操作系统名称，AWS 没有这个字段，使用镜像描述
+	Platform     *string         `json:"platform"` // linux 或 windows
+	Architecture *string         `json:"architecture"`
+	Visibility   ImageVisibility `json:"visibility"`
+	State        ImageState      `json:"state"`
+	SizeGiB      int64           `json:"size_gib"`
+	OwnerId      *string         `json:"owner_id"` // AWS 为账号 ID，腾讯云为创建者
+	CreatedTime  *time.Time      `json:"created_time"`
+	Tags         *Tags           `json:"tags"`
+	Meta         any             `json:"meta"`
+}
+
+// DescribeImagesRequest 过滤条件之间为且的关系
+type DescribeImagesRequest struct {
+	ImageIds     []string         `json:"image_ids"`    // 指定时忽略 Visibility
+	Visibility   *ImageVisibility `json:"visibility"`   // 为空时查询私有和共享镜像，公共镜像很多，建议同时指定 Name
+	Name         *string          `json:"name"`         // 支持 * 通配符，比如 golden-*
+	OSName       *string          `json:"os_name"`      // 按名称、描述或者操作系统包含匹配，不区分大小写，比如 ubuntu
+	Platform     *string          `json:"platform"`     // linux 或 windows
+	Architecture *string          `json:"architecture"` // x86_64 或 arm64
+}
+
+// Match 接口不支持的过滤条件在本地过滤
+func (r DescribeImagesRequest) Match(image Image) bool {
+	if r.Name != nil {
+		if ok, _ := path.Match(*r.Name, tea.StringValue(image.Name)); !ok {
+			return false
+		}
+	}
+	if r.OSName != nil {
+		os := strings.ToLower(*r.OSName)
+		text := strings.ToLower(tea.StringValue(image.Name) + " " + tea.StringValue(image.Description) + " " + tea.StringValue(image.OSName))
+		if !strings.Contains(text, os) {
+			return false
+		}
+	}
+	if r.Platform != nil && !strings.EqualFold(*r.Platform, tea.StringValue(image.Platform)) {
+		return false
+	}
+	if r.Architecture != nil && *r.Architecture != tea.StringValue(image.Architecture) {
+		return false
+	}
+	return true
+}
+
+type CreateImageRequest struct {
+	InstanceId  *string `json:"instance_id" binding:"required"`
+	Name        *string `json:"name" binding:"required"`
+	Description *string `json:"description"`
+	NoReboot    bool    `json:"no_reboot"` // 默认会关机后制作镜像以保证数据一致
+	Tags        Tags    `json:"tags"`
+}
+
+func (r CreateImageRequest) Validate() error {
+	if tea.StringValue(r.InstanceId) == "" || tea.StringValue(r.Name) == "" {
+		return fmt.Errorf("%w: instance_id and name are required", ErrInvalidInput)
+	}
+	return nil
+}
+
+type CreateImageResponse struct {
+	ImageId *string `json:"image_id"`
+	Meta    any     `json:"meta"`
+}
+
+// CopyImageRequest 复制镜像到其他地域，源地域为调用时传入的 region
+type CopyImageRequest struct {
+	ImageId            *string  `json:"image_id" binding:"required"`
+	DestinationRegions []string `json:"destination_regions" binding:"required"`
+	Name               *string  `json:"name"` // 为空时和源镜像同名
+}
+
+func (r CopyImageRequest) Validate() error {
+	if tea.StringValue(r.ImageId) == "" || len(r.DestinationRegions) == 0 {
+		return fmt.Errorf("%w: image_id and destination_regions are required", ErrInvalidInput)
+	}
+	return nil
+}
+
+type CopiedImage struct {
+	Region  string  `json:"region"`
+	ImageId *string `json:"image_id"`
+}
+
+// CopyImageResponse 复制是异步的，目标地域的镜像为 pending 状态
+type CopyImageResponse struct {
+	Images []CopiedImage `json:"images"`
+	Meta   any           `json:"meta"`
+}
+
+type ShareImageRequest struct {
+	ImageId    *string  `json:"image_id" binding:"required"`
+	AccountIds []string `json:"account_ids" binding:"required"` // AWS 为账号 ID，腾讯云为主账号 uin
+	Unshare    bool     `json:"unshare"`                        // true 时取消共享
+}
+
+func (r ShareImageRequest) Validate() error {
+	if tea.StringValue(r.ImageId) == "" || len(r.AccountIds) == 0 {
+		return fmt.Errorf("%w: image_id and account_ids are required", ErrInvalidInput)
+	}
+	return nil
+}
+
+type DeleteImageRequest struct {
+	ImageId         *string `json:"image_id" binding:"required"`
+	DeleteSnapshots bool    `json:"delete_snapshots"` // 同时删除镜像关联的快照
+}
+
+func NewImageFromAws(image *ec2.Image, visibility ImageVisibility) Image {
+	m := Image{
+		ImageId:      image.ImageId,
+		Name:         image.Name,
+		Description:  image.Description,
+		OSName:       image.Description,
+		Platform:     tea.String("linux"),
+		Architecture: image.Architecture,
+		Visibility:   visibility,
+		OwnerId:      image.OwnerId,
+		Tags:         AwsTagsToModelTags(image.Tags),
+		Meta:         image,
+	}
+	if strings.EqualFold(tea.StringValue(image.Platform), "windows") {
+		m.Platform = tea.String("windows")
+	}
+	if tea.BoolValue(image.Public) {
+		m.Visibility = ImagePublic
+	}
+	switch state := tea.StringValue(image.State); state {
+	case "available", "pending", "failed":
+		m.State = ImageState(state)
+	case "error", "invalid":
+		m.State = ImageStateFailed
+	default:
+		m.State = ImageState(state)
+	}
+	for _, device := range image.BlockDeviceMappings {
+		if device.Ebs != nil {
+			m.SizeGiB += tea.Int64Value(device.Ebs.VolumeSize)
+		}
+	}
+	if t, err := time.Parse(time.RFC3339, tea.StringValue(image.CreationDate)); err == nil {
+		m.CreatedTime = &t
+	}
+	return m
+}
+
+// AwsImageSnapshotIds 镜像关联的 EBS 快照
+func AwsImageSnapshotIds(image *ec2.Image) []*string {
+	var ids []*string
+	for _, device := range image.BlockDeviceMappings {
+		if device.Ebs != nil && device.Ebs.SnapshotId != nil {
+			ids = append(ids, device.Ebs.SnapshotId)
+		}
+	}
+	return ids
+}
+
+var tencentImageVisibility = map[string]ImageVisibility{
+	"PUBLIC_IMAGE":      ImagePublic,
+	"MARKET_IMAGE":      ImagePublic,
+	"PRIVATE_IMAGE":     ImagePrivate,
+	"SHARED_IMAGE":      ImageShared,
+	"TRANSFORMED_IMAGE": ImagePrivate,
+}
+
+// ToTencentImageTypes 腾讯云 image-type 过滤的取值
+func (v ImageVisibility) ToTencentImageTypes() []string {
+	switch v {
+	case ImagePublic:
+		return []string{"PUBLIC_IMAGE"}
+	case ImagePrivate:
+		return []string{"PRIVATE_IMAGE"}
+	case ImageShared:
+		return []string{"SHARED_IMAGE"}
+	}
+	return []string{"PRIVATE_IMAGE", "SHARED_IMAGE"}
+}
+
+func NewImageFromTencent(image *cvm.Image) Image {
+	m := Image{
+		ImageId:      image.ImageId,
+		Name:         image.ImageName,
+		Description:  image.ImageDescription,
+		OSName:       image.OsName,
+		Platform:     tea.String("linux"),
+		Architecture: image.Architecture,
+		Visibility:   tencentImageVisibility[tea.StringValue(image.ImageType)],
+		SizeGiB:      tea.Int64Value(image.ImageSize),
+		OwnerId:      image.ImageCreator,
+		Tags:         TencentTagsToModelTags(image.Tags),
+		Meta:         image,
+	}
+	if strings.EqualFold(tea.StringValue(image.Platform), "windows") {
+		m.Platform = tea.String("windows")
+	}
+	if tea.StringValue(image.Architecture) == "arm" {
+		m.Architecture = tea.String(ArchitectureArm64)
+	}
+	switch state := tea.StringValue(image.ImageState); state {
+	case "NORMAL", "USING":
+		m.State = ImageStateAvailable
+	case "CREATING", "SYNCING", "IMPORTING":
+		m.State = ImageStatePending
+	case "CREATEFAILED", "IMPORTFAILED":
+		m.State = ImageStateFailed
+	default:
+		m.State = ImageState(strings.ToLower(state))
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05"} {
+		if t, err := time.ParseInLocation(layout, tea.StringValue(image.CreatedTime), time.FixedZone("CST", 8*3600)); err == nil {
+			m.CreatedTime = &t
+			break
+		}
+	}
+	return m
+}
+
+// ToAwsCreateImageInput 镜像和快照都打上标签
+func (r CreateImageRequest) ToAwsCreateImageInput() *ec2.CreateImageInput {
+	input := &ec2.CreateImageInput{
+		InstanceId:  r.InstanceId,
+		Name:        r.Name,
+		Description: r.Description,
+		NoReboot:    tea.Bool(r.NoReboot),
+	}
+	if len(r.Tags) > 0 {
+		for _, resourceType := range []string{ec2.ResourceTypeImage, ec2.ResourceTypeSnapshot} {
+			input.TagSpecifications = append(input.TagSpecifications, &ec2.TagSpecification{
+				ResourceType: tea.String(resourceType),
+				Tags:         r.Tags.ToAwsEc2Tags(),
+			})
+		}
+	}
+	return input
+}
+
+func (r CreateImageRequest) ToTencentCreateImageRequest() *cvm.CreateImageRequest {
+	request := cvm.NewCreateImageRequest()
+	request.InstanceId = r.InstanceId
+	request.ImageName = r.Name
+	request.ImageDescription = r.Description
+	request.ForcePoweroff = tea.String("TRUE")
+	if r.NoReboot {
+		request.ForcePoweroff = tea.String("FALSE")
+	}
+	if len(r.Tags) > 0 {
+		spec := &cvm.TagSpecification{ResourceType: tea.String("image")}
+		for _, tag := range r.Tags {
+			spec.Tags = append(spec.Tags, &cvm.Tag{Key: tea.String(tag.Key), Value: tea.String(tag.Value)})
+		}
+		request.TagSpecification = []*cvm.TagSpecification{spec}
+	}
+	return request
+}
@@ -1,9 +1,12 @@
 package model
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 )
@@ -29,8 +32,20 @@ type InstanceFilter struct {
 	PublicIp   *string         `json:"public_ip"`   // 公有IP
 	Status     *InstanceStatus `json:"status"`      // 机器状态
 	Owner      *string         `json:"owner"`       // 机器所有者，tags的Owner
-	Size       *int64          `json:"size"`        // 分页大小
-	NextMarker *string         `json:"next_marker"` // 如果没有下一页，返回nil 腾讯云直接返回所有数据，不需要分页
+	Size       *int64          `json:"size"`        // 分页大小，Size 和 NextMarker 都为空时返回全部
+	NextMarker *string         `json:"next_marker"` // 上一页返回的游标
+}
+
+// ToDescribeInstancesInput 按云转换过滤条件，未单独适配的云沿用腾讯云的过滤字段
+func (q *InstanceFilter) ToDescribeInstancesInput(cloud Cloud) DescribeInstancesInput {
+	switch cloud {
+	case AWS:
+		return q.ToAwsDescribeInstancesInput()
+	case ALIYUN:
+		return q.ToAliyunDescribeInstancesInput()
+	default:
+		return q.ToTxDescribeInstancesInput()
+	}
 }
 
 func (q *InstanceFilter) ToTxDescribeInstancesInput() DescribeInstancesInput {
@@ -122,6 +137,56 @@ func (q *InstanceFilter) ToAwsDescribeInstancesInput() DescribeInstancesInput {
 	}
 }
 
+// 阿里云 Filter.Name 直接使用 DescribeInstances 的请求参数名，标签使用 tag:Key 的形式
+func (q *InstanceFilter) ToAliyunDescribeInstancesInput() DescribeInstancesInput {
+	var instanceIds []*string
+	if q.IDs != nil {
+		instanceIds = append(instanceIds, q.IDs...)
+	}
+	var filters []*Filter
+	if q.Name != nil {
+		filters = append(filters, &Filter{
+			Name:   tea.String("InstanceName"),
+			Values: []*string{tea.String("*" + *q.Name + "*")},
+		})
+	}
+	if q.PrivateIp != nil {
+		filters = append(filters, &Filter{
+			Name:   tea.String("PrivateIpAddresses"),
+			Values: []*string{q.PrivateIp},
+		})
+	}
+	if q.PublicIp != nil {
+		filters = append(filters, &Filter{
+			Name:   tea.String("PublicIpAddresses"),
+			Values: []*string{q.PublicIp},
+		})
+	}
+	if q.Status != nil {
+		// RUNNING -> Running
+		status := strings.ToLower(string(*q.Status))
+		if status != "" {
+			status = strings.ToUpper(status[:1]) + status[1:]
+		}
+		filters = append(filters, &Filter{
+			Name:   tea.String("Status"),
+			Values: []*string{tea.String(status)},
+		})
+	}
+	if q.Owner != nil {
+		filters = append(filters, &Filter{
+			Name:   tea.String("tag:Owner"),
+			Values: []*string{q.Owner},
+		})
+	}
+	return DescribeInstancesInput{
+		InstanceIds: instanceIds,
+		Filters:     filters,
+		NextMarker:  q.NextMarker,
+		Size:        q.Size,
+	}
+}
+
 // 每次请求的`Filters`的上限为10，`Filter.Values`的上限为5。参数不支持同时指定`InstanceIds`和`Filters`。
 type DescribeInstancesInput struct {
 	InstanceIds []*string
@@ -137,7 +202,7 @@ type Filter struct {
 
 type InstanceResponse struct {
 	Instances  []Instance `json:"instances"`
-	NextMarker *string    `json:"next_marker"` // 如果没有下一页，返回nil 腾讯云直接返回所有数据，不需要分页
+	NextMarker *string    `json:"next_marker"` // 如果没有下一页，返回nil
 }
 
 // Create
@@ -211,6 +276,7 @@ func (i *CreateInstanceInput) ToTencentRunInstancesRequest() *cvm.RunInstancesRe
 	for _, disk := range i.DataDisks {
 		request.DataDisks = append(request.DataDisks, &cvm.DataDisk{
 			DiskSize: disk.Size,
+			DiskType: disk.Type,
 		})
 	}
 	request.CamRoleName = i.RoleName
@@ -245,6 +311,134 @@ func (i *CreateInstanceInput) ToTencentRunInstancesRequest() *cvm.RunInstancesRe
 	return request
 }
 
+// 阿里云 RunInstances 的请求参数，默认按量付费，默认值和腾讯云保持一致
+// 不设置默认密码，没有 Password 和 KeyIds 时实例创建后需要在控制台重置密码
+func (i *CreateInstanceInput) ToAliyunRunInstancesRequest(region string) map[string]*string {
+	request := map[string]*string{
+		"RegionId":           tea.String(region),
+		"InstanceChargeType": tea.String("PostPaid"),
+		"Amount":             tea.String("1"),
+		"ImageId":            i.ImageID,
+		"InstanceType":       i.InstanceType,
+		"SystemDisk.Size":    tea.String("40"),
+		"UserData":           tea.String("IyEvYmluL2Jhc2gKZWNobyAiSGVsbG8gTXVsdGlDbG91ZFNkayIK"),
+	}
+	if i.InstanceChargeType != nil {
+		request["InstanceChargeType"] = i.InstanceChargeType
+	}
+	if i.Count != nil {
+		request["Amount"] = tea.String(fmt.Sprint(*i.Count))
+	}
+	if i.Zone != nil {
+		request["ZoneId"] = i.Zone
+	}
+	if i.SystemDisk != nil {
+		if i.SystemDisk.Size != nil {
+			request["SystemDisk.Size"] = tea.String(fmt.Sprint(*i.SystemDisk.Size))
+		}
+		request["SystemDisk.Category"] = i.SystemDisk.Type
+	}
+	for n, disk := range i.DataDisks {
+		if disk.Size != nil {
+			request[fmt.Sprintf("DataDisk.%d.Size", n+1)] = tea.String(fmt.Sprint(*disk.Size))
+		}
+		request[fmt.Sprintf("DataDisk.%d.Category", n+1)] = disk.Type
+	}
+	request["RamRoleName"] = i.RoleName
+	request["VSwitchId"] = i.SubnetID
+	for n, id := range i.SecurityGroupIDs {
+		request[fmt.Sprintf("SecurityGroupIds.%d", n+1)] = id
+	}
+	request["InstanceName"] = i.Name
+	if i.UserData != nil {
+		request["UserData"] = i.UserData
+	}
+	if i.Password != nil {
+		request["Password"] = i.Password
+	}
+	// 阿里云只支持绑定一个密钥对
+	if len(i.KeyIds) > 0 {
+		request["KeyPairName"] = i.KeyIds[0]
+		delete(request, "Password")
+	}
+	for n, tag := range i.Tags {
+		request[fmt.Sprintf("Tag.%d.Key", n+1)] = tea.String(tag.Key)
+		request[fmt.Sprintf("Tag.%d.Value", n+1)] = tea.String(tag.Value)
+	}
+	// 去掉未设置的参数
+	for k, v := range request {
+		if v == nil {
+			delete(request, k)
+		}
+	}
+	return request
+}
+
+// AWS RunInstances 的请求参数，rootDeviceName 为镜像的根设备名，为空时不设置系统盘
+// 数据盘从 /dev/sdf 开始依次挂载到 /dev/sdz，最多 21 块，Disk.Type 为 EBS 卷类型，比如 gp3
+// AWS 没有密码登录，Password 不生效，多个 KeyIds 只取第一个
+func (i *CreateInstanceInput) ToAwsRunInstancesInput(rootDeviceName string) (*ec2.RunInstancesInput, error) {
+	if len(i.DataDisks) > 'z'-'f'+1 {
+		return nil, fmt.Errorf("%w: aws supports at most %d data disks (/dev/sdf to /dev/sdz)", ErrInvalidInput, 'z'-'f'+1)
+	}
+	input := &ec2.RunInstancesInput{
+		ImageId:          i.ImageID,
+		InstanceType:     i.InstanceType,
+		MinCount:         aws.Int64(1),
+		MaxCount:         aws.Int64(1),
+		SubnetId:         i.SubnetID,
+		SecurityGroupIds: i.SecurityGroupIDs,
+		UserData:         i.UserData,
+	}
+	if i.Count != nil {
+		input.MinCount = i.Count
+		input.MaxCount = i.Count
+	}
+	if i.Zone != nil {
+		input.Placement = &ec2.Placement{AvailabilityZone: i.Zone}
+	}
+	if len(i.KeyIds) > 0 {
+		input.KeyName = i.KeyIds[0]
+	}
+	if i.RoleName != nil {
+		input.IamInstanceProfile = &ec2.IamInstanceProfileSpecification{Name: i.RoleName}
+	}
+	if i.SystemDisk != nil && rootDeviceName != "" {
+		input.BlockDeviceMappings = append(input.BlockDeviceMappings, &ec2.BlockDeviceMapping{
+			DeviceName: aws.String(rootDeviceName),
+			Ebs: &ec2.EbsBlockDevice{
+				VolumeSize:          i.SystemDisk.Size,
+				VolumeType:          i.SystemDisk.Type,
+				DeleteOnTermination: aws.Bool(true),
+			},
+		})
+	}
+	for n, disk := range i.DataDisks {
+		input.BlockDeviceMappings = append(input.BlockDeviceMappings, &ec2.BlockDeviceMapping{
+			DeviceName: aws.String(fmt.Sprintf("/dev/sd%c", 'f'+n)),
+			Ebs: &ec2.EbsBlockDevice{
+				VolumeSize:          disk.Size,
+				VolumeType:          disk.Type,
+				DeleteOnTermination: aws.Bool(true),
+			},
+		})
+	}
+	// 名称在 AWS 上就是 Name 标签，实例和随实例创建的卷打一样的标签
+	tags := append(Tags{}, i.Tags...)
+	if i.Name != nil && tags.GetName() == nil {
+		tags = append(tags, Tag{Key: "Name", Value: *i.Name})
+	}
+	if len(tags) > 0 {
+		for _, resourceType := range []string{ec2.ResourceTypeInstance, ec2.ResourceTypeVolume} {
+			input.TagSpecifications = append(input.TagSpecifications, &ec2.TagSpecification{
+				ResourceType: aws.String(resourceType),
+				Tags:         tags.ToAwsEc2Tags(),
+			})
+		}
+	}
+	return input, nil
+}
+
 type CreateInstanceResponse struct {
 	Meta        any       `json:"meta"`
 	InstanceIds []*string `json:"instance_ids"`
@@ -254,6 +448,7 @@ type ModifyInstanceInput struct {
 	Action       ModifyAction
 	InstanceIDs  []*string `json:"instance_ids"`  // ["ins-r8hr2upy","ins-5d8a23rs"]
 	InstanceType *string   `json:"instance_type"` // Action="change_instance_type" 时必填
+	Tags         Tags      `json:"tags"`          // Action="change_instance_tags" 时必填，已有的同名标签会被覆盖
 }
 
 type ModifyAction string
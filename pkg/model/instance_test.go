@@ -0,0 +1,109 @@
+package model_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+func TestToAwsRunInstancesInput(t *testing.T) {
+	input := model.CreateInstanceInput{
+		Name:             tea.String("web-1"),
+		ImageID:          tea.String("ami-123"),
+		InstanceType:     tea.String("t3.micro"),
+		Zone:             tea.String("cn-northwest-1a"),
+		SubnetID:         tea.String("subnet-1"),
+		SecurityGroupIDs: []*string{tea.String("sg-1")},
+		KeyIds:           []*string{tea.String("key-1"), tea.String("key-2")},
+		RoleName:         tea.String("ec2-role"),
+		UserData:         tea.String("IyEvYmluL2Jhc2g="),
+		Password:         tea.String("ignored"),
+		SystemDisk:       &model.Disk{Size: tea.Int64(40), Type: tea.String("gp3")},
+		DataDisks:        []model.Disk{{Size: tea.Int64(100), Type: tea.String("gp3")}, {Size: tea.Int64(200)}},
+		Tags:             model.Tags{{Key: "Owner", Value: "ops"}},
+	}
+	req, err := input.ToAwsRunInstancesInput("/dev/xvda")
+	assert.Nil(t, err)
+	assert.Nil(t, req.Validate())
+	assert.Equal(t, int64(1), aws.Int64Value(req.MinCount))
+	assert.Equal(t, int64(1), aws.Int64Value(req.MaxCount))
+	assert.Equal(t, "cn-northwest-1a", aws.StringValue(req.Placement.AvailabilityZone))
+	assert.Equal(t, "key-1", aws.StringValue(req.KeyName))
+	assert.Equal(t, "ec2-role", aws.StringValue(req.IamInstanceProfile.Name))
+
+	assert.Len(t, req.BlockDeviceMappings, 3)
+	assert.Equal(t, "/dev/xvda", aws.StringValue(req.BlockDeviceMappings[0].DeviceName))
+	assert.Equal(t, int64(40), aws.Int64Value(req.BlockDeviceMappings[0].Ebs.VolumeSize))
+	assert.Equal(t, "/dev/sdf", aws.StringValue(req.BlockDeviceMappings[1].DeviceName))
+	assert.Equal(t, "gp3", aws.StringValue(req.BlockDeviceMappings[1].Ebs.VolumeType))
+	assert.Equal(t, "/dev/sdg", aws.StringValue(req.BlockDeviceMappings[2].DeviceName))
+
+	// 实例和卷都打上标签，名称转成 Name 标签
+	assert.Len(t, req.TagSpecifications, 2)
+	for _, spec := range req.TagSpecifications {
+		tags := model.AwsTagsToModelTags(spec.Tags)
+		assert.Equal(t, "web-1", aws.StringValue(tags.GetName()))
+		assert.Equal(t, "ops", aws.StringValue(tags.GetOwner()))
+	}
+	// 调用方的 Tags 不被修改
+	assert.Len(t, input.Tags, 1)
+
+	// 没有根设备名时不设置系统盘
+	req, err = input.ToAwsRunInstancesInput("")
+	assert.Nil(t, err)
+	assert.Len(t, req.BlockDeviceMappings, 2)
+
+	// 数据盘最多挂载到 /dev/sdz
+	input.DataDisks = make([]model.Disk, 21)
+	req, err = input.ToAwsRunInstancesInput("")
+	assert.Nil(t, err)
+	assert.Equal(t, "/dev/sdz", aws.StringValue(req.BlockDeviceMappings[20].DeviceName))
+	input.DataDisks = append(input.DataDisks, model.Disk{})
+	_, err = input.ToAwsRunInstancesInput("")
+	assert.True(t, errors.Is(err, model.ErrInvalidInput))
+}
+
+func TestToTencentRunInstancesRequest(t *testing.T) {
+	input := model.CreateInstanceInput{
+		ImageID:      tea.String("img-123"),
+		InstanceType: tea.String("S5.MEDIUM4"),
+		SystemDisk:   &model.Disk{Size: tea.Int64(50), Type: tea.String("CLOUD_SSD")},
+		DataDisks:    []model.Disk{{Size: tea.Int64(100), Type: tea.String("CLOUD_HSSD")}, {Size: tea.Int64(200)}},
+	}
+	req := input.ToTencentRunInstancesRequest()
+	assert.Equal(t, "CLOUD_SSD", tea.StringValue(req.SystemDisk.DiskType))
+	assert.Len(t, req.DataDisks, 2)
+	assert.Equal(t, "CLOUD_HSSD", tea.StringValue(req.DataDisks[0].DiskType))
+	assert.Equal(t, int64(100), tea.Int64Value(req.DataDisks[0].DiskSize))
+	// 没有指定类型时使用腾讯云的默认类型
+	assert.Nil(t, req.DataDisks[1].DiskType)
+}
+
+func TestToAliyunRunInstancesRequest(t *testing.T) {
+	input := model.CreateInstanceInput{
+		ImageID:      tea.String("ubuntu_22_04_x64"),
+		InstanceType: tea.String("ecs.g7.large"),
+	}
+	// 没有指定密码和密钥时不设置密码
+	req := input.ToAliyunRunInstancesRequest("cn-hangzhou")
+	_, ok := req["Password"]
+	assert.False(t, ok)
+	_, ok = req["KeyPairName"]
+	assert.False(t, ok)
+
+	input.Password = tea.String("Secret@123")
+	req = input.ToAliyunRunInstancesRequest("cn-hangzhou")
+	assert.Equal(t, "Secret@123", tea.StringValue(req["Password"]))
+
+	// 指定密钥时使用密钥登录
+	input.KeyIds = []*string{tea.String("my-key")}
+	req = input.ToAliyunRunInstancesRequest("cn-hangzhou")
+	assert.Equal(t, "my-key", tea.StringValue(req["KeyPairName"]))
+	_, ok = req["Password"]
+	assert.False(t, ok)
+}
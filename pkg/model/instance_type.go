@@ -0,0 +1,195 @@
+package model
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+const (
+	ArchitectureX86   = "x86_64"
+	ArchitectureArm64 = "arm64"
+)
+
+// InstanceTypeFilter 都为空时返回地域内全部的机型
+type InstanceTypeFilter struct {
+	InstanceTypes []string `json:"instance_types"` // AWS 一次最多 100 个
+	Zone          *string  `json:"zone"`           // 只返回该可用区售卖的机型
+	Family        *string  `json:"family"`         // 比如 m5、S5
+	Architecture  *string  `json:"architecture"`   // x86_64 或 arm64
+	MinCPU        int64    `json:"min_cpu"`
+	MinMemoryGiB  float64  `json:"min_memory_gib"`
+	GPU           *bool    `json:"gpu"` // true 只返回 GPU 机型，false 只返回没有 GPU 的机型
+}
+
+type InstanceTypeZone struct {
+	Zone  string            `json:"zone"`
+	State AvailabilityState `json:"state"` // 腾讯云售罄时为 unavailable
+}
+
+type InstanceType struct {
+	InstanceType         string             `json:"instance_type"`
+	Family               string             `json:"family"`
+	CPU                  int64              `json:"cpu"`
+	MemoryGiB            float64            `json:"memory_gib"`
+	GPU                  int64              `json:"gpu"`
+	GPUModel             string             `json:"gpu_model"`
+	Architecture         string             `json:"architecture"`
+	NetworkBandwidthGbps float64            `json:"network_bandwidth_gbps"` // 内网带宽，AWS 为峰值
+	NetworkPerformance   string             `json:"network_performance"`    // AWS 的描述，比如 Up to 10 Gigabit
+	Zones                []InstanceTypeZone `json:"zones"`                  // 售卖的可用区
+	Meta                 any                `json:"meta"`
+}
+
+// Available 在 zone 售卖，zone 为空时任一可用区售卖即可
+func (t InstanceType) Available(zone string) bool {
+	for _, z := range t.Zones {
+		if (zone == "" || z.Zone == zone) && z.State == AvailabilityAvailable {
+			return true
+		}
+	}
+	return false
+}
+
+// Match 规格是否满足过滤条件，Zone 和 InstanceTypes 由接口过滤
+func (f InstanceTypeFilter) Match(t InstanceType) bool {
+	if f.Family != nil && !strings.EqualFold(*f.Family, t.Family) {
+		return false
+	}
+	if f.Architecture != nil && *f.Architecture != t.Architecture {
+		return false
+	}
+	if t.CPU < f.MinCPU || t.MemoryGiB < f.MinMemoryGiB {
+		return false
+	}
+	if f.GPU != nil && *f.GPU != (t.GPU > 0) {
+		return false
+	}
+	return true
+}
+
+func NewInstanceTypeFromAws(info *ec2.InstanceTypeInfo) InstanceType {
+	name := tea.StringValue(info.InstanceType)
+	t := InstanceType{
+		InstanceType: name,
+		Family:       strings.SplitN(name, ".", 2)[0],
+		Architecture: ArchitectureX86,
+		Meta:         info,
+	}
+	if info.VCpuInfo != nil {
+		t.CPU = tea.Int64Value(info.VCpuInfo.DefaultVCpus)
+	}
+	if info.MemoryInfo != nil {
+		t.MemoryGiB = float64(tea.Int64Value(info.MemoryInfo.SizeInMiB)) / 1024
+	}
+	if info.GpuInfo != nil {
+		for _, gpu := range info.GpuInfo.Gpus {
+			t.GPU += tea.Int64Value(gpu.Count)
+			t.GPUModel = strings.TrimSpace(tea.StringValue(gpu.Manufacturer) + " " + tea.StringValue(gpu.Name))
+		}
+	}
+	if info.ProcessorInfo != nil {
+		for _, arch := range info.ProcessorInfo.SupportedArchitectures {
+			if tea.StringValue(arch) == ArchitectureArm64 {
+				t.Architecture = ArchitectureArm64
+			}
+		}
+	}
+	if info.NetworkInfo != nil {
+		t.NetworkPerformance = tea.StringValue(info.NetworkInfo.NetworkPerformance)
+		for _, card := range info.NetworkInfo.NetworkCards {
+			t.NetworkBandwidthGbps = math.Max(t.NetworkBandwidthGbps, tea.Float64Value(card.PeakBandwidthInGbps))
+		}
+	}
+	return t
+}
+
+// 腾讯云没有返回架构，按 CPU 型号判断
+var tencentArmCPUs = []string{"ampere", "kunpeng", "鲲鹏", "yitian", "倚天"}
+
+// NewInstanceTypeFromTencent items 为同一个机型在各个可用区的配置
+func NewInstanceTypeFromTencent(items []*cvm.InstanceTypeQuotaItem) InstanceType {
+	first := items[0]
+	t := InstanceType{
+		InstanceType:         tea.StringValue(first.InstanceType),
+		Family:               tea.StringValue(first.InstanceFamily),
+		CPU:                  tea.Int64Value(first.Cpu),
+		MemoryGiB:            float64(tea.Int64Value(first.Memory)),
+		GPU:                  tea.Int64Value(first.Gpu),
+		Architecture:         ArchitectureX86,
+		NetworkBandwidthGbps: tea.Float64Value(first.InstanceBandwidth),
+		Meta:                 items,
+	}
+	// vGPU 机型 Gpu 为 0，GpuCount 为小数
+	if t.GPU == 0 && tea.Float64Value(first.GpuCount) > 0 {
+		t.GPU = int64(math.Ceil(tea.Float64Value(first.GpuCount)))
+	}
+	cpuType := strings.ToLower(tea.StringValue(first.CpuType))
+	for _, arm := range tencentArmCPUs {
+		if strings.Contains(cpuType, arm) {
+			t.Architecture = ArchitectureArm64
+		}
+	}
+	for _, item := range items {
+		zone := InstanceTypeZone{Zone: tea.StringValue(item.Zone), State: AvailabilityAvailable}
+		if tea.StringValue(item.Status) != "SELL" {
+			zone.State = AvailabilityUnavailable
+		}
+		t.Zones = append(t.Zones, zone)
+	}
+	return t
+}
+
+// ClosestInstanceType 从 candidates 中选出和 spec 最接近的机型，用于在另一个云上找对应的机型。
+// 架构和有没有 GPU 必须一致，CPU、内存、GPU 数量不能少于 spec，
+// 其次按 CPU 和内存超出的比例最小，比例相同时优先和 spec 的内存/CPU 比一致的机型。
+// zone 不为空时只考虑在该可用区售卖的机型。
+func ClosestInstanceType(spec InstanceType, candidates []InstanceType, zone string) (InstanceType, bool) {
+	var matched []InstanceType
+	for _, c := range candidates {
+		if spec.Architecture != "" && c.Architecture != spec.Architecture {
+			continue
+		}
+		if (spec.GPU > 0) != (c.GPU > 0) || c.GPU < spec.GPU {
+			continue
+		}
+		if c.CPU < spec.CPU || c.MemoryGiB < spec.MemoryGiB {
+			continue
+		}
+		if zone != "" && !c.Available(zone) {
+			continue
+		}
+		matched = append(matched, c)
+	}
+	if len(matched) == 0 {
+		return InstanceType{}, false
+	}
+	ratio := func(t InstanceType) float64 {
+		if t.CPU == 0 {
+			return 0
+		}
+		return t.MemoryGiB / float64(t.CPU)
+	}
+	score := func(t InstanceType) float64 {
+		s := 0.0
+		if spec.CPU > 0 {
+			s += float64(t.CPU-spec.CPU) / float64(spec.CPU)
+		}
+		if spec.MemoryGiB > 0 {
+			s += (t.MemoryGiB - spec.MemoryGiB) / spec.MemoryGiB
+		}
+		return s
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		si, sj := score(matched[i]), score(matched[j])
+		if si != sj {
+			return si < sj
+		}
+		return math.Abs(ratio(matched[i])-ratio(spec)) < math.Abs(ratio(matched[j])-ratio(spec))
+	})
+	return matched[0], true
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClosestInstanceType(t *testing.T) {
+	zones := []InstanceTypeZone{{Zone: "z1", State: AvailabilityAvailable}}
+	candidates := []InstanceType{
+		{InstanceType: "c.large", CPU: 2, MemoryGiB: 4, Architecture: ArchitectureX86, Zones: zones},
+		{InstanceType: "m.large", CPU: 2, MemoryGiB: 8, Architecture: ArchitectureX86, Zones: zones},
+		{InstanceType: "r.large", CPU: 2, MemoryGiB: 16, Architecture: ArchitectureX86, Zones: zones},
+		{InstanceType: "m.xlarge", CPU: 4, MemoryGiB: 16, Architecture: ArchitectureX86},
+		{InstanceType: "mg.large", CPU: 2, MemoryGiB: 8, Architecture: ArchitectureArm64, Zones: zones},
+		{InstanceType: "g.xlarge", CPU: 4, MemoryGiB: 16, GPU: 1, Architecture: ArchitectureX86, Zones: zones},
+	}
+	closest := func(spec InstanceType, zone string) string {
+		t, _ := ClosestInstanceType(spec, candidates, zone)
+		return t.InstanceType
+	}
+	assert.Equal(t, "m.large", closest(InstanceType{CPU: 2, MemoryGiB: 8, Architecture: ArchitectureX86}, ""))
+	assert.Equal(t, "mg.large", closest(InstanceType{CPU: 2, MemoryGiB: 8, Architecture: ArchitectureArm64}, ""))
+	// 3 核 6G 没有完全一致的，选超出最少的
+	assert.Equal(t, "m.xlarge", closest(InstanceType{CPU: 3, MemoryGiB: 6, Architecture: ArchitectureX86}, ""))
+	// m.xlarge 在 z1 没有售卖
+	assert.Equal(t, "g.xlarge", closest(InstanceType{CPU: 4, MemoryGiB: 16, GPU: 1}, "z1"))
+	assert.Equal(t, "", closest(InstanceType{CPU: 4, MemoryGiB: 16, Architecture: ArchitectureX86}, "z1"))
+	assert.Equal(t, "", closest(InstanceType{CPU: 2, MemoryGiB: 8, GPU: 2}, ""))
+}
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+// KeyPair AWS 的密钥对按地域隔离，腾讯云的密钥对所有地域通用
+type KeyPair struct {
+	KeyId       *string    `json:"key_id"` // AWS 为 key-xxx，腾讯云为 skey-xxx，创建实例时 KeyIds 使用，AWS 创建实例使用 Name
+	Name        *string    `json:"name"`
+	Fingerprint *string    `json:"fingerprint"` // 只有 AWS 返回
+	PublicKey   *string    `json:"public_key"`
+	CreatedTime *time.Time `json:"created_time"`
+	InstanceIds []*string  `json:"instance_ids"` // 绑定的实例，只有腾讯云返回
+	Tags        *Tags      `json:"tags"`
+	Meta        any        `json:"meta"`
+}
+
+type DescribeKeyPairsRequest struct {
+	KeyIds []string `json:"key_ids"`
+	Name   *string  `json:"name"` // 精确匹配
+}
+
+// CreateKeyPairRequest 腾讯云的名称只能包含字母、数字和下划线，最长 25 个字符
+type CreateKeyPairRequest struct {
+	Name    *string `json:"name" binding:"required"`
+	KeyType *string `json:"key_type"` // rsa 或 ed25519，只有 AWS 支持，腾讯云为 rsa
+	Tags    Tags    `json:"tags"`
+}
+
+func (r CreateKeyPairRequest) Validate() error {
+	if tea.StringValue(r.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidInput)
+	}
+	return nil
+}
+
+// CreateKeyPairResponse 私钥只在创建时返回一次，需要调用方保存
+type CreateKeyPairResponse struct {
+	KeyPair    KeyPair `json:"key_pair"`
+	PrivateKey *string `json:"private_key"`
+}
+
+type ImportKeyPairRequest struct {
+	Name      *string `json:"name" binding:"required"`
+	PublicKey *string `json:"public_key" binding:"required"` // OpenSSH 格式，比如 ssh-ed25519 AAAA... user@host
+	Tags      Tags    `json:"tags"`
+}
+
+func (r ImportKeyPairRequest) Validate() error {
+	if tea.StringValue(r.Name) == "" || tea.StringValue(r.PublicKey) == "" {
+		return fmt.Errorf("%w: name and public_key are required", ErrInvalidInput)
+	}
+	if !strings.HasPrefix(strings.TrimSpace(*r.PublicKey), "ssh-") && !strings.HasPrefix(strings.TrimSpace(*r.PublicKey), "ecdsa-") {
+		return fmt.Errorf("%w: public_key must be in OpenSSH format", ErrInvalidInput)
+	}
+	return nil
+}
+
+type DeleteKeyPairRequest struct {
+	KeyId *string `json:"key_id" binding:"required"`
+}
+
+// KeyPairAssociationRequest 绑定或者解绑实例的密钥，只有腾讯云支持，实例需要关机，ForceStop 时会强制关机
+type KeyPairAssociationRequest struct {
+	KeyIds      []*string `json:"key_ids" binding:"required"`
+	InstanceIds []*string `json:"instance_ids" binding:"required"`
+	ForceStop   bool      `json:"force_stop"`
+}
+
+func (r KeyPairAssociationRequest) Validate() error {
+	if len(r.KeyIds) == 0 || len(r.InstanceIds) == 0 {
+		return fmt.Errorf("%w: key_ids and instance_ids are required", ErrInvalidInput)
+	}
+	return nil
+}
+
+func NewKeyPairFromAws(key *ec2.KeyPairInfo) KeyPair {
+	return KeyPair{
+		KeyId:       key.KeyPairId,
+		Name:        key.KeyName,
+		Fingerprint: key.KeyFingerprint,
+		PublicKey:   key.PublicKey,
+		CreatedTime: key.CreateTime,
+		Tags:        AwsTagsToModelTags(key.Tags),
+		Meta:        key,
+	}
+}
+
+func NewKeyPairFromTencent(key *cvm.KeyPair) KeyPair {
+	m := KeyPair{
+		KeyId:       key.KeyId,
+		Name:        key.KeyName,
+		PublicKey:   key.PublicKey,
+		InstanceIds: key.AssociatedInstanceIds,
+		Tags:        TencentTagsToModelTags(key.Tags),
+		Meta:        key,
+	}
+	if t, err := time.Parse(time.RFC3339, tea.StringValue(key.CreatedTime)); err == nil {
+		m.CreatedTime = &t
+	}
+	return m
+}
+
+// ToAwsKeyPairTags AWS 创建和导入时的标签
+func ToAwsKeyPairTags(tags Tags) []*ec2.TagSpecification {
+	if len(tags) == 0 {
+		return nil
+	}
+	return []*ec2.TagSpecification{{ResourceType: tea.String(ec2.ResourceTypeKeyPair), Tags: tags.ToAwsEc2Tags()}}
+}
+
+// ToTencentKeyPairTags 腾讯云创建和导入时的标签
+func ToTencentKeyPairTags(tags Tags) []*cvm.TagSpecification {
+	if len(tags) == 0 {
+		return nil
+	}
+	spec := &cvm.TagSpecification{ResourceType: tea.String("keypair")}
+	for _, tag := range tags {
+		spec.Tags = append(spec.Tags, &cvm.Tag{Key: tea.String(tag.Key), Value: tea.String(tag.Value)})
+	}
+	return []*cvm.TagSpecification{spec}
+}
@@ -1,69 +1,121 @@
 package model
 
+import "context"
+
 type CloudIO interface {
-	DescribeInstances(profile, region string, input DescribeInstancesInput) (InstanceResponse, error)
-	CreateInstance(profile, region string, input CreateInstanceInput) (CreateInstanceResponse, error)
-	ModifyInstance(profile, region string, input ModifyInstanceInput) (ModifyInstanceResponse, error)
-	DeleteInstance(profile, region string, input DeleteInstanceInput) (DeleteInstanceResponse, error)
+	DescribeInstances(ctx context.Context, profile, region string, input DescribeInstancesInput) (InstanceResponse, error)
+	CreateInstance(ctx context.Context, profile, region string, input CreateInstanceInput) (CreateInstanceResponse, error)
+	ModifyInstance(ctx context.Context, profile, region string, input ModifyInstanceInput) (ModifyInstanceResponse, error)
+	DeleteInstance(ctx context.Context, profile, region string, input DeleteInstanceInput) (DeleteInstanceResponse, error)
+
+	// Region ListRegions 的 region 为调用接口使用的地域，为空时使用各云的默认地域
+	ListRegions(ctx context.Context, profile, region string) ([]Region, error)
+	ListZones(ctx context.Context, profile, region string) ([]Zone, error)
+	DescribeInstanceTypes(ctx context.Context, profile, region string, filter InstanceTypeFilter) ([]InstanceType, error)
+
+	// Image
+	DescribeImages(ctx context.Context, profile, region string, input DescribeImagesRequest) ([]Image, error)
+	CreateImage(ctx context.Context, profile, region string, input CreateImageRequest) (CreateImageResponse, error)
+	CopyImage(ctx context.Context, profile, region string, input CopyImageRequest) (CopyImageResponse, error)
+	ShareImage(ctx context.Context, profile, region string, input ShareImageRequest) error
+	DeleteImage(ctx context.Context, profile, region string, input DeleteImageRequest) error
+
+	// KeyPair AWS 按地域隔离，腾讯云所有地域通用
+	DescribeKeyPairs(ctx context.Context, profile, region string, input DescribeKeyPairsRequest) ([]KeyPair, error)
+	CreateKeyPair(ctx context.Context, profile, region string, input CreateKeyPairRequest) (CreateKeyPairResponse, error)
+	ImportKeyPair(ctx context.Context, profile, region string, input ImportKeyPairRequest) (KeyPair, error)
+	DeleteKeyPair(ctx context.Context, profile, region string, input DeleteKeyPairRequest) error
+	AssociateKeyPairs(ctx context.Context, profile, region string, input KeyPairAssociationRequest) error
+	DisassociateKeyPairs(ctx context.Context, profile, region string, input KeyPairAssociationRequest) error
+
+	// Volume AWS 为 EBS，腾讯云为 CBS
+	DescribeVolumes(ctx context.Context, profile, region string, input DescribeVolumesRequest) ([]Volume, error)
+	CreateVolume(ctx context.Context, profile, region string, input CreateVolumeRequest) (CreateVolumeResponse, error)
+	ResizeVolume(ctx context.Context, profile, region string, input ResizeVolumeRequest) error
+	AttachVolume(ctx context.Context, profile, region string, input AttachVolumeRequest) error
+	DetachVolume(ctx context.Context, profile, region string, input DetachVolumeRequest) error
+	DeleteVolume(ctx context.Context, profile, region string, input DeleteVolumeRequest) error
 
 	// VPC
-	QueryVPC(profile, region string, input CommonFilter) ([]VPC, error)
-	QuerySubnet(profile, region string, input CommonFilter) ([]Subnet, error)
-	QueryEIP(profile, region string, input CommonFilter) ([]EIP, error)
-	QueryNAT(profile, region string, input CommonFilter) ([]NAT, error)
-	CreateSecurityGroupWithPolicies(profile, region string, input CreateSecurityGroupWithPoliciesInput) (CreateSecurityGroupWithPoliciesResponse, error) // 创建安全组并添加策略
-	CreateSecurityGroupPolicies(profile, region string, input CreateSecurityGroupPoliciesInput) (CreateSecurityGroupPoliciesResponse, error)             // 创建安全组策略
+	QueryVPC(ctx context.Context, profile, region string, input CommonFilter) ([]VPC, error)
+	QuerySubnet(ctx context.Context, profile, region string, input CommonFilter) ([]Subnet, error)
+	QueryEIP(ctx context.Context, profile, region string, input CommonFilter) ([]EIP, error)
+	QueryNAT(ctx context.Context, profile, region string, input CommonFilter) ([]NAT, error)
+	CreateSecurityGroupWithPolicies(ctx context.Context, profile, region string, input CreateSecurityGroupWithPoliciesInput) (CreateSecurityGroupWithPoliciesResponse, error) // 创建安全组并添加策略
+	CreateSecurityGroupPolicies(ctx context.Context, profile, region string, input CreateSecurityGroupPoliciesInput) (CreateSecurityGroupPoliciesResponse, error)             // 创建安全组策略
 
 	// Tags
-	CreateTags(profile, region string, input CreateTagsInput) error
-	AddTagsToResource(profile, region string, input AddTagsInput) error
-	RemoveTagsFromResource(profile, region string, input RemoveTagsInput) error
-	ModifyTagsForResource(profile, region string, input ModifyTagsInput) error
+	CreateTags(ctx context.Context, profile, region string, input CreateTagsInput) error
+	AddTagsToResource(ctx context.Context, profile, region string, input AddTagsInput) error
+	RemoveTagsFromResource(ctx context.Context, profile, region string, input RemoveTagsInput) error
+	ModifyTagsForResource(ctx context.Context, profile, region string, input ModifyTagsInput) error
 
 	// EMR
-	QueryEmrCluster(EmrFilter) (FilterEmrResponse, error) // 方便 Post使用，将Profile和Region放入filter
-	DescribeEmrCluster(DescribeInput) ([]DescribeEmrCluster, error)
-	CreateEmrCluster(profile, region string, input CreateEmrClusterInput) (CreateEmrClusterResponse, error)
+	QueryEmrCluster(ctx context.Context, filter EmrFilter) (FilterEmrResponse, error) // 方便 Post使用，将Profile和Region放入filter
+	DescribeEmrCluster(ctx context.Context, input DescribeInput) ([]DescribeEmrCluster, error)
+	CreateEmrCluster(ctx context.Context, profile, region string, input CreateEmrClusterInput) (CreateEmrClusterResponse, error)
 
 	// tencent region is not required
-	DescribeDomainList(profile, region string, input DescribeDomainListRequest) (DescribeDomainListResponse, error)
+	DescribeDomainList(ctx context.Context, profile, region string, input DescribeDomainListRequest) (DescribeDomainListResponse, error)
 	// tencent region is not required
-	DescribeRecordList(profile, region string, input DescribeRecordListRequest) (DescribeRecordListResponse, error)
+	DescribeRecordList(ctx context.Context, profile, region string, input DescribeRecordListRequest) (DescribeRecordListResponse, error)
 	// tencent region is not required
-	DescribeRecordListWithPages(profile, region string, input DescribeRecordListWithPageRequest) (ListRecordsPageResponse, error)
+	DescribeRecordListWithPages(ctx context.Context, profile, region string, input DescribeRecordListWithPageRequest) (ListRecordsPageResponse, error)
 	// tencent region is not required
-	DescribeRecord(profile, region string, input DescribeRecordRequest) (Record, error)
+	DescribeRecord(ctx context.Context, profile, region string, input DescribeRecordRequest) (Record, error)
 	// tencent region is not required
-	CreateRecord(profile, region string, input CreateRecordRequest) (CreateRecordResponse, error)
+	CreateRecord(ctx context.Context, profile, region string, input CreateRecordRequest) (CreateRecordResponse, error)
 	// tencent region is not required
-	ModifyRecord(profile, region string, ignoreType bool, input ModifyRecordRequest) error
+	ModifyRecord(ctx context.Context, profile, region string, ignoreType bool, input ModifyRecordRequest) error
 	// tencent region is not required
-	DeleteRecord(profile, region string, input DeleteRecordRequest) (CommonDnsResponse, error)
+	DeleteRecord(ctx context.Context, profile, region string, input DeleteRecordRequest) (CommonDnsResponse, error)
+	// ChangeRecordSet 一次提交多个记录变更，aws 是原子的，腾讯云失败时尽量回滚
+	ChangeRecordSet(ctx context.Context, profile, region string, input ChangeRecordSetRequest) (ChangeRecordSetResponse, error)
+	// DescribeRecordLineList 腾讯云、阿里云为解析线路，aws 为地理位置
+	DescribeRecordLineList(ctx context.Context, profile, region string, input DescribeRecordLineListRequest) (DescribeRecordLineListResponse, error)
+	// GetRecordChange 查询 aws 变更的状态，公有域、私有域通用
+	GetRecordChange(ctx context.Context, profile, changeId string) (RecordChangeStatus, error)
 
 	// Private_Dns
-	DescribePrivateDomainList(profile string, input DescribeDomainListRequest) (DescribePrivateDomainListResponse, error)
-	CreatePrivateRecord(profile string, input CreateRecordRequest) (CreateRecordResponse, error)
-	DeletePrivateRecord(profile string, input DeletePrivateRecordRequest) error
-	ModifyPrivateRecord(profile string, input ModifyRecordRequest) error
-	DescribePrivateRecordList(profile string, input DescribePrivateRecordListRequest) (DescribePrivateRecordListResponse, error)
-	DescribePrivateRecordListWithPages(profile string, input DescribePrivateDnsRecordListWithPageRequest) (ListRecordsPageResponse, error)
+	DescribePrivateDomainList(ctx context.Context, profile string, input DescribeDomainListRequest) (DescribePrivateDomainListResponse, error)
+	CreatePrivateRecord(ctx context.Context, profile string, input CreateRecordRequest) (CreateRecordResponse, error)
+	DeletePrivateRecord(ctx context.Context, profile string, input DeletePrivateRecordRequest) error
+	ModifyPrivateRecord(ctx context.Context, profile string, input ModifyRecordRequest) error
+	DescribePrivateRecordList(ctx context.Context, profile string, input DescribePrivateRecordListRequest) (DescribePrivateRecordListResponse, error)
+	DescribePrivateRecordListWithPages(ctx context.Context, profile string, input DescribePrivateDnsRecordListWithPageRequest) (ListRecordsPageResponse, error)
+	ChangePrivateRecordSet(ctx context.Context, profile string, input ChangeRecordSetRequest) (ChangeRecordSetResponse, error)
+	CreatePrivateZone(ctx context.Context, profile string, input CreatePrivateZoneRequest) (CreatePrivateZoneResponse, error)
+	DeletePrivateZone(ctx context.Context, profile string, input DeletePrivateZoneRequest) error
+	AddZoneVpcAssociation(ctx context.Context, profile string, input ZoneVpcAssociationRequest) error
+	RemoveZoneVpcAssociation(ctx context.Context, profile string, input ZoneVpcAssociationRequest) error
+
+	// HealthCheck 全局资源，不区分 region
+	DescribeHealthChecks(ctx context.Context, profile string) ([]HealthCheck, error)
+	CreateHealthCheck(ctx context.Context, profile string, input CreateHealthCheckRequest) (CreateHealthCheckResponse, error)
+	ModifyHealthCheck(ctx context.Context, profile string, input ModifyHealthCheckRequest) error
+	DeleteHealthCheck(ctx context.Context, profile, id string) error
+	DescribeHealthCheckStatus(ctx context.Context, profile, id string) (HealthCheckStatus, error)
+
+	// RegisteredDomain 域名注册信息，全局资源，不区分 region
+	DescribeRegisteredDomains(ctx context.Context, profile string, input DescribeRegisteredDomainsRequest) ([]RegisteredDomain, error)
+	DescribeRegisteredDomain(ctx context.Context, profile, domain string) (RegisteredDomain, error)
 
 	// OCR
-	CommonOCR(profile, region string, input OcrRequest) (OcrResponse, error)
-	CreatePicture(profile, region string, input CreatePictureRequest) (CreatePictureResponse, error)
-	GetPictureByName(profile, region string, input CommonPictureRequest) (GetPictureByNameResponse, error)
-	QueryPicture(profile, region string, input QueryPictureRequest) (QueryPictureResponse, error)
-	DeletePicture(profile, region string, input CommonPictureRequest) (CommonPictureResponse, error)
-	UpdatePicture(profile, region string, input UpdatePictureRequest) (CommonPictureResponse, error)
-	SearchPicture(profile, region string, input SearchPictureRequest) (SearchPictureResponse, error)
+	CommonOCR(ctx context.Context, profile, region string, input OcrRequest) (OcrResponse, error)
+	CreatePicture(ctx context.Context, profile, region string, input CreatePictureRequest) (CreatePictureResponse, error)
+	GetPictureByName(ctx context.Context, profile, region string, input CommonPictureRequest) (GetPictureByNameResponse, error)
+	QueryPicture(ctx context.Context, profile, region string, input QueryPictureRequest) (QueryPictureResponse, error)
+	DeletePicture(ctx context.Context, profile, region string, input CommonPictureRequest) (CommonPictureResponse, error)
+	UpdatePicture(ctx context.Context, profile, region string, input UpdatePictureRequest) (CommonPictureResponse, error)
+	SearchPicture(ctx context.Context, profile, region string, input SearchPictureRequest) (SearchPictureResponse, error)
 
 	// S3 COS
-	CreateBucket(profile, region string, input CreateBucketRequest) error
-	CreateBucketLifecycle(profile, region string, input CreateBucketLifecycleRequest) error
-	GetBucketLifecycle(profile, region string, input GetBucketLifecycleRequest) (GetBucketLifecycleResponse, error)
+	CreateBucket(ctx context.Context, profile, region string, input CreateBucketRequest) error
+	CreateBucketLifecycle(ctx context.Context, profile, region string, input CreateBucketLifecycleRequest) error
+	GetBucketLifecycle(ctx context.Context, profile, region string, input GetBucketLifecycleRequest) (GetBucketLifecycleResponse, error)
 	// DeleteBucketLifecycle(profile, region string, input DeleteBucketLifecycleRequest) error
-	DeleteBucket(profile, region string, input DeleteBucketRequest) (DeleteBucketResponse, error)
-	ListBucket(profile, region string, input ListBucketRequest) (ListBucketResponse, error) // 比官方多支持了 aws location 返回，并且都带上了tag返回。
-	GetObjectPregisn(profile, region string, input ObjectPregisnRequest) (ObjectPregisnResponse, error)
-	GetObjectPregisnWithAKSK(ak, sk, region string, input ObjectPregisnRequest) (ObjectPregisnResponse, error) // 支持AKSK的方式获取对象的预签名URL
+	DeleteBucket(ctx context.Context, profile, region string, input DeleteBucketRequest) (DeleteBucketResponse, error)
+	ListBucket(ctx context.Context, profile, region string, input ListBucketRequest) (ListBucketResponse, error) // 比官方多支持了 aws location 返回，并且都带上了tag返回。
+	GetObjectPregisn(ctx context.Context, profile, region string, input ObjectPregisnRequest) (ObjectPregisnResponse, error)
+	GetObjectPregisnWithAKSK(ctx context.Context, ak, sk, region string, input ObjectPregisnRequest) (ObjectPregisnResponse, error) // 支持AKSK的方式获取对象的预签名URL
 }
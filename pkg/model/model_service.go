@@ -1,6 +1,7 @@
 package model
 
 import (
+	"context"
 	"time"
 )
 
@@ -30,14 +31,34 @@ type CommonContract interface {
 	PrivateCreateRecord(profile string, req CreateRecordRequest) (CreateRecordResponse, error)
 	PrivateModifyRecord(profile string, req ModifyRecordRequest) error
 	PrivateDeleteRecord(profile string, req DeletePrivateRecordRequest) error
+	PrivateChangeRecordSet(profile string, req ChangeRecordSetRequest) (ChangeRecordSetResponse, error)
+	CreatePrivateZone(profile string, req CreatePrivateZoneRequest) (CreatePrivateZoneResponse, error)
+	DeletePrivateZone(profile string, req DeletePrivateZoneRequest) error
+	AddZoneVpcAssociation(profile string, req ZoneVpcAssociationRequest) error
+	RemoveZoneVpcAssociation(profile string, req ZoneVpcAssociationRequest) error
 
 	DescribeDomainList(profile, region string, req DescribeDomainListRequest) (DescribeDomainListResponse, error)
 	DescribeRecordList(profile, region string, req DescribeRecordListRequest) (DescribeRecordListResponse, error)
+	DescribeRecordLineList(profile, region string, req DescribeRecordLineListRequest) (DescribeRecordLineListResponse, error)
 	DescribeRecordListWithPages(profile, region string, req DescribeRecordListWithPageRequest) (ListRecordsPageResponse, error)
 	DescribeRecord(profile, region string, req DescribeRecordRequest) (Record, error)
 	CreateRecord(profile, region string, req CreateRecordRequest) (CreateRecordResponse, error)
 	ModifyRecord(profile, region string, ignoreType bool, req ModifyRecordRequest) error
 	DeleteRecord(profile, region string, req DeleteRecordRequest) (CommonDnsResponse, error)
+	ChangeRecordSet(profile, region string, req ChangeRecordSetRequest) (ChangeRecordSetResponse, error)
+	ExportZone(profile, region string, req ExportZoneRequest) (ZoneFile, error)
+	ImportZone(profile, region string, req ImportZoneRequest) (ImportZoneResponse, error)
+	PlanDnsZone(profile, region string, spec DnsZoneSpec) (DnsPlan, error)
+	ApplyDnsPlan(profile, region string, plan DnsPlan) (ApplyDnsPlanResponse, error)
+	WaitForRecordChange(profile, region string, req WaitForRecordChangeRequest) (WaitForRecordChangeResponse, error)
+	DescribeHealthChecks(profile string) ([]HealthCheck, error)
+	CreateHealthCheck(profile string, req CreateHealthCheckRequest) (CreateHealthCheckResponse, error)
+	ModifyHealthCheck(profile string, req ModifyHealthCheckRequest) error
+	DeleteHealthCheck(profile, id string) error
+	DescribeHealthCheckStatus(profile, id string) (HealthCheckStatus, error)
+	DescribeRegisteredDomains(profile string, req DescribeRegisteredDomainsRequest) ([]RegisteredDomain, error)
+	DescribeRegisteredDomain(profile, domain string) (RegisteredDomain, error)
+	DomainExpiryReport(req DomainExpiryReportRequest) (DomainExpiryReport, error)
 
 	DescribeEmrCluster(DescribeInput) ([]DescribeEmrCluster, error)
 	QueryEmrCluster(EmrFilter) (FilterEmrResponse, error)
@@ -46,6 +67,33 @@ type CommonContract interface {
 	CreateInstance(profile, region string, input CreateInstanceInput) (CreateInstanceResponse, error)
 	ModifyInstance(profile, region string, input ModifyInstanceInput) (ModifyInstanceResponse, error)
 	DeleteInstance(profile, region string, input DeleteInstanceInput) (DeleteInstanceResponse, error)
+	WaitForInstanceStatus(profile, region string, ids []*string, target InstanceStatus, opts WaitOptions) ([]Instance, error)
+
+	ListRegions(profile string) ([]Region, error)
+	ListZones(profile, region string) ([]Zone, error)
+	DescribeInstanceTypes(profile, region string, filter InstanceTypeFilter) ([]InstanceType, error)
+
+	DescribeImages(profile, region string, input DescribeImagesRequest) ([]Image, error)
+	DescribeImage(profile, region, imageId string) (Image, error)
+	CreateImage(profile, region string, input CreateImageRequest) (CreateImageResponse, error)
+	CopyImage(profile, region string, input CopyImageRequest) (CopyImageResponse, error)
+	ShareImage(profile, region string, input ShareImageRequest) error
+	DeleteImage(profile, region string, input DeleteImageRequest) error
+
+	DescribeKeyPairs(profile, region string, input DescribeKeyPairsRequest) ([]KeyPair, error)
+	CreateKeyPair(profile, region string, input CreateKeyPairRequest) (CreateKeyPairResponse, error)
+	ImportKeyPair(profile, region string, input ImportKeyPairRequest) (KeyPair, error)
+	DeleteKeyPair(profile, region string, input DeleteKeyPairRequest) error
+	AssociateKeyPairs(profile, region string, input KeyPairAssociationRequest) error
+	DisassociateKeyPairs(profile, region string, input KeyPairAssociationRequest) error
+
+	DescribeVolumes(profile, region string, input DescribeVolumesRequest) ([]Volume, error)
+	DescribeVolume(profile, region, volumeId string) (Volume, error)
+	CreateVolume(profile, region string, input CreateVolumeRequest) (CreateVolumeResponse, error)
+	ResizeVolume(profile, region string, input ResizeVolumeRequest) error
+	AttachVolume(profile, region string, input AttachVolumeRequest) error
+	DetachVolume(profile, region string, input DetachVolumeRequest) error
+	DeleteVolume(profile, region string, input DeleteVolumeRequest) error
 
 	QueryVPCs(profile, region string, input CommonFilter) ([]VPC, error)
 	QuerySubnets(profile, region string, input CommonFilter) ([]Subnet, error)
@@ -61,4 +109,137 @@ type CommonContract interface {
 
 	GetObjectPregisn(profile, region string, input ObjectPregisnRequest) (ObjectPregisnResponse, error)
 	GetObjectPregisnWithAKSK(cloud Cloud, ak, sk, region string, input ObjectPregisnRequest) (ObjectPregisnResponse, error)
+
+	// 以下为支持 context 的版本，可以通过 ctx 取消请求或者设置超时，上面的方法等价于传入 context.Background()
+	QueryOcrWithContext(ctx context.Context, profile, region string, input OcrRequest) (OcrResponse, error)
+	// tiia
+	CreatePictureWithContext(ctx context.Context, profile, region string, input CreatePictureRequest) (CreatePictureResponse, error)
+	GetPictureByNameWithContext(ctx context.Context, profile, region string, input CommonPictureRequest) (GetPictureByNameResponse, error)
+	QueryPictureWithContext(ctx context.Context, profile, region string, input QueryPictureRequest) (QueryPictureResponse, error)
+	DeletePictureWithContext(ctx context.Context, profile, region string, input CommonPictureRequest) (CommonPictureResponse, error)
+	UpdatePictureWithContext(ctx context.Context, profile, region string, input UpdatePictureRequest) (CommonPictureResponse, error)
+	SearchPictureWithContext(ctx context.Context, profile, region string, input SearchPictureRequest) (SearchPictureResponse, error)
+
+	PrivateDomainListWithContext(ctx context.Context, profile string, req DescribeDomainListRequest) (DescribePrivateDomainListResponse, error)
+	PrivateRecordListWithContext(ctx context.Context, profile string, req DescribePrivateRecordListRequest) (DescribePrivateRecordListResponse, error)
+	PrivateRecordListWithPagesWithContext(ctx context.Context, profile string, req DescribePrivateDnsRecordListWithPageRequest) (ListRecordsPageResponse, error)
+	PrivateCreateRecordWithContext(ctx context.Context, profile string, req CreateRecordRequest) (CreateRecordResponse, error)
+	PrivateModifyRecordWithContext(ctx context.Context, profile string, req ModifyRecordRequest) error
+	PrivateDeleteRecordWithContext(ctx context.Context, profile string, req DeletePrivateRecordRequest) error
+	PrivateChangeRecordSetWithContext(ctx context.Context, profile string, req ChangeRecordSetRequest) (ChangeRecordSetResponse, error)
+	// CreatePrivateZoneWithContext 创建私有域并关联 VPC，VPC 的 AccountId 不为空时跨账号关联
+	CreatePrivateZoneWithContext(ctx context.Context, profile string, req CreatePrivateZoneRequest) (CreatePrivateZoneResponse, error)
+	DeletePrivateZoneWithContext(ctx context.Context, profile string, req DeletePrivateZoneRequest) error
+	AddZoneVpcAssociationWithContext(ctx context.Context, profile string, req ZoneVpcAssociationRequest) error
+	RemoveZoneVpcAssociationWithContext(ctx context.Context, profile string, req ZoneVpcAssociationRequest) error
+
+	DescribeDomainListWithContext(ctx context.Context, profile, region string, req DescribeDomainListRequest) (DescribeDomainListResponse, error)
+	DescribeRecordListWithContext(ctx context.Context, profile, region string, req DescribeRecordListRequest) (DescribeRecordListResponse, error)
+	// DescribeRecordLineListWithContext 腾讯云按域名套餐返回解析线路，aws 返回地理位置
+	DescribeRecordLineListWithContext(ctx context.Context, profile, region string, req DescribeRecordLineListRequest) (DescribeRecordLineListResponse, error)
+	DescribeRecordListWithPagesWithContext(ctx context.Context, profile, region string, req DescribeRecordListWithPageRequest) (ListRecordsPageResponse, error)
+	DescribeRecordWithContext(ctx context.Context, profile, region string, req DescribeRecordRequest) (Record, error)
+	CreateRecordWithContext(ctx context.Context, profile, region string, req CreateRecordRequest) (CreateRecordResponse, error)
+	ModifyRecordWithContext(ctx context.Context, profile, region string, ignoreType bool, req ModifyRecordRequest) error
+	DeleteRecordWithContext(ctx context.Context, profile, region string, req DeleteRecordRequest) (CommonDnsResponse, error)
+	// ChangeRecordSetWithContext 多个记录变更一次提交，aws 为一个 ChangeBatch，腾讯云使用批量接口并在失败时尽量回滚
+	ChangeRecordSetWithContext(ctx context.Context, profile, region string, req ChangeRecordSetRequest) (ChangeRecordSetResponse, error)
+	// ExportZoneWithContext 导出为 RFC 1035 zone file，ImportZoneWithContext 只创建目标域名中缺少的记录
+	ExportZoneWithContext(ctx context.Context, profile, region string, req ExportZoneRequest) (ZoneFile, error)
+	ImportZoneWithContext(ctx context.Context, profile, region string, req ImportZoneRequest) (ImportZoneResponse, error)
+	// PlanDnsZoneWithContext 计算声明式记录和线上记录的差异，ApplyDnsPlanWithContext 执行差异
+	PlanDnsZoneWithContext(ctx context.Context, profile, region string, spec DnsZoneSpec) (DnsPlan, error)
+	ApplyDnsPlanWithContext(ctx context.Context, profile, region string, plan DnsPlan) (ApplyDnsPlanResponse, error)
+	// WaitForRecordChangeWithContext 等待记录生效，aws 轮询 GetChange，其他云轮询记录列表，可选直接查询权威 DNS
+	WaitForRecordChangeWithContext(ctx context.Context, profile, region string, req WaitForRecordChangeRequest) (WaitForRecordChangeResponse, error)
+	// HealthCheck 目前只有 aws 支持，通过记录的 RoutingPolicy.HealthCheckId 关联，腾讯云 D 监控暂缓实现
+	DescribeHealthChecksWithContext(ctx context.Context, profile string) ([]HealthCheck, error)
+	CreateHealthCheckWithContext(ctx context.Context, profile string, req CreateHealthCheckRequest) (CreateHealthCheckResponse, error)
+	ModifyHealthCheckWithContext(ctx context.Context, profile string, req ModifyHealthCheckRequest) error
+	DeleteHealthCheckWithContext(ctx context.Context, profile, id string) error
+	DescribeHealthCheckStatusWithContext(ctx context.Context, profile, id string) (HealthCheckStatus, error)
+	// DescribeRegisteredDomainsWithContext 注册的域名，aws 为 Route53 Domains，腾讯云为域名注册服务
+	DescribeRegisteredDomainsWithContext(ctx context.Context, profile string, req DescribeRegisteredDomainsRequest) ([]RegisteredDomain, error)
+	DescribeRegisteredDomainWithContext(ctx context.Context, profile, domain string) (RegisteredDomain, error)
+	// DomainExpiryReportWithContext 汇总多个账号即将到期的域名，不支持的云跳过，查询失败的账号记录在 Failed
+	DomainExpiryReportWithContext(ctx context.Context, req DomainExpiryReportRequest) (DomainExpiryReport, error)
+
+	DescribeEmrClusterWithContext(ctx context.Context, input DescribeInput) ([]DescribeEmrCluster, error)
+	QueryEmrClusterWithContext(ctx context.Context, filter EmrFilter) (FilterEmrResponse, error)
+
+	DescribeInstancesWithContext(ctx context.Context, profile, region string, input InstanceFilter) (InstanceResponse, error)
+	CreateInstanceWithContext(ctx context.Context, profile, region string, input CreateInstanceInput) (CreateInstanceResponse, error)
+	ModifyInstanceWithContext(ctx context.Context, profile, region string, input ModifyInstanceInput) (ModifyInstanceResponse, error)
+	DeleteInstanceWithContext(ctx context.Context, profile, region string, input DeleteInstanceInput) (DeleteInstanceResponse, error)
+	// WaitForInstanceStatusWithContext 按指数退避轮询，实例创建失败时提前返回 ErrTerminalState
+	WaitForInstanceStatusWithContext(ctx context.Context, profile, region string, ids []*string, target InstanceStatus, opts WaitOptions) ([]Instance, error)
+
+	// ListRegionsWithContext 使用账号配置的 Region 调用接口，AWS 中国区账号需要配置
+	ListRegionsWithContext(ctx context.Context, profile string) ([]Region, error)
+	ListZonesWithContext(ctx context.Context, profile, region string) ([]Zone, error)
+	DescribeInstanceTypesWithContext(ctx context.Context, profile, region string, filter InstanceTypeFilter) ([]InstanceType, error)
+	// EquivalentInstanceTypeWithContext 在 profile 的地域中找和 spec 最接近的机型，spec 一般是另一个云 DescribeInstanceTypes 返回的
+	EquivalentInstanceTypeWithContext(ctx context.Context, profile, region string, spec InstanceType, zone string) (InstanceType, error)
+
+	DescribeImagesWithContext(ctx context.Context, profile, region string, input DescribeImagesRequest) ([]Image, error)
+	DescribeImageWithContext(ctx context.Context, profile, region, imageId string) (Image, error)
+	CreateImageWithContext(ctx context.Context, profile, region string, input CreateImageRequest) (CreateImageResponse, error)
+	// CopyImageWithContext 复制到其他地域是异步的，返回目标地域的镜像 ID
+	CopyImageWithContext(ctx context.Context, profile, region string, input CopyImageRequest) (CopyImageResponse, error)
+	ShareImageWithContext(ctx context.Context, profile, region string, input ShareImageRequest) error
+	DeleteImageWithContext(ctx context.Context, profile, region string, input DeleteImageRequest) error
+
+	DescribeKeyPairsWithContext(ctx context.Context, profile, region string, input DescribeKeyPairsRequest) ([]KeyPair, error)
+	// CreateKeyPairWithContext 私钥只在这里返回一次，云上不会保存
+	CreateKeyPairWithContext(ctx context.Context, profile, region string, input CreateKeyPairRequest) (CreateKeyPairResponse, error)
+	ImportKeyPairWithContext(ctx context.Context, profile, region string, input ImportKeyPairRequest) (KeyPair, error)
+	DeleteKeyPairWithContext(ctx context.Context, profile, region string, input DeleteKeyPairRequest) error
+	// AssociateKeyPairsWithContext 只有腾讯云支持绑定已有实例，AWS 返回 ErrNotImplemented
+	AssociateKeyPairsWithContext(ctx context.Context, profile, region string, input KeyPairAssociationRequest) error
+	DisassociateKeyPairsWithContext(ctx context.Context, profile, region string, input KeyPairAssociationRequest) error
+
+	DescribeVolumesWithContext(ctx context.Context, profile, region string, input DescribeVolumesRequest) ([]Volume, error)
+	DescribeVolumeWithContext(ctx context.Context, profile, region, volumeId string) (Volume, error)
+	CreateVolumeWithContext(ctx context.Context, profile, region string, input CreateVolumeRequest) (CreateVolumeResponse, error)
+	// ResizeVolumeWithContext 只能扩容，扩容后需要在系统内扩展分区和文件系统
+	ResizeVolumeWithContext(ctx context.Context, profile, region string, input ResizeVolumeRequest) error
+	// AttachVolumeWithContext AWS 没有指定设备名时使用第一个空闲的 /dev/sd[f-p]
+	AttachVolumeWithContext(ctx context.Context, profile, region string, input AttachVolumeRequest) error
+	DetachVolumeWithContext(ctx context.Context, profile, region string, input DetachVolumeRequest) error
+	DeleteVolumeWithContext(ctx context.Context, profile, region string, input DeleteVolumeRequest) error
+
+	QueryVPCsWithContext(ctx context.Context, profile, region string, input CommonFilter) ([]VPC, error)
+	QuerySubnetsWithContext(ctx context.Context, profile, region string, input CommonFilter) ([]Subnet, error)
+	QueryEIPsWithContext(ctx context.Context, profile, region string, input CommonFilter) ([]EIP, error)
+	QueryNATsWithContext(ctx context.Context, profile, region string, input CommonFilter) ([]NAT, error)
+
+	CreateBucketWithContext(ctx context.Context, profile, region string, input CreateBucketRequest) error
+	DeleteBucketWithContext(ctx context.Context, profile, region string, input DeleteBucketRequest) (DeleteBucketResponse, error)
+	ListBucketsWithContext(ctx context.Context, profile, region string, input ListBucketRequest) (ListBucketResponse, error)
+
+	CreateBucketLifecycleWithContext(ctx context.Context, profile, region string, input CreateBucketLifecycleRequest) error
+	GetBucketLifecycleWithContext(ctx context.Context, profile, region string, input GetBucketLifecycleRequest) (GetBucketLifecycleResponse, error)
+
+	GetObjectPregisnWithContext(ctx context.Context, profile, region string, input ObjectPregisnRequest) (ObjectPregisnResponse, error)
+	GetObjectPregisnWithAKSKWithContext(ctx context.Context, cloud Cloud, ak, sk, region string, input ObjectPregisnRequest) (ObjectPregisnResponse, error)
+
+	// Pager 按游标逐页查询，请求时传入 ctx，初始的 NextMarker 可以从之前保存的游标继续
+	InstancePager(profile, region string, input InstanceFilter) *Pager[Instance]
+	RecordPager(profile, region string, req DescribeRecordListWithPageRequest) *Pager[Record]
+	PrivateRecordPager(profile string, req DescribePrivateDnsRecordListWithPageRequest) *Pager[Record]
+	EmrClusterPager(filter EmrFilter) *Pager[EmrCluster]
+
+	// FanOut 并发查询多个账号、地域，结果带上来源，单个范围失败记录在 Errors 中
+	DescribeInstancesFanOut(ctx context.Context, req FanOutRequest, input InstanceFilter) FanOutResult[Instance]
+	QueryVPCsFanOut(ctx context.Context, req FanOutRequest, input CommonFilter) FanOutResult[VPC]
+	QuerySubnetsFanOut(ctx context.Context, req FanOutRequest, input CommonFilter) FanOutResult[Subnet]
+	QueryEIPsFanOut(ctx context.Context, req FanOutRequest, input CommonFilter) FanOutResult[EIP]
+	QueryNATsFanOut(ctx context.Context, req FanOutRequest, input CommonFilter) FanOutResult[NAT]
+	ListBucketsFanOut(ctx context.Context, req FanOutRequest, input ListBucketRequest) FanOutResult[Bucket]
+	QueryEmrClustersFanOut(ctx context.Context, req FanOutRequest, filter EmrFilter) FanOutResult[EmrCluster]
+	// ImportKeyPairFanOut 把公钥导入到多个账号，AWS 每个地域一次，腾讯云每个账号一次
+	ImportKeyPairFanOut(ctx context.Context, req FanOutRequest, input ImportKeyPairRequest) FanOutResult[KeyPair]
+
+	// Capabilities 返回云支持的操作，未注册的云返回 ErrCloudNotSupported
+	Capabilities(cloud Cloud) (Capabilities, error)
 }
@@ -0,0 +1,151 @@
+package model
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// 游标分页约定：第一页不传 NextMarker，之后传入上一页返回的 NextMarker，返回的 NextMarker 为 nil 时没有下一页。
+// 游标对调用方不透明，只能原样传回，不同云、不同接口的游标不能混用。
+
+// EncodeMarker 把云接口的分页参数编码为游标
+func EncodeMarker(v interface{}) *string {
+	data, _ := json.Marshal(v)
+	marker := base64.RawURLEncoding.EncodeToString(data)
+	return &marker
+}
+
+// DecodeMarker marker 为空时不修改 v
+func DecodeMarker(marker *string, v interface{}) error {
+	if marker == nil || *marker == "" {
+		return nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(*marker)
+	if err == nil {
+		err = json.Unmarshal(data, v)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: invalid next marker %q", ErrInvalidInput, *marker)
+	}
+	return nil
+}
+
+type offsetMarker struct {
+	Offset int64 `json:"o"`
+}
+
+// NewOffsetMarker 按偏移量分页的接口（腾讯云、阿里云）使用的游标
+func NewOffsetMarker(offset int64) *string {
+	return EncodeMarker(offsetMarker{Offset: offset})
+}
+
+// ParseOffsetMarker marker 为空时为第一页，偏移量为 0
+func ParseOffsetMarker(marker *string) (int64, error) {
+	var m offsetMarker
+	if err := DecodeMarker(marker, &m); err != nil {
+		return 0, err
+	}
+	if m.Offset < 0 {
+		return 0, fmt.Errorf("%w: invalid next marker %q", ErrInvalidInput, *marker)
+	}
+	return m.Offset, nil
+}
+
+// NextOffsetMarker 还有数据时返回下一页的游标，count 为当页从接口返回的条数（过滤之前）
+func NextOffsetMarker(offset int64, count int, total int64) *string {
+	if count == 0 || offset+int64(count) >= total {
+		return nil
+	}
+	return NewOffsetMarker(offset + int64(count))
+}
+
+// PageFunc 查询 marker 对应的一页，返回当页数据和下一页的游标
+type PageFunc[T any] func(ctx context.Context, marker *string) ([]T, *string, error)
+
+// Pager 逐页查询，内存中只保留当前页，用法：
+//
+//	for pager.Next(ctx) {
+//		item := pager.Item()
+//	}
+//	if err := pager.Err(); err != nil {}
+type Pager[T any] struct {
+	fetch   PageFunc[T]
+	marker  *string
+	started bool
+	page    []T
+	index   int
+	err     error
+}
+
+// NewPager marker 为空时从第一页开始，传入之前保存的 Marker 可以继续查询
+func NewPager[T any](marker *string, fetch PageFunc[T]) *Pager[T] {
+	return &Pager[T]{fetch: fetch, marker: marker, index: -1}
+}
+
+// More 是否还有没有查询的页
+func (p *Pager[T]) More() bool {
+	return p.err == nil && (!p.started || p.marker != nil)
+}
+
+// NextPage 查询下一页，当前页中没有遍历的元素会被跳过
+func (p *Pager[T]) NextPage(ctx context.Context) ([]T, error) {
+	if !p.More() {
+		return nil, p.err
+	}
+	items, next, err := p.fetch(ctx, p.marker)
+	if err != nil {
+		p.err = err
+		return nil, err
+	}
+	if next != nil && p.marker != nil && *next == *p.marker {
+		p.err = fmt.Errorf("pager: next marker %q is not advancing", *next)
+		return nil, p.err
+	}
+	p.started = true
+	p.marker = next
+	p.page = items
+	p.index = len(items) - 1
+	return items, nil
+}
+
+// Next 移动到下一个元素，需要时查询下一页，没有更多元素或者出错时返回 false
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	for p.index+1 >= len(p.page) {
+		if !p.More() {
+			return false
+		}
+		if _, err := p.NextPage(ctx); err != nil {
+			return false
+		}
+		p.index = -1
+	}
+	p.index++
+	return true
+}
+
+// Item 当前元素，只在 Next 返回 true 之后有效
+func (p *Pager[T]) Item() T {
+	return p.page[p.index]
+}
+
+// Err 查询过程中的错误
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Marker 下一页的游标，可以保存下来之后用 NewPager 继续查询
+func (p *Pager[T]) Marker() *string {
+	return p.marker
+}
+
+// ForEach 遍历全部元素，fn 返回错误时停止
+func (p *Pager[T]) ForEach(ctx context.Context, fn func(item T) error) error {
+	for p.Next(ctx) {
+		if err := fn(p.Item()); err != nil {
+			return err
+		}
+	}
+	return p.Err()
+}
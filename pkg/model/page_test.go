@@ -0,0 +1,101 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// offsetPages 模拟按偏移量分页的接口，每页 size 个
+func offsetPages(items []int, size int, calls *int) model.PageFunc[int] {
+	return func(ctx context.Context, marker *string) ([]int, *string, error) {
+		*calls++
+		offset, err := model.ParseOffsetMarker(marker)
+		if err != nil {
+			return nil, nil, err
+		}
+		end := min(int(offset)+size, len(items))
+		page := items[offset:end]
+		return page, model.NextOffsetMarker(offset, len(page), int64(len(items))), nil
+	}
+}
+
+func TestPager(t *testing.T) {
+	var calls int
+	pager := model.NewPager(nil, offsetPages([]int{1, 2, 3, 4, 5}, 2, &calls))
+	var got []int
+	for pager.Next(context.Background()) {
+		got = append(got, pager.Item())
+		// 按需查询，遍历完第一个元素时只查询了一页
+		if len(got) == 1 {
+			assert.Equal(t, 1, calls)
+		}
+	}
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, got)
+	assert.Equal(t, 3, calls)
+	assert.False(t, pager.More())
+	assert.Nil(t, pager.Marker())
+
+	// 保存游标后继续查询
+	calls = 0
+	pager = model.NewPager(nil, offsetPages([]int{1, 2, 3, 4, 5}, 2, &calls))
+	page, err := pager.NextPage(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2}, page)
+	resumed := model.NewPager(pager.Marker(), offsetPages([]int{1, 2, 3, 4, 5}, 2, &calls))
+	got = nil
+	assert.Nil(t, resumed.ForEach(context.Background(), func(item int) error {
+		got = append(got, item)
+		return nil
+	}))
+	assert.Equal(t, []int{3, 4, 5}, got)
+}
+
+func TestPagerErrors(t *testing.T) {
+	var calls int
+	pager := model.NewPager(tea.String("not-a-marker"), offsetPages([]int{1}, 1, &calls))
+	assert.False(t, pager.Next(context.Background()))
+	assert.True(t, errors.Is(pager.Err(), model.ErrInvalidInput))
+
+	// 游标没有前进时停止，避免死循环
+	stuck := model.NewPager(nil, func(ctx context.Context, marker *string) ([]int, *string, error) {
+		return []int{1}, model.NewOffsetMarker(1), nil
+	})
+	var count int
+	for stuck.Next(context.Background()) {
+		count++
+	}
+	assert.Equal(t, 1, count)
+	assert.NotNil(t, stuck.Err())
+
+	// 空页不会结束遍历
+	empty := model.NewPager(nil, func(ctx context.Context, marker *string) ([]int, *string, error) {
+		if marker == nil {
+			return nil, model.NewOffsetMarker(10), nil
+		}
+		return []int{7}, nil, nil
+	})
+	assert.True(t, empty.Next(context.Background()))
+	assert.Equal(t, 7, empty.Item())
+	assert.False(t, empty.Next(context.Background()))
+}
+
+func TestOffsetMarker(t *testing.T) {
+	offset, err := model.ParseOffsetMarker(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), offset)
+	offset, err = model.ParseOffsetMarker(model.NewOffsetMarker(200))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(200), offset)
+
+	assert.Nil(t, model.NextOffsetMarker(0, 3, 3))
+	assert.Nil(t, model.NextOffsetMarker(100, 0, 500))
+	next, _ := model.ParseOffsetMarker(model.NextOffsetMarker(100, 100, 250))
+	assert.Equal(t, int64(200), next)
+}
@@ -0,0 +1,118 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+type AvailabilityState string
+
+const (
+	AvailabilityAvailable   AvailabilityState = "available"
+	AvailabilityUnavailable AvailabilityState = "unavailable" // AWS 未开通的地域也是 unavailable
+	AvailabilityImpaired    AvailabilityState = "impaired"    // 只有 AWS 的可用区有
+)
+
+const (
+	SiteChina         = "china"         // 中国内地
+	SiteInternational = "international" // 港澳台和海外
+)
+
+type Region struct {
+	ID        string            `json:"id"`   // 接口中使用的地域，比如 ap-shanghai、cn-northwest-1
+	Name      string            `json:"name"` // 展示名称，比如 华东地区(上海)、China (Ningxia)
+	State     AvailabilityState `json:"state"`
+	Partition string            `json:"partition"` // AWS 为 aws、aws-cn、aws-us-gov，腾讯云、阿里云为 china 或 international
+	Meta      any               `json:"meta"`
+}
+
+type Zone struct {
+	ID     string            `json:"id"`   // 接口中使用的可用区，比如 ap-shanghai-2、cn-northwest-1a
+	Name   string            `json:"name"` // 展示名称，比如 上海二区
+	Region string            `json:"region"`
+	State  AvailabilityState `json:"state"`
+	Meta   any               `json:"meta"`
+}
+
+// NewRegionFromAws 展示名称和 partition 取自 SDK 内置的 endpoints
+func NewRegionFromAws(region *ec2.Region) Region {
+	id := tea.StringValue(region.RegionName)
+	r := Region{ID: id, Name: id, State: AvailabilityAvailable, Meta: region}
+	if tea.StringValue(region.OptInStatus) == "not-opted-in" {
+		r.State = AvailabilityUnavailable
+	}
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), id); ok {
+		r.Partition = partition.ID()
+		if known, ok := partition.Regions()[id]; ok && known.Description() != "" {
+			r.Name = known.Description()
+		}
+	}
+	return r
+}
+
+func NewZoneFromAws(zone *ec2.AvailabilityZone) Zone {
+	z := Zone{
+		ID:     tea.StringValue(zone.ZoneName),
+		Name:   tea.StringValue(zone.ZoneName),
+		Region: tea.StringValue(zone.RegionName),
+		State:  AvailabilityState(tea.StringValue(zone.State)),
+		Meta:   zone,
+	}
+	switch z.State {
+	case AvailabilityAvailable, AvailabilityImpaired, AvailabilityUnavailable:
+	default:
+		// information 等状态按可用处理
+		z.State = AvailabilityAvailable
+	}
+	if tea.StringValue(zone.OptInStatus) == "not-opted-in" {
+		z.State = AvailabilityUnavailable
+	}
+	return z
+}
+
+// 腾讯云中国内地的地域，金融专区以城市加 -fsi 结尾
+var tencentChinaCities = map[string]bool{
+	"beijing": true, "shanghai": true, "guangzhou": true, "shenzhen": true, "chengdu": true,
+	"chongqing": true, "nanjing": true, "tianjin": true, "wuhan": true, "changsha": true,
+	"hangzhou": true, "jinan": true, "hefei": true, "fuzhou": true, "shijiazhuang": true,
+	"xian": true, "zhengzhou": true, "qingdao": true, "shenyang": true, "guiyang": true,
+}
+
+func tencentSite(region string) string {
+	city := strings.TrimSuffix(strings.TrimPrefix(region, "ap-"), "-fsi")
+	if strings.HasPrefix(region, "ap-") && tencentChinaCities[city] {
+		return SiteChina
+	}
+	return SiteInternational
+}
+
+func tencentAvailability(state *string) AvailabilityState {
+	if strings.EqualFold(tea.StringValue(state), "AVAILABLE") {
+		return AvailabilityAvailable
+	}
+	return AvailabilityUnavailable
+}
+
+func NewRegionFromTencent(region *cvm.RegionInfo) Region {
+	return Region{
+		ID:        tea.StringValue(region.Region),
+		Name:      tea.StringValue(region.RegionName),
+		State:     tencentAvailability(region.RegionState),
+		Partition: tencentSite(tea.StringValue(region.Region)),
+		Meta:      region,
+	}
+}
+
+func NewZoneFromTencent(region string, zone *cvm.ZoneInfo) Zone {
+	return Zone{
+		ID:     tea.StringValue(zone.Zone),
+		Name:   tea.StringValue(zone.ZoneName),
+		Region: region,
+		State:  tencentAvailability(zone.ZoneState),
+		Meta:   zone,
+	}
+}
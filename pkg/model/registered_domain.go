@@ -0,0 +1,149 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+)
+
+// RegisteredDomain 注册的域名，和解析的 Domain 不同，包含到期时间、续费和转移锁等注册信息
+type RegisteredDomain struct {
+	Name           *string        `json:"name"`
+	CreationDate   *time.Time     `json:"creation_date"`
+	ExpirationDate *time.Time     `json:"expiration_date"`
+	AutoRenew      *bool          `json:"auto_renew"`
+	TransferLock   *bool          `json:"transfer_lock"` // 禁止转移
+	Status         []string       `json:"status"`
+	Nameservers    []string       `json:"nameservers"` // 列表接口不返回，需要 Detail
+	Registrant     *DomainContact `json:"registrant"`  // 列表接口不返回，需要 Detail
+	Meta           interface{}    `json:"meta"`
+}
+
+type DomainContact struct {
+	Name         *string `json:"name"`
+	Organization *string `json:"organization"`
+	Email        *string `json:"email"`
+	Phone        *string `json:"phone"`
+	CountryCode  *string `json:"country_code"`
+}
+
+type DescribeRegisteredDomainsRequest struct {
+	Detail bool `json:"detail"` // 同时查询 NS 和注册人，每个域名多一次请求
+}
+
+// DaysLeft 距离到期的天数，已经过期为负数，没有到期时间返回 0
+func (d RegisteredDomain) DaysLeft(now time.Time) int {
+	if d.ExpirationDate == nil {
+		return 0
+	}
+	return int(d.ExpirationDate.Sub(now).Hours() / 24)
+}
+
+// NewRegisteredDomainFromAwsSummary ListDomains 只返回到期时间、自动续费和转移锁
+func NewRegisteredDomainFromAwsSummary(summary *route53domains.DomainSummary) RegisteredDomain {
+	return RegisteredDomain{
+		Name:           summary.DomainName,
+		ExpirationDate: summary.Expiry,
+		AutoRenew:      summary.AutoRenew,
+		TransferLock:   summary.TransferLock,
+		Meta:           summary,
+	}
+}
+
+// NewRegisteredDomainFromAwsDetail 转移锁对应状态 clientTransferProhibited
+func NewRegisteredDomainFromAwsDetail(detail *route53domains.GetDomainDetailOutput) RegisteredDomain {
+	domain := RegisteredDomain{
+		Name:           detail.DomainName,
+		CreationDate:   detail.CreationDate,
+		ExpirationDate: detail.ExpirationDate,
+		AutoRenew:      detail.AutoRenew,
+		Status:         aws.StringValueSlice(detail.StatusList),
+		Meta:           detail,
+	}
+	locked := false
+	for _, status := range domain.Status {
+		if status == "clientTransferProhibited" {
+			locked = true
+		}
+	}
+	domain.TransferLock = aws.Bool(locked)
+	for _, nameserver := range detail.Nameservers {
+		domain.Nameservers = append(domain.Nameservers, aws.StringValue(nameserver.Name))
+	}
+	if contact := detail.RegistrantContact; contact != nil {
+		name := strings.TrimSpace(aws.StringValue(contact.FirstName) + " " + aws.StringValue(contact.LastName))
+		domain.Registrant = &DomainContact{
+			Name:         tea.String(name),
+			Organization: contact.OrganizationName,
+			Email:        contact.Email,
+			Phone:        contact.PhoneNumber,
+			CountryCode:  contact.CountryCode,
+		}
+	}
+	return domain
+}
+
+// DomainExpiryReportRequest Profiles 为空时检查全部配置
+type DomainExpiryReportRequest struct {
+	Profiles []string      `json:"profiles"`
+	Within   time.Duration `json:"within"` // 只返回这段时间内到期（包括已经过期）的域名，默认 60 天，小于 0 时返回全部
+}
+
+type DomainExpiryReport struct {
+	GeneratedAt time.Time            `json:"generated_at"`
+	Items       []DomainExpiryItem   `json:"items"` // 按到期时间排序
+	Failed      []DomainExpiryFailed `json:"failed"`
+}
+
+type DomainExpiryItem struct {
+	Profile  string           `json:"profile"`
+	Cloud    Cloud            `json:"cloud"`
+	Domain   RegisteredDomain `json:"domain"`
+	DaysLeft int              `json:"days_left"`
+	Expired  bool             `json:"expired"`
+}
+
+type DomainExpiryFailed struct {
+	Profile string `json:"profile"`
+	Error   string `json:"error"`
+}
+
+// SortItems 按到期时间排序，没有到期时间的排在最后
+func (r *DomainExpiryReport) SortItems() {
+	sort.SliceStable(r.Items, func(i, j int) bool {
+		a, b := r.Items[i].Domain.ExpirationDate, r.Items[j].Domain.ExpirationDate
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Before(*b)
+	})
+}
+
+// String 以表格展示，没有开启自动续费的域名标记 !
+func (r DomainExpiryReport) String() string {
+	var b strings.Builder
+	for _, item := range r.Items {
+		mark := " "
+		if !tea.BoolValue(item.Domain.AutoRenew) {
+			mark = "!"
+		}
+		expiration := "-"
+		if item.Domain.ExpirationDate != nil {
+			expiration = item.Domain.ExpirationDate.Format("2006-01-02")
+		}
+		state := fmt.Sprintf("%d days left", item.DaysLeft)
+		if item.Expired {
+			state = "expired"
+		}
+		fmt.Fprintf(&b, "%s %-30s %-10s %-16s %s %s\n", mark, tea.StringValue(item.Domain.Name), item.Cloud, item.Profile, expiration, state)
+	}
+	for _, failed := range r.Failed {
+		fmt.Fprintf(&b, "? %s: %s\n", failed.Profile, failed.Error)
+	}
+	return b.String()
+}
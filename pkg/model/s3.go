@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/spf13/cast"
 	cos "github.com/tencentyun/cos-go-sdk-v5"
@@ -251,6 +252,73 @@ func (c *CreateBucketLifecycleRequest) ToAWSS3Lifecycle() (*s3.PutBucketLifecycl
 	return input, nil
 }
 
+// to aliyun oss lifecycle
+func (c *CreateBucketLifecycleRequest) ToAliyunOssLifecycle() ([]oss.LifecycleRule, error) {
+	rules := make([]oss.LifecycleRule, len(c.Lifecycles))
+	for i, lifecycle := range c.Lifecycles {
+		if lifecycle.ID == nil {
+			return nil, fmt.Errorf("id is required")
+		}
+		rule := oss.LifecycleRule{
+			ID:     *lifecycle.ID,
+			Status: "Enabled",
+		}
+		if lifecycle.Filter != nil && lifecycle.Filter.Prefix != nil {
+			rule.Prefix = *lifecycle.Filter.Prefix
+		}
+		if lifecycle.Expiration != nil {
+			ex := &oss.LifecycleExpiration{
+				Days:                      cast.ToInt(lifecycle.Expiration.Days),
+				ExpiredObjectDeleteMarker: lifecycle.Expiration.ExpiredObjectDeleteMarker,
+			}
+			if lifecycle.Expiration.Date != nil {
+				ex.Date = *lifecycle.Expiration.Date
+			}
+			rule.Expiration = ex
+		}
+		if lifecycle.Transition != nil {
+			if lifecycle.Transition.Days == nil || lifecycle.Transition.StorageClass == nil {
+				return nil, fmt.Errorf("transition days and StorageClass is required")
+			}
+			rule.Transitions = []oss.LifecycleTransition{
+				{
+					Days:         *lifecycle.Transition.Days,
+					StorageClass: oss.StorageClassType(*lifecycle.Transition.StorageClass),
+				},
+			}
+		}
+		if lifecycle.AbortIncompleteMultipartUpload != nil {
+			rule.AbortMultipartUpload = &oss.LifecycleAbortMultipartUpload{
+				Days: cast.ToInt(lifecycle.AbortIncompleteMultipartUpload.DaysAfterInitiation),
+			}
+		}
+		if lifecycle.NoncurrentVersionExpiration != nil {
+			if lifecycle.NoncurrentVersionExpiration.Days == nil {
+				return nil, fmt.Errorf("NoncurrentVersionExpiration days is required")
+			}
+			rule.NonVersionExpiration = &oss.LifecycleVersionExpiration{
+				NoncurrentDays: *lifecycle.NoncurrentVersionExpiration.Days,
+			}
+		}
+		if lifecycle.NoncurrentVersionTransition != nil {
+			if lifecycle.NoncurrentVersionTransition.Days == nil {
+				return nil, fmt.Errorf("NoncurrentVersionTransition days is required")
+			}
+			if lifecycle.NoncurrentVersionTransition.StorageClass == nil {
+				return nil, fmt.Errorf("StorageClass is required")
+			}
+			rule.NonVersionTransitions = []oss.LifecycleVersionTransition{
+				{
+					NoncurrentDays: *lifecycle.NoncurrentVersionTransition.Days,
+					StorageClass:   oss.StorageClassType(*lifecycle.NoncurrentVersionTransition.StorageClass),
+				},
+			}
+		}
+		rules[i] = rule
+	}
+	return rules, nil
+}
+
 type GetBucketLifecycleRequest struct {
 	Bucket *string
 }
@@ -3,6 +3,7 @@ package model
 import (
 	"strings"
 
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/emr"
@@ -88,6 +89,29 @@ func (t Tags) ToTencentCosTags() []cos.BucketTaggingTag {
 	return tags
 }
 
+// 阿里云 OSS 存储桶标签
+func NewTagsFromAliyunOssTags(tags []oss.Tag) Tags {
+	var modelTags Tags
+	for _, tag := range tags {
+		modelTags = append(modelTags, Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return modelTags
+}
+
+func (t Tags) ToAliyunOssTags() []oss.Tag {
+	var ossTags []oss.Tag
+	for _, tag := range t {
+		ossTags = append(ossTags, oss.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return ossTags
+}
+
 // to string
 func (t Tags) ToString() string {
 	var tags string
@@ -153,6 +177,17 @@ func AwsTagsToModelTags(tags []*ec2.Tag) *Tags {
 	return &modelTags
 }
 
+func (t Tags) ToAwsEc2Tags() []*ec2.Tag {
+	var awsTags []*ec2.Tag
+	for _, tag := range t {
+		awsTags = append(awsTags, &ec2.Tag{
+			Key:   aws.String(tag.Key),
+			Value: aws.String(tag.Value),
+		})
+	}
+	return awsTags
+}
+
 func (t *Tags) ToTencentTags() []*tencentTag.Tag {
 	var tencentTags []*tencentTag.Tag
 	for _, tag := range *t {
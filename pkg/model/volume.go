@@ -0,0 +1,184 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+type VolumeState string
+
+const (
+	VolumeStateAvailable VolumeState = "available" // 未挂载
+	VolumeStateInUse     VolumeState = "in-use"
+	VolumeStatePending   VolumeState = "pending" // 创建、挂载、卸载、扩容中
+	VolumeStateDeleting  VolumeState = "deleting"
+	VolumeStateError     VolumeState = "error"
+)
+
+type VolumeAttachment struct {
+	InstanceId         *string `json:"instance_id"`
+	Device             *string `json:"device"` // 只有 AWS 返回，比如 /dev/sdf
+	DeleteWithInstance bool    `json:"delete_with_instance"`
+}
+
+// Volume AWS 为 EBS 卷，腾讯云为 CBS 云硬盘
+type Volume struct {
+	VolumeId    *string            `json:"volume_id"`
+	Name        *string            `json:"name"` // AWS 为 Name 标签
+	Zone        *string            `json:"zone"`
+	SizeGiB     int64              `json:"size_gib"`
+	Type        *string            `json:"type"`       // AWS 为 gp3、io2 等，腾讯云为 CLOUD_PREMIUM、CLOUD_SSD 等，见 TencenteDiskType
+	Iops        int64              `json:"iops"`       // 只有 AWS 返回
+	Throughput  int64              `json:"throughput"` // MiB/s，AWS 为 gp3 的吞吐，腾讯云为额外购买的吞吐性能
+	Encrypted   bool               `json:"encrypted"`
+	SystemDisk  bool               `json:"system_disk"` // 只有腾讯云返回
+	State       VolumeState        `json:"state"`
+	Attachments []VolumeAttachment `json:"attachments"`
+	CreatedTime *time.Time         `json:"created_time"`
+	Tags        *Tags              `json:"tags"`
+	Meta        any                `json:"meta"`
+}
+
+// DescribeVolumesRequest 过滤条件之间为且的关系
+type DescribeVolumesRequest struct {
+	VolumeIds  []string `json:"volume_ids"`
+	InstanceId *string  `json:"instance_id"` // 挂载在该实例上的卷，包括系统盘
+	Zone       *string  `json:"zone"`
+}
+
+type CreateVolumeRequest struct {
+	Zone       *string `json:"zone" binding:"required"`
+	SizeGiB    int64   `json:"size_gib" binding:"required"`
+	Type       *string `json:"type"`        // 为空时 AWS 为 gp3，腾讯云为 CLOUD_PREMIUM
+	Iops       *int64  `json:"iops"`        // 只有 AWS 的 gp3、io1、io2 支持
+	Throughput *int64  `json:"throughput"`  // MiB/s，AWS 只有 gp3 支持，腾讯云只有 CLOUD_HSSD、CLOUD_TSSD 支持
+	Encrypted  bool    `json:"encrypted"`   // 为 true 时使用默认的 KMS 密钥加密
+	KmsKeyId   *string `json:"kms_key_id"`  // 可选，自定义的 KMS 密钥
+	SnapshotId *string `json:"snapshot_id"` // 从快照创建
+	Name       *string `json:"name"`
+	Tags       Tags    `json:"tags"`
+}
+
+func (r CreateVolumeRequest) Validate() error {
+	if tea.StringValue(r.Zone) == "" {
+		return fmt.Errorf("%w: zone is required", ErrInvalidInput)
+	}
+	if r.SizeGiB <= 0 && r.SnapshotId == nil {
+		return fmt.Errorf("%w: size_gib or snapshot_id is required", ErrInvalidInput)
+	}
+	return nil
+}
+
+type CreateVolumeResponse struct {
+	VolumeId *string `json:"volume_id"`
+	Meta     any     `json:"meta"`
+}
+
+// ResizeVolumeRequest 只能扩容，扩容后还需要在系统内扩展分区和文件系统
+type ResizeVolumeRequest struct {
+	VolumeId *string `json:"volume_id" binding:"required"`
+	SizeGiB  int64   `json:"size_gib" binding:"required"`
+}
+
+func (r ResizeVolumeRequest) Validate() error {
+	if tea.StringValue(r.VolumeId) == "" || r.SizeGiB <= 0 {
+		return fmt.Errorf("%w: volume_id and size_gib are required", ErrInvalidInput)
+	}
+	return nil
+}
+
+// AttachVolumeRequest 卷和实例需要在同一个可用区
+type AttachVolumeRequest struct {
+	VolumeId           *string `json:"volume_id" binding:"required"`
+	InstanceId         *string `json:"instance_id" binding:"required"`
+	Device             *string `json:"device"`               // 只有 AWS 使用，为空时使用 /dev/sdf 到 /dev/sdp 中第一个空闲的设备名
+	DeleteWithInstance bool    `json:"delete_with_instance"` // 只有腾讯云支持
+}
+
+func (r AttachVolumeRequest) Validate() error {
+	if tea.StringValue(r.VolumeId) == "" || tea.StringValue(r.InstanceId) == "" {
+		return fmt.Errorf("%w: volume_id and instance_id are required", ErrInvalidInput)
+	}
+	return nil
+}
+
+type DetachVolumeRequest struct {
+	VolumeId   *string `json:"volume_id" binding:"required"`
+	InstanceId *string `json:"instance_id"` // 可选，指定时校验卷挂载在该实例上
+	Force      bool    `json:"force"`       // 只有 AWS 支持，实例无响应时强制卸载，可能丢失数据
+}
+
+type DeleteVolumeRequest struct {
+	VolumeId *string `json:"volume_id" binding:"required"`
+}
+
+func NewVolumeFromAws(volume *ec2.Volume) Volume {
+	m := Volume{
+		VolumeId:    volume.VolumeId,
+		Zone:        volume.AvailabilityZone,
+		SizeGiB:     tea.Int64Value(volume.Size),
+		Type:        volume.VolumeType,
+		Iops:        tea.Int64Value(volume.Iops),
+		Throughput:  tea.Int64Value(volume.Throughput),
+		Encrypted:   tea.BoolValue(volume.Encrypted),
+		CreatedTime: volume.CreateTime,
+		Tags:        AwsTagsToModelTags(volume.Tags),
+		Meta:        volume,
+	}
+	m.Name = m.Tags.GetName()
+	switch state := tea.StringValue(volume.State); state {
+	case ec2.VolumeStateAvailable:
+		m.State = VolumeStateAvailable
+	case ec2.VolumeStateInUse:
+		m.State = VolumeStateInUse
+	case ec2.VolumeStateCreating:
+		m.State = VolumeStatePending
+	case ec2.VolumeStateDeleting, ec2.VolumeStateDeleted:
+		m.State = VolumeStateDeleting
+	default:
+		m.State = VolumeStateError
+	}
+	for _, attachment := range volume.Attachments {
+		m.Attachments = append(m.Attachments, VolumeAttachment{
+			InstanceId:         attachment.InstanceId,
+			Device:             attachment.Device,
+			DeleteWithInstance: tea.BoolValue(attachment.DeleteOnTermination),
+		})
+		if tea.StringValue(attachment.State) != ec2.VolumeAttachmentStateAttached {
+			m.State = VolumeStatePending
+		}
+	}
+	return m
+}
+
+func (r CreateVolumeRequest) ToAwsCreateVolumeInput() *ec2.CreateVolumeInput {
+	input := &ec2.CreateVolumeInput{
+		AvailabilityZone: r.Zone,
+		VolumeType:       tea.String(ec2.VolumeTypeGp3),
+		Iops:             r.Iops,
+		Throughput:       r.Throughput,
+		KmsKeyId:         r.KmsKeyId,
+		SnapshotId:       r.SnapshotId,
+	}
+	if r.Type != nil {
+		input.VolumeType = r.Type
+	}
+	if r.SizeGiB > 0 {
+		input.Size = tea.Int64(r.SizeGiB)
+	}
+	if r.Encrypted || r.KmsKeyId != nil {
+		input.Encrypted = tea.Bool(true)
+	}
+	// 名称在 AWS 上就是 Name 标签
+	tags := append(Tags{}, r.Tags...)
+	if r.Name != nil && tags.GetName() == nil {
+		tags = append(tags, Tag{Key: "Name", Value: *r.Name})
+	}
+	if len(tags) > 0 {
+		input.TagSpecifications = []*ec2.TagSpecification{{ResourceType: tea.String(ec2.ResourceTypeVolume), Tags: tags.ToAwsEc2Tags()}}
+	}
+	return input
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// WaitOptions 轮询间隔从 Interval 开始每次翻倍，最长为 MaxInterval，超时返回 context.DeadlineExceeded
+type WaitOptions struct {
+	Timeout     time.Duration `json:"timeout"`      // 默认 10 分钟
+	Interval    time.Duration `json:"interval"`     // 默认 2 秒
+	MaxInterval time.Duration `json:"max_interval"` // 默认 30 秒
+}
+
+// WithDefaults 返回填充了默认值的选项
+func (o WaitOptions) WithDefaults() WaitOptions {
+	if o.Timeout <= 0 {
+		o.Timeout = 10 * time.Minute
+	}
+	if o.Interval <= 0 {
+		o.Interval = 2 * time.Second
+	}
+	if o.MaxInterval <= 0 {
+		o.MaxInterval = 30 * time.Second
+	}
+	if o.MaxInterval < o.Interval {
+		o.MaxInterval = o.Interval
+	}
+	return o
+}
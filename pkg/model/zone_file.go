@@ -0,0 +1,450 @@
+package model
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+type ExportZoneRequest struct {
+	Domain  *string `json:"domain" binding:"required"` // 域名，不支持 ID
+	Private bool    `json:"private"`                   // 导出私有域
+}
+
+type ImportZoneRequest struct {
+	Domain   *string `json:"domain" binding:"required"`    // 导入的目标域名，也是 zone file 中相对名称的 $ORIGIN
+	Private  bool    `json:"private"`                      // 导入到私有域
+	ZoneFile *string `json:"zone_file" binding:"required"` // zone file 内容
+	DryRun   bool    `json:"dry_run"`                      // 只返回需要创建的记录，不实际创建
+}
+
+type ImportZoneResponse struct {
+	Created []Record           `json:"created"` // 新建的记录，DryRun 时为需要新建的记录
+	Skipped []Record           `json:"skipped"` // SOA、根域名的 NS 以及目标域名已经存在的同名同类型记录
+	Failed  []ImportZoneFailed `json:"failed"`
+}
+
+type ImportZoneFailed struct {
+	Record Record `json:"record"`
+	Error  string `json:"error"`
+}
+
+// ZoneFile RFC 1035 格式的 zone file，Records 中同名同类型的记录合并为一条，多个值放在 Values
+// zone file 没有 aws 的解析策略和别名，也没有腾讯云的线路，这些信息导出时会丢失
+type ZoneFile struct {
+	Origin  string   // 域名，不带结尾的点
+	TTL     uint64   // $TTL，记录没有 TTL 时使用
+	Records []Record // SubDomain 为相对 Origin 的名称，根域名为 @
+}
+
+// zone file 默认的 $TTL
+const defaultZoneTTL = 600
+
+// NewZoneFile records 为 DescribeRecordList 返回的记录，根域名 aws 为空，腾讯云为 @
+func NewZoneFile(origin string, records []Record) ZoneFile {
+	zone := ZoneFile{
+		Origin: strings.TrimSuffix(origin, "."),
+		TTL:    defaultZoneTTL,
+	}
+	var soa *Record
+	var primaryNs string
+	for _, record := range records {
+		record.SubDomain = tea.String(zoneRelativeName(tea.StringValue(record.SubDomain)))
+		switch tea.StringValue(record.RecordType) {
+		case "SOA":
+			if soa == nil {
+				soaRecord := record
+				soa = &soaRecord
+				continue
+			}
+		case "NS":
+			if primaryNs == "" && tea.StringValue(record.SubDomain) == "@" && len(record.GetValues()) > 0 {
+				primaryNs = tea.StringValue(record.GetValues()[0])
+			}
+		}
+		zone.Records = append(zone.Records, record)
+	}
+	// 没有 SOA 记录（比如腾讯云）时补一条，serial 固定为 1，导入时 SOA 由目标云自己管理
+	if soa == nil {
+		if primaryNs == "" {
+			primaryNs = "ns." + zone.Origin + "."
+		}
+		soa = &Record{
+			SubDomain:  tea.String("@"),
+			RecordType: tea.String("SOA"),
+			TTL:        tea.Uint64(zone.TTL),
+			Value:      tea.String(fmt.Sprintf("%s hostmaster.%s. 1 7200 900 1209600 %d", zoneFileValue("NS", primaryNs), zone.Origin, zone.TTL)),
+		}
+	}
+	zone.Records = append([]Record{*soa}, zone.Records...)
+	return zone
+}
+
+// String 每个值一行，别名记录和停用的记录写成注释
+func (z ZoneFile) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", z.Origin)
+	fmt.Fprintf(&b, "$TTL %d\n", z.TTL)
+	for _, record := range z.Records {
+		name := zoneRelativeName(tea.StringValue(record.SubDomain))
+		recordType := tea.StringValue(record.RecordType)
+		if record.AliasTarget != nil {
+			fmt.Fprintf(&b, "; alias %s %s -> %s\n", name, recordType, tea.StringValue(record.AliasTarget.DNSName))
+			continue
+		}
+		prefix := ""
+		if strings.EqualFold(tea.StringValue(record.Status), "DISABLE") {
+			prefix = "; disabled "
+		}
+		ttl := z.TTL
+		if record.TTL != nil {
+			ttl = *record.TTL
+		}
+		for _, value := range record.GetValues() {
+			fmt.Fprintf(&b, "%s%s\t%d\tIN\t%s\t%s\n", prefix, name, ttl, recordType, zoneFileValue(recordType, tea.StringValue(value)))
+		}
+	}
+	return b.String()
+}
+
+// ParseZoneFile 支持 $ORIGIN、$TTL、括号换行、注释、省略名称和 TTL，不支持 $INCLUDE
+// origin 为域名，zone file 中没有 $ORIGIN 时相对名称基于 origin
+func ParseZoneFile(r io.Reader, origin string) (ZoneFile, error) {
+	zone := ZoneFile{
+		Origin: strings.ToLower(strings.TrimSuffix(origin, ".")),
+		TTL:    defaultZoneTTL,
+	}
+	current := zone.Origin
+	var lastName string
+	var lastTTL *uint64
+	index := map[string]int{}
+
+	lines, err := zoneFileLines(r)
+	if err != nil {
+		return ZoneFile{}, err
+	}
+	for _, line := range lines {
+		tokens := line.tokens
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) < 2 {
+				return ZoneFile{}, fmt.Errorf("line %d: $ORIGIN requires a domain", line.number)
+			}
+			current = strings.ToLower(zoneAbsoluteName(tokens[1], current))
+			current = strings.TrimSuffix(current, ".")
+			continue
+		case "$TTL":
+			if len(tokens) < 2 {
+				return ZoneFile{}, fmt.Errorf("line %d: $TTL requires a value", line.number)
+			}
+			ttl, err := parseZoneTTL(tokens[1])
+			if err != nil {
+				return ZoneFile{}, fmt.Errorf("line %d: %w", line.number, err)
+			}
+			zone.TTL = ttl
+			continue
+		case "$INCLUDE":
+			return ZoneFile{}, fmt.Errorf("line %d: $INCLUDE is not supported", line.number)
+		}
+
+		name := lastName
+		if !line.inherit {
+			name = strings.ToLower(zoneAbsoluteName(tokens[0], current))
+			tokens = tokens[1:]
+		}
+		if name == "" {
+			return ZoneFile{}, fmt.Errorf("line %d: missing owner name", line.number)
+		}
+		lastName = name
+
+		var ttl *uint64
+		// 名称后面是可选的 TTL 和 class，顺序不固定
+		for len(tokens) > 0 {
+			t, err := parseZoneTTL(tokens[0])
+			if err == nil && ttl == nil {
+				ttl = &t
+			} else if !isZoneClass(tokens[0]) {
+				break
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) < 2 {
+			return ZoneFile{}, fmt.Errorf("line %d: missing record type or data", line.number)
+		}
+		if ttl == nil {
+			ttl = lastTTL
+		}
+		if ttl == nil {
+			ttl = tea.Uint64(zone.TTL)
+		}
+		lastTTL = ttl
+
+		subDomain, ok := zoneSubDomain(name, zone.Origin)
+		if !ok {
+			return ZoneFile{}, fmt.Errorf("line %d: %s is out of zone %s", line.number, strings.TrimSuffix(name, "."), zone.Origin)
+		}
+		recordType := strings.ToUpper(tokens[0])
+		value := zoneRecordValue(recordType, tokens[1:], current)
+
+		key := subDomain + " " + recordType
+		if i, ok := index[key]; ok {
+			zone.Records[i].Values = append(zone.Records[i].Values, tea.String(value))
+			continue
+		}
+		index[key] = len(zone.Records)
+		zone.Records = append(zone.Records, Record{
+			SubDomain:  tea.String(subDomain),
+			RecordType: tea.String(recordType),
+			TTL:        tea.Uint64(*ttl),
+			Value:      tea.String(value),
+			Values:     []*string{tea.String(value)},
+		})
+	}
+	return zone, nil
+}
+
+// UnquoteTxtValue zone file 和 aws 的 TXT 值带引号，腾讯云、阿里云不带
+func UnquoteTxtValue(value string) string {
+	tokens, err := splitZoneLine(value)
+	if err != nil || len(tokens) == 0 || !strings.HasPrefix(tokens[0], `"`) {
+		return value
+	}
+	// 多段字符串直接拼接
+	var b strings.Builder
+	for _, token := range tokens {
+		token = strings.TrimSuffix(strings.TrimPrefix(token, `"`), `"`)
+		for i := 0; i < len(token); i++ {
+			if token[i] == '\\' && i+1 < len(token) {
+				i++
+			}
+			b.WriteByte(token[i])
+		}
+	}
+	return b.String()
+}
+
+// quoteTxtValue zone file 中字符串只需要转义引号和反斜杠
+func quoteTxtValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return `"` + value + `"`
+}
+
+type zoneFileLine struct {
+	number  int
+	inherit bool // 行首为空白，沿用上一条记录的名称
+	tokens  []string
+}
+
+// zoneFileLines 去掉注释，把括号内的多行合并为一行
+func zoneFileLines(r io.Reader) ([]zoneFileLine, error) {
+	var lines []zoneFileLine
+	var pending *zoneFileLine
+	scanner := bufio.NewScanner(r)
+	number := 0
+	for scanner.Scan() {
+		number++
+		text := scanner.Text()
+		tokens, err := splitZoneLine(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", number, err)
+		}
+		if pending == nil {
+			if len(tokens) == 0 {
+				continue
+			}
+			pending = &zoneFileLine{number: number, inherit: text[0] == ' ' || text[0] == '\t'}
+		}
+		pending.tokens = append(pending.tokens, tokens...)
+		if zoneParenDepth(pending.tokens) > 0 {
+			continue
+		}
+		pending.tokens = removeZoneParens(pending.tokens)
+		if len(pending.tokens) > 0 {
+			lines = append(lines, *pending)
+		}
+		pending = nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", pending.number)
+	}
+	return lines, nil
+}
+
+// splitZoneLine 按空白拆分，引号内的内容作为一个整体并保留引号，; 之后为注释
+func splitZoneLine(text string) ([]string, error) {
+	var tokens []string
+	var b strings.Builder
+	inQuote := false
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case inQuote:
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(text) {
+				i++
+				b.WriteByte(text[i])
+			} else if c == '"' {
+				inQuote = false
+			}
+		case c == '"':
+			inQuote = true
+			b.WriteByte(c)
+		case c == ';':
+			i = len(text)
+		case c == ' ' || c == '\t':
+			if b.Len() > 0 {
+				tokens = append(tokens, b.String())
+				b.Reset()
+			}
+		case c == '(' || c == ')':
+			if b.Len() > 0 {
+				tokens = append(tokens, b.String())
+				b.Reset()
+			}
+			tokens = append(tokens, string(c))
+		default:
+			b.WriteByte(c)
+		}
+	}
+	if inQuote {
+		return nil, fmt.Errorf("unterminated quoted string")
+	}
+	if b.Len() > 0 {
+		tokens = append(tokens, b.String())
+	}
+	return tokens, nil
+}
+
+func zoneParenDepth(tokens []string) int {
+	depth := 0
+	for _, token := range tokens {
+		switch token {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		}
+	}
+	return depth
+}
+
+func removeZoneParens(tokens []string) []string {
+	var result []string
+	for _, token := range tokens {
+		if token != "(" && token != ")" {
+			result = append(result, token)
+		}
+	}
+	return result
+}
+
+// parseZoneTTL 支持纯数字以及 1h30m 这种 BIND 的写法
+func parseZoneTTL(s string) (uint64, error) {
+	if n, err := strconv.ParseUint(s, 10, 32); err == nil {
+		return n, nil
+	}
+	var total, n uint64
+	digits := false
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			n = n*10 + uint64(c-'0')
+			digits = true
+			continue
+		}
+		unit := map[rune]uint64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}[c]
+		if unit == 0 || !digits {
+			return 0, fmt.Errorf("invalid ttl %s", s)
+		}
+		total += n * unit
+		n, digits = 0, false
+	}
+	if digits || total == 0 {
+		return 0, fmt.Errorf("invalid ttl %s", s)
+	}
+	return total, nil
+}
+
+func isZoneClass(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+// zoneRelativeName 根域名统一为 @
+func zoneRelativeName(subDomain string) string {
+	if subDomain == "" {
+		return "@"
+	}
+	return subDomain
+}
+
+// zoneAbsoluteName 返回以 . 结尾的完整域名
+func zoneAbsoluteName(name, origin string) string {
+	origin = strings.TrimSuffix(origin, ".")
+	switch {
+	case name == "@":
+		return origin + "."
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + origin + "."
+	}
+}
+
+// zoneSubDomain 完整域名转为相对 origin 的名称，不在 origin 下时返回 false
+func zoneSubDomain(name, origin string) (string, bool) {
+	name = strings.TrimSuffix(name, ".")
+	if name == origin {
+		return "@", true
+	}
+	if strings.HasSuffix(name, "."+origin) {
+		return strings.TrimSuffix(name, "."+origin), true
+	}
+	return "", false
+}
+
+// 这些类型的最后一个字段是域名，相对名称需要补全
+var zoneNameTypes = map[string]bool{"CNAME": true, "NS": true, "PTR": true, "MX": true, "SRV": true}
+
+// zoneRecordValue 导入时把记录值中的相对域名补全为完整域名
+func zoneRecordValue(recordType string, tokens []string, origin string) string {
+	if zoneNameTypes[recordType] {
+		last := len(tokens) - 1
+		tokens = append([]string{}, tokens...)
+		tokens[last] = zoneAbsoluteName(tokens[last], origin)
+	}
+	return strings.Join(tokens, " ")
+}
+
+// zoneFileValue 导出时域名统一写成以 . 结尾的完整域名，TXT 没有引号时加上引号
+func zoneFileValue(recordType, value string) string {
+	switch {
+	case zoneNameTypes[recordType]:
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			return value
+		}
+		last := len(fields) - 1
+		if !strings.HasSuffix(fields[last], ".") {
+			// aws 和腾讯云返回的都是完整域名，只是可能没有结尾的点
+			fields[last] += "."
+		}
+		return strings.Join(fields, " ")
+	case recordType == "TXT" || recordType == "SPF":
+		if strings.HasPrefix(value, `"`) {
+			return value
+		}
+		return quoteTxtValue(value)
+	}
+	return value
+}
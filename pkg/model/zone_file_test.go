@@ -0,0 +1,90 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. hostmaster.example.com. (
+		2024010101 ; serial
+		7200 900 1209600 86400 )
+	IN	NS	ns1
+www	300	IN	A	203.0.113.10
+	300	IN	A	203.0.113.11
+mail	IN	MX	10 mx1
+	IN	MX	20 mx2.example.net.
+@	TXT	"v=spf1 include:_spf.example.com ~all; comment in quotes"
+api	IN	CNAME	www
+$ORIGIN dev.example.com.
+*	60	CNAME	@
+`
+
+func TestParseZoneFile(t *testing.T) {
+	zone, err := model.ParseZoneFile(strings.NewReader(testZoneFile), "example.com")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3600), zone.TTL)
+	assert.Len(t, zone.Records, 7)
+
+	soa := zone.Records[0]
+	assert.Equal(t, "SOA", tea.StringValue(soa.RecordType))
+	assert.Equal(t, "ns1.example.com. hostmaster.example.com. 2024010101 7200 900 1209600 86400", tea.StringValue(soa.Value))
+
+	ns := zone.Records[1]
+	assert.Equal(t, "@", tea.StringValue(ns.SubDomain))
+	assert.Equal(t, "ns1.example.com.", tea.StringValue(ns.Value))
+	assert.Equal(t, uint64(3600), tea.Uint64Value(ns.TTL))
+
+	www := zone.Records[2]
+	assert.Equal(t, []string{"203.0.113.10", "203.0.113.11"}, tea.StringSliceValue(www.Values))
+	assert.Equal(t, uint64(300), tea.Uint64Value(www.TTL))
+
+	mx := zone.Records[3]
+	assert.Equal(t, []string{"10 mx1.example.com.", "20 mx2.example.net."}, tea.StringSliceValue(mx.Values))
+
+	txt := zone.Records[4]
+	assert.Equal(t, `"v=spf1 include:_spf.example.com ~all; comment in quotes"`, tea.StringValue(txt.Value))
+	assert.Equal(t, "v=spf1 include:_spf.example.com ~all; comment in quotes", model.UnquoteTxtValue(tea.StringValue(txt.Value)))
+
+	assert.Equal(t, "www.example.com.", tea.StringValue(zone.Records[5].Value))
+
+	wildcard := zone.Records[6]
+	assert.Equal(t, "*.dev", tea.StringValue(wildcard.SubDomain))
+	assert.Equal(t, "dev.example.com.", tea.StringValue(wildcard.Value))
+	assert.Equal(t, uint64(60), tea.Uint64Value(wildcard.TTL))
+}
+
+func TestParseZoneFileError(t *testing.T) {
+	_, err := model.ParseZoneFile(strings.NewReader("www.example.org. 300 IN A 203.0.113.1\n"), "example.com")
+	assert.Contains(t, err.Error(), "out of zone")
+
+	_, err = model.ParseZoneFile(strings.NewReader("@ IN SOA ns1 hostmaster ( 1 2 3\n"), "example.com")
+	assert.Contains(t, err.Error(), "unbalanced parentheses")
+}
+
+func TestZoneFileRoundTrip(t *testing.T) {
+	records := []model.Record{
+		{SubDomain: tea.String(""), RecordType: tea.String("NS"), TTL: tea.Uint64(172800), Values: []*string{tea.String("ns-1.awsdns-01.org")}},
+		{SubDomain: tea.String("www"), RecordType: tea.String("A"), TTL: tea.Uint64(300), Values: []*string{tea.String("203.0.113.10"), tea.String("203.0.113.11")}},
+		{SubDomain: tea.String("@"), RecordType: tea.String("TXT"), TTL: tea.Uint64(600), Value: tea.String(`say "hi"`)},
+		{SubDomain: tea.String("old"), RecordType: tea.String("A"), TTL: tea.Uint64(600), Value: tea.String("203.0.113.99"), Status: tea.String("DISABLE")},
+		{SubDomain: tea.String(""), RecordType: tea.String("A"), AliasTarget: &model.AliasTarget{DNSName: tea.String("lb.example.net.")}},
+	}
+	content := model.NewZoneFile("example.com", records).String()
+	assert.Contains(t, content, "@\t600\tIN\tSOA\tns-1.awsdns-01.org. hostmaster.example.com. 1 7200 900 1209600 600\n")
+	assert.Contains(t, content, "; disabled old\t600\tIN\tA\t203.0.113.99\n")
+	assert.Contains(t, content, "; alias @ A -> lb.example.net.\n")
+
+	zone, err := model.ParseZoneFile(strings.NewReader(content), "example.com")
+	assert.Nil(t, err)
+	assert.Len(t, zone.Records, 4)
+	assert.Equal(t, "ns-1.awsdns-01.org.", tea.StringValue(zone.Records[1].Value))
+	assert.Equal(t, []string{"203.0.113.10", "203.0.113.11"}, tea.StringSliceValue(zone.Records[2].Values))
+	assert.Equal(t, `say "hi"`, model.UnquoteTxtValue(tea.StringValue(zone.Records[3].Value)))
+}
@@ -0,0 +1,334 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/io"
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+	"github.com/xops-infra/multi-cloud-sdk/pkg/service"
+)
+
+// aliyunFixture 按接口回放 testdata/aliyun 下录制的响应
+// RPC 接口按 Action 取 json，OSS 接口按操作取 xml
+type aliyunFixture struct {
+	lock     sync.Mutex
+	server   *httptest.Server
+	requests []aliyunFixtureRequest
+}
+
+type aliyunFixtureRequest struct {
+	action string
+	form   url.Values
+}
+
+func newAliyunFixture(t *testing.T) *aliyunFixture {
+	f := &aliyunFixture{}
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		// v3 签名的 Action 放在 header 里
+		action := r.Header.Get("x-acs-action")
+		if action == "" {
+			action = r.Form.Get("Action")
+		}
+		f.lock.Lock()
+		f.requests = append(f.requests, aliyunFixtureRequest{action: action, form: r.Form})
+		f.lock.Unlock()
+
+		// 不存在的域名回放录制的错误响应
+		if r.Form.Get("DomainName") == "notexist.com" {
+			data, _ := os.ReadFile(filepath.Join("testdata", "aliyun", "errors", "InvalidDomainName.NoExist.json"))
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write(data)
+			return
+		}
+		var name string
+		switch {
+		case action != "":
+			name = action + ".json"
+			w.Header().Set("Content-Type", "application/json")
+		case r.Method == http.MethodHead:
+			// IsObjectExist
+			w.WriteHeader(http.StatusOK)
+			return
+		case r.URL.Query().Has("tagging"):
+			name = "GetBucketTagging.xml"
+			w.Header().Set("Content-Type", "application/xml")
+		case r.URL.Path == "/":
+			name = "ListBuckets.xml"
+			w.Header().Set("Content-Type", "application/xml")
+		}
+		data, err := os.ReadFile(filepath.Join("testdata", "aliyun", name))
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"Code":"InvalidAction.NotFound","Message":"fixture not found"}`))
+			return
+		}
+		w.Write(data)
+	}))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+// actions 返回按顺序请求过的 RPC 接口
+func (f *aliyunFixture) actions() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var actions []string
+	for _, req := range f.requests {
+		if req.action != "" {
+			actions = append(actions, req.action)
+		}
+	}
+	return actions
+}
+
+func (f *aliyunFixture) lastRequest(action string) url.Values {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for i := len(f.requests) - 1; i >= 0; i-- {
+		if f.requests[i].action == action {
+			return f.requests[i].form
+		}
+	}
+	return nil
+}
+
+// aliyunFixtureClientIo 只替换阿里云的客户端，所有请求都发到 fixture server
+type aliyunFixtureClientIo struct {
+	model.ClientIo
+	host string
+}
+
+func (c aliyunFixtureClientIo) newOpenapiClient() (*openapi.Client, error) {
+	return openapi.NewClient(&openapi.Config{
+		AccessKeyId:     tea.String("ak"),
+		AccessKeySecret: tea.String("sk"),
+		Endpoint:        tea.String(c.host),
+		Protocol:        tea.String("http"),
+	})
+}
+
+func (c aliyunFixtureClientIo) GetAliyunEcsClient(profile, region string) (*openapi.Client, error) {
+	return c.newOpenapiClient()
+}
+
+func (c aliyunFixtureClientIo) GetAliyunVpcClient(profile, region string) (*openapi.Client, error) {
+	return c.newOpenapiClient()
+}
+
+func (c aliyunFixtureClientIo) GetAliyunDnsClient(profile string) (*openapi.Client, error) {
+	return c.newOpenapiClient()
+}
+
+func (c aliyunFixtureClientIo) GetAliyunOssClient(profile, region string) (*oss.Client, error) {
+	return oss.New("http://"+c.host, "ak", "sk")
+}
+
+func newAliyunFixtureService(t *testing.T) (model.CommonContract, *aliyunFixture) {
+	fixture := newAliyunFixture(t)
+	profiles := []model.ProfileConfig{
+		{
+			Name:  "aliyun",
+			Cloud: model.ALIYUN,
+			AK:    "ak",
+			SK:    "sk",
+		},
+	}
+	clientIo := aliyunFixtureClientIo{
+		ClientIo: io.NewCloudClient(profiles),
+		host:     strings.TrimPrefix(fixture.server.URL, "http://"),
+	}
+	registry := service.NewRegistry()
+	registry.Register(model.ALIYUN, io.NewAliyunClient(clientIo))
+	return service.NewCommonServiceWithRegistry(profiles, registry), fixture
+}
+
+func TestAliyunDescribeInstances(t *testing.T) {
+	s, fixture := newAliyunFixtureService(t)
+	resp, err := s.DescribeInstancesWithContext(context.Background(), "aliyun", "cn-hangzhou", model.InstanceFilter{
+		Owner:  tea.String("zhoushoujian"),
+		Status: model.InstanceStatusRunning.TString(),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, resp.Instances, 1)
+	assert.Nil(t, resp.NextMarker)
+
+	instance := resp.Instances[0]
+	assert.Equal(t, "i-bp67acfmxazb4p****", *instance.InstanceID)
+	assert.Equal(t, model.InstanceStatusRunning, instance.Status)
+	assert.Equal(t, "zhoushoujian", *instance.Owner)
+	assert.Equal(t, []*string{tea.String("47.110.12.34")}, instance.PublicIP)
+	assert.Equal(t, []*string{tea.String("172.16.0.10")}, instance.PrivateIP)
+	assert.Equal(t, "default-key", *instance.KeyIDs[0])
+
+	req := fixture.lastRequest("DescribeInstances")
+	assert.Equal(t, "cn-hangzhou", req.Get("RegionId"))
+	assert.Equal(t, "Running", req.Get("Status"))
+	assert.Equal(t, "Owner", req.Get("Tag.1.Key"))
+	assert.Equal(t, "zhoushoujian", req.Get("Tag.1.Value"))
+}
+
+func TestAliyunChangeInstanceTags(t *testing.T) {
+	s, fixture := newAliyunFixtureService(t)
+	_, err := s.ModifyInstanceWithContext(context.Background(), "aliyun", "cn-hangzhou", model.ModifyInstanceInput{
+		Action:      model.ChangeInstanceTags,
+		InstanceIDs: []*string{tea.String("i-1"), tea.String("i-2")},
+		Tags:        model.Tags{{Key: "Owner", Value: "ops"}},
+	})
+	assert.Nil(t, err)
+	req := fixture.lastRequest("TagResources")
+	assert.Equal(t, "instance", req.Get("ResourceType"))
+	assert.Equal(t, "i-2", req.Get("ResourceId.2"))
+	assert.Equal(t, "Owner", req.Get("Tag.1.Key"))
+	assert.Equal(t, "ops", req.Get("Tag.1.Value"))
+}
+
+// tea 的客户端不支持 context，请求没有返回时 ctx 超时也要立即返回
+func TestAliyunContextDeadline(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+	profiles := []model.ProfileConfig{{Name: "aliyun", Cloud: model.ALIYUN, AK: "ak", SK: "sk"}}
+	registry := service.NewRegistry()
+	registry.Register(model.ALIYUN, io.NewAliyunClient(aliyunFixtureClientIo{
+		ClientIo: io.NewCloudClient(profiles),
+		host:     strings.TrimPrefix(server.URL, "http://"),
+	}))
+	s := service.NewCommonServiceWithRegistry(profiles, registry)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := s.DescribeInstancesWithContext(ctx, "aliyun", "cn-hangzhou", model.InstanceFilter{})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "%v", err)
+	assert.True(t, time.Since(start) < 2*time.Second)
+}
+
+func TestAliyunQueryVPCs(t *testing.T) {
+	s, _ := newAliyunFixtureService(t)
+	vpcs, err := s.QueryVPCsWithContext(context.Background(), "aliyun", "cn-hangzhou", model.CommonFilter{})
+	assert.Nil(t, err)
+	assert.Len(t, vpcs, 1)
+	assert.Equal(t, "vpc-bp15zckdt37pq72zv****", vpcs[0].ID)
+	assert.Equal(t, model.ALIYUN, vpcs[0].CloudProvider)
+	assert.True(t, vpcs[0].IsDefault)
+	assert.Equal(t, "zhoushoujian", *vpcs[0].Tags.GetOwner())
+}
+
+func TestAliyunDescribeRecord(t *testing.T) {
+	s, fixture := newAliyunFixtureService(t)
+	record, err := s.DescribeRecordWithContext(context.Background(), "aliyun", "", model.DescribeRecordRequest{
+		Domain:     tea.String("example.com"),
+		SubDomain:  tea.String("www"),
+		RecordType: tea.String("A"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "9999985", *record.RecordId)
+	assert.Equal(t, "1.1.1.1", *record.Value)
+	assert.Equal(t, "web", *record.Remark)
+
+	req := fixture.lastRequest("DescribeDomainRecords")
+	assert.Equal(t, "example.com", req.Get("DomainName"))
+	assert.Equal(t, "www", req.Get("RRKeyWord"))
+}
+
+func TestAliyunDescribeRecordNotFound(t *testing.T) {
+	s, _ := newAliyunFixtureService(t)
+	// 域名下没有匹配的记录，CommonService 返回空记录
+	record, err := s.DescribeRecordWithContext(context.Background(), "aliyun", "", model.DescribeRecordRequest{
+		Domain:     tea.String("example.com"),
+		SubDomain:  tea.String("www"),
+		RecordType: tea.String("MX"),
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, record.RecordId)
+
+	// 域名不存在返回分类后的错误
+	_, err = s.DescribeRecordListWithContext(context.Background(), "aliyun", "", model.DescribeRecordListRequest{
+		Domain: tea.String("notexist.com"),
+	})
+	assert.True(t, errors.Is(err, model.ErrNotFound))
+	assert.False(t, errors.Is(err, model.ErrRecordNotFound))
+	var cloudErr *model.CloudError
+	assert.True(t, errors.As(err, &cloudErr))
+	assert.Equal(t, model.ALIYUN, cloudErr.Provider)
+	assert.Equal(t, "InvalidDomainName.NoExist", cloudErr.Code)
+	assert.Equal(t, "B7AB4AF8-7B5C-4B4E-9A36-8E4E0E5E2F6A", cloudErr.RequestId)
+	assert.Equal(t, http.StatusBadRequest, cloudErr.HTTPStatus)
+}
+
+func TestAliyunCreateRecord(t *testing.T) {
+	s, fixture := newAliyunFixtureService(t)
+	resp, err := s.CreateRecordWithContext(context.Background(), "aliyun", "", model.CreateRecordRequest{
+		Domain:     tea.String("example.com"),
+		SubDomain:  tea.String("api"),
+		RecordType: tea.String("A"),
+		Value:      tea.String("2.2.2.2"),
+		Info:       tea.String("created by test"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "9999987", *resp.RecordId)
+	assert.Equal(t, []string{"AddDomainRecord", "UpdateDomainRecordRemark"}, fixture.actions())
+
+	req := fixture.lastRequest("AddDomainRecord")
+	assert.Equal(t, "600", req.Get("TTL"))
+	assert.Equal(t, "default", req.Get("Line"))
+	assert.Equal(t, "created by test", fixture.lastRequest("UpdateDomainRecordRemark").Get("Remark"))
+}
+
+func TestAliyunCreateAliasRecord(t *testing.T) {
+	s, fixture := newAliyunFixtureService(t)
+	_, err := s.CreateRecordWithContext(context.Background(), "aliyun", "", model.CreateRecordRequest{
+		Domain:      tea.String("example.com"),
+		SubDomain:   tea.String("@"),
+		RecordType:  tea.String("A"),
+		AliasTarget: &model.AliasTarget{DNSName: tea.String("lb.example.com")},
+	})
+	assert.True(t, errors.Is(err, model.ErrUnsupported))
+	assert.Empty(t, fixture.actions())
+}
+
+func TestAliyunListBuckets(t *testing.T) {
+	s, _ := newAliyunFixtureService(t)
+	resp, err := s.ListBucketsWithContext(context.Background(), "aliyun", "cn-hangzhou", model.ListBucketRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), resp.Total)
+	assert.Equal(t, "app-logs", resp.Buckets[0].Name)
+	assert.Equal(t, "cn-hangzhou", resp.Buckets[0].Location)
+	// 没有 Region 字段时从 Location 中取
+	assert.Equal(t, "cn-shanghai", resp.Buckets[1].Location)
+	assert.Equal(t, "zhoushoujian", *resp.Buckets[0].Tags.GetOwner())
+}
+
+func TestAliyunGetObjectPregisn(t *testing.T) {
+	s, fixture := newAliyunFixtureService(t)
+	resp, err := s.GetObjectPregisnWithContext(context.Background(), "aliyun", "cn-hangzhou", model.ObjectPregisnRequest{
+		Bucket: tea.String("app-logs"),
+		Key:    tea.String("2024/04/01.log"),
+		Expire: tea.Int64(60),
+	})
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(resp.Url, fixture.server.URL+"/app-logs/2024%2F04%2F01.log?"))
+	assert.Contains(t, resp.Url, "Signature=")
+}
@@ -0,0 +1,1199 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/io"
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+	"github.com/xops-infra/multi-cloud-sdk/pkg/service"
+)
+
+// awsFixture 按 Route53 的 REST 路径回放 testdata/aws/route53 下录制的响应
+// 带 hosted zone 的接口按 <操作>_<zoneId>.xml 取文件，取不到再用 <操作>.xml
+// Route53 Domains 为 JSON 协议，按 X-Amz-Target 回放 testdata/aws/route53domains 下的 <操作>.json
+type awsFixture struct {
+	lock     sync.Mutex
+	server   *httptest.Server
+	requests []awsFixtureRequest
+}
+
+type awsFixtureRequest struct {
+	operation string
+	zoneId    string
+	query     map[string][]string
+	body      string
+}
+
+var route53PathPattern = regexp.MustCompile(`^/2013-04-01/hostedzone(?:/([^/]+))?(/rrset/?|/[a-z]+vpc[a-z]*)?$`)
+
+// 私有域关联 VPC 的子路径
+var route53VpcOperations = map[string]string{
+	"/associatevpc":              "AssociateVPCWithHostedZone",
+	"/disassociatevpc":           "DisassociateVPCFromHostedZone",
+	"/authorizevpcassociation":   "CreateVPCAssociationAuthorization",
+	"/deauthorizevpcassociation": "DeleteVPCAssociationAuthorization",
+}
+var route53ChangePathPattern = regexp.MustCompile(`^/2013-04-01/change/([^/]+)$`)
+var route53HealthCheckPathPattern = regexp.MustCompile(`^/2013-04-01/(tags/)?healthcheck(?:/([^/]+))?(/status)?$`)
+
+func newAwsFixture(t *testing.T) *awsFixture {
+	f := &awsFixture{}
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := new(bytes.Buffer)
+		body.ReadFrom(r.Body)
+		var operation, zoneId string
+		if m := route53PathPattern.FindStringSubmatch(r.URL.Path); m != nil {
+			zoneId = m[1]
+			switch {
+			case route53VpcOperations[m[2]] != "":
+				operation = route53VpcOperations[m[2]]
+			case m[2] != "" && r.Method == http.MethodPost:
+				operation = "ChangeResourceRecordSets"
+			case m[2] != "":
+				operation = "ListResourceRecordSets"
+			case zoneId != "" && r.Method == http.MethodDelete:
+				operation = "DeleteHostedZone"
+			case zoneId != "":
+				operation = "GetHostedZone"
+			case r.Method == http.MethodPost:
+				operation = "CreateHostedZone"
+			default:
+				operation = "ListHostedZones"
+			}
+		}
+		if r.URL.Path == "/2013-04-01/geolocations" {
+			operation = "ListGeoLocations"
+		}
+		if r.URL.Path == "/2013-04-01/hostedzonesbyname" {
+			operation = "ListHostedZonesByName"
+		}
+		// GetChange 的 zoneId 为变更 ID
+		if m := route53ChangePathPattern.FindStringSubmatch(r.URL.Path); m != nil {
+			operation, zoneId = "GetChange", m[1]
+		}
+		// 健康检查的 zoneId 为健康检查 ID
+		if m := route53HealthCheckPathPattern.FindStringSubmatch(r.URL.Path); m != nil {
+			zoneId = m[2]
+			switch {
+			case m[1] != "" && zoneId != "":
+				operation = "ChangeTagsForResource"
+			case m[1] != "":
+				operation = "ListTagsForResources"
+			case m[3] != "":
+				operation = "GetHealthCheckStatus"
+			case zoneId == "" && r.Method == http.MethodPost:
+				operation = "CreateHealthCheck"
+			case zoneId == "":
+				operation = "ListHealthChecks"
+			case r.Method == http.MethodPost:
+				operation = "UpdateHealthCheck"
+			case r.Method == http.MethodDelete:
+				operation = "DeleteHealthCheck"
+			default:
+				operation = "GetHealthCheck"
+			}
+		}
+		dir, ext := "route53", ".xml"
+		// EC2 为 query 协议，body 中的 Action 为接口名
+		if r.URL.Path == "/" && r.Method == http.MethodPost {
+			if values, err := url.ParseQuery(body.String()); err == nil && values.Get("Action") != "" {
+				operation, dir = values.Get("Action"), "ec2"
+			}
+		}
+		if target := r.Header.Get("X-Amz-Target"); strings.HasPrefix(target, "Route53Domains_v20140515.") {
+			operation = strings.TrimPrefix(target, "Route53Domains_v20140515.")
+			dir, ext = "route53domains", ".json"
+		}
+		f.lock.Lock()
+		f.requests = append(f.requests, awsFixtureRequest{operation: operation, zoneId: zoneId, query: r.URL.Query(), body: body.String()})
+		f.lock.Unlock()
+
+		if dir == "route53domains" {
+			w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+			data, err := os.ReadFile(filepath.Join("testdata", "aws", dir, operation+ext))
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"__type": "InvalidInput", "message": "fixture not found"}`))
+				return
+			}
+			w.Write(data)
+			return
+		}
+		w.Header().Set("Content-Type", "text/xml")
+		for _, name := range []string{operation + "_" + zoneId + ext, operation + ext} {
+			data, err := os.ReadFile(filepath.Join("testdata", "aws", dir, name))
+			if err == nil {
+				w.Write(data)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>NoSuchHostedZone</Code><Message>fixture not found</Message></Error></ErrorResponse>`))
+	}))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *awsFixture) lastRequest(operation string) *awsFixtureRequest {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for i := len(f.requests) - 1; i >= 0; i-- {
+		if f.requests[i].operation == operation {
+			return &f.requests[i]
+		}
+	}
+	return nil
+}
+
+func (f *awsFixture) operations() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var operations []string
+	for _, req := range f.requests {
+		operations = append(operations, req.operation)
+	}
+	return operations
+}
+
+// awsFixtureClientIo 只替换 EC2、Route53 和 Route53 Domains 的客户端
+type awsFixtureClientIo struct {
+	model.ClientIo
+	endpoint string
+}
+
+func (c awsFixtureClientIo) newSession() (*session.Session, error) {
+	return session.NewSession(&aws.Config{
+		Endpoint:    aws.String(c.endpoint),
+		Region:      aws.String("us-east-1"),
+		Credentials: credentials.NewStaticCredentials("ak", "sk", ""),
+	})
+}
+
+func (c awsFixtureClientIo) GetAwsEc2Client(profile, region string) (*ec2.EC2, error) {
+	sess, err := c.newSession()
+	if err != nil {
+		return nil, err
+	}
+	return ec2.New(sess, aws.NewConfig().WithRegion(region)), nil
+}
+
+func (c awsFixtureClientIo) GetAwsRoute53Client(profile, region string) (*route53.Route53, error) {
+	sess, err := c.newSession()
+	if err != nil {
+		return nil, err
+	}
+	return route53.New(sess), nil
+}
+
+func (c awsFixtureClientIo) GetAwsRoute53DomainClient(profile string) (*route53domains.Route53Domains, error) {
+	sess, err := c.newSession()
+	if err != nil {
+		return nil, err
+	}
+	return route53domains.New(sess), nil
+}
+
+func newAwsFixtureService(t *testing.T) (model.CommonContract, *awsFixture) {
+	fixture := newAwsFixture(t)
+	profiles := []model.ProfileConfig{
+		{
+			Name:  "aws",
+			Cloud: model.AWS,
+			AK:    "ak",
+			SK:    "sk",
+		},
+	}
+	clientIo := awsFixtureClientIo{
+		ClientIo: io.NewCloudClient(profiles),
+		endpoint: fixture.server.URL,
+	}
+	registry := service.NewRegistry()
+	registry.Register(model.AWS, io.NewAwsClient(clientIo))
+	return service.NewCommonServiceWithRegistry(profiles, registry), fixture
+}
+
+func TestAwsDescribePrivateDomainList(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	resp, err := s.PrivateDomainListWithContext(context.Background(), "aws", model.DescribeDomainListRequest{})
+	assert.Nil(t, err)
+	// 公有域被过滤掉，默认不逐个查询 VPC
+	assert.Len(t, resp.DomainList, 1)
+	assert.Equal(t, int64(1), tea.Int64Value(resp.TotalCount))
+	domain := resp.DomainList[0]
+	assert.Equal(t, "/hostedzone/Z2PRIVATE", tea.StringValue(domain.DomainId))
+	assert.Equal(t, "corp.internal", tea.StringValue(domain.Name))
+	assert.Equal(t, int64(4), tea.Int64Value(domain.RecordCount))
+	assert.Empty(t, domain.VpcSet)
+	assert.Equal(t, []string{"ListHostedZones"}, f.operations())
+
+	resp, err = s.PrivateDomainListWithContext(context.Background(), "aws", model.DescribeDomainListRequest{WithVpcs: true})
+	assert.Nil(t, err)
+	domain = resp.DomainList[0]
+	assert.Equal(t, "ENABLED", tea.StringValue(domain.Status))
+
+	vpcs := domain.VpcSet
+	assert.Len(t, vpcs, 2)
+	assert.Equal(t, "vpc-0a1b2c3d", tea.StringValue(vpcs[0].VpcId))
+	assert.Equal(t, "cn-northwest-1", tea.StringValue(vpcs[0].Region))
+	assert.Equal(t, "Z2PRIVATE", f.lastRequest("GetHostedZone").zoneId)
+}
+
+func TestAwsDescribePrivateRecordList(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	resp, err := s.PrivateRecordListWithContext(context.Background(), "aws", model.DescribePrivateRecordListRequest{
+		Domain: tea.String("corp.internal"),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, resp.RecordList, 4)
+	// 按名称直接查找私有域，不列出全部 hosted zone
+	assert.Equal(t, []string{"ListHostedZonesByName", "ListResourceRecordSets"}, f.operations())
+	assert.Equal(t, "corp.internal.", f.lastRequest("ListHostedZonesByName").query["dnsname"][0])
+	wildcard := resp.RecordList[3]
+	assert.Equal(t, "*", tea.StringValue(wildcard.SubDomain))
+	assert.Equal(t, "CNAME", tea.StringValue(wildcard.RecordType))
+	assert.Equal(t, "*.corp.internal.|CNAME", tea.StringValue(wildcard.RecordId))
+
+	// 按 hostedzoneId 直接 GetHostedZone
+	before := len(f.operations())
+	resp, err = s.PrivateRecordListWithContext(context.Background(), "aws", model.DescribePrivateRecordListRequest{
+		Domain:  tea.String("/hostedzone/Z2PRIVATE"),
+		Keyword: tea.String("db"),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, resp.RecordList, 1)
+	assert.Equal(t, "10.0.1.10", tea.StringValue(resp.RecordList[0].Value))
+	assert.Equal(t, uint64(60), tea.Uint64Value(resp.RecordList[0].TTL))
+	assert.Equal(t, []string{"GetHostedZone", "ListResourceRecordSets"}, f.operations()[before:])
+	assert.Equal(t, "Z2PRIVATE", f.lastRequest("GetHostedZone").zoneId)
+}
+
+func TestAwsPrivateRecordWithPublicDomain(t *testing.T) {
+	s, _ := newAwsFixtureService(t)
+	_, err := s.PrivateRecordListWithContext(context.Background(), "aws", model.DescribePrivateRecordListRequest{
+		Domain: tea.String("example.com"),
+	})
+	assert.True(t, errors.Is(err, model.ErrNotFound))
+}
+
+func TestAwsCreatePrivateRecord(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	resp, err := s.PrivateCreateRecordWithContext(context.Background(), "aws", model.CreateRecordRequest{
+		Domain:     tea.String("corp.internal"),
+		SubDomain:  tea.String("cache"),
+		RecordType: tea.String("A"),
+		Value:      tea.String("10.0.1.20"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "cache.corp.internal.|A", tea.StringValue(resp.RecordId))
+
+	req := f.lastRequest("ChangeResourceRecordSets")
+	assert.Equal(t, "Z2PRIVATE", req.zoneId)
+	assert.Contains(t, req.body, "<Action>CREATE</Action>")
+	assert.Contains(t, req.body, "<Name>cache.corp.internal.</Name>")
+	assert.Contains(t, req.body, "<TTL>300</TTL>")
+}
+
+func TestAwsModifyPrivateRecord(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	err := s.PrivateModifyRecordWithContext(context.Background(), "aws", model.ModifyRecordRequest{
+		Domain:     tea.String("corp.internal"),
+		SubDomain:  tea.String("@"),
+		RecordType: tea.String("TXT"),
+		Value:      tea.String(`"v=1"`),
+		TTL:        tea.Uint64(120),
+	})
+	assert.Nil(t, err)
+	req := f.lastRequest("ChangeResourceRecordSets")
+	assert.Contains(t, req.body, "<Action>UPSERT</Action>")
+	assert.Contains(t, req.body, "<Name>corp.internal.</Name>")
+	assert.Contains(t, req.body, "<TTL>120</TTL>")
+}
+
+func TestAwsDeletePrivateRecord(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	err := s.PrivateDeleteRecordWithContext(context.Background(), "aws", model.DeletePrivateRecordRequest{
+		Domain:    tea.String("corp.internal"),
+		RecordIds: []*string{tea.String("db.corp.internal.|A"), tea.String("*.corp.internal.|CNAME")},
+	})
+	assert.Nil(t, err)
+	req := f.lastRequest("ChangeResourceRecordSets")
+	assert.Contains(t, req.body, "<Action>DELETE</Action>")
+	assert.Contains(t, req.body, "<Value>10.0.1.10</Value>")
+	assert.Contains(t, req.body, "<TTL>60</TTL>")
+	assert.Contains(t, req.body, "<Value>db.corp.internal</Value>")
+
+	err = s.PrivateDeleteRecordWithContext(context.Background(), "aws", model.DeletePrivateRecordRequest{
+		Domain:   tea.String("corp.internal"),
+		RecordId: tea.String("missing.corp.internal.|A"),
+	})
+	assert.True(t, errors.Is(err, model.ErrRecordNotFound))
+}
+
+func TestAwsDescribeRecordListWithRoutingPolicy(t *testing.T) {
+	s, _ := newAwsFixtureService(t)
+	resp, err := s.DescribeRecordListWithContext(context.Background(), "aws", "", model.DescribeRecordListRequest{
+		Domain: tea.String("example.com"),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, resp.RecordList, 5)
+
+	www := resp.RecordList[2]
+	assert.Equal(t, "www", tea.StringValue(www.SubDomain))
+	assert.Equal(t, []string{"203.0.113.10", "203.0.113.11"}, tea.StringSliceValue(www.Values))
+	assert.Equal(t, "203.0.113.10", tea.StringValue(www.Value))
+	assert.Nil(t, www.RoutingPolicy)
+	assert.Nil(t, www.Weight)
+
+	blue := resp.RecordList[3]
+	assert.Equal(t, "blue", tea.StringValue(blue.SetIdentifier))
+	assert.Equal(t, uint64(80), tea.Uint64Value(blue.Weight))
+	assert.Equal(t, model.RoutingPolicyWeighted, blue.RoutingPolicy.Type)
+	assert.Equal(t, "hc-blue", tea.StringValue(blue.RoutingPolicy.HealthCheckId))
+}
+
+func TestAwsCreateWeightedRecord(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	_, err := s.CreateRecordWithContext(context.Background(), "aws", "", model.CreateRecordRequest{
+		Domain:        tea.String("example.com"),
+		SubDomain:     tea.String("api"),
+		RecordType:    tea.String("A"),
+		Values:        []*string{tea.String("203.0.113.40"), tea.String("203.0.113.41")},
+		Weight:        tea.Uint64(10),
+		SetIdentifier: tea.String("canary"),
+		RoutingPolicy: &model.RoutingPolicy{Type: model.RoutingPolicyWeighted},
+	})
+	assert.Nil(t, err)
+	req := f.lastRequest("ChangeResourceRecordSets")
+	assert.Equal(t, "Z1PUBLIC", req.zoneId)
+	assert.Contains(t, req.body, "<SetIdentifier>canary</SetIdentifier>")
+	assert.Contains(t, req.body, "<Weight>10</Weight>")
+	assert.Contains(t, req.body, "<Value>203.0.113.40</Value>")
+	assert.Contains(t, req.body, "<Value>203.0.113.41</Value>")
+}
+
+func TestAwsDeleteMultiValueRecord(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	_, err := s.DeleteRecordWithContext(context.Background(), "aws", "", model.DeleteRecordRequest{
+		Domain:     tea.String("example.com"),
+		SubDomain:  tea.String("www"),
+		RecordType: tea.String("A"),
+	})
+	assert.Nil(t, err)
+	// 删除时要带上完整的记录集，两个值都要在请求里
+	req := f.lastRequest("ChangeResourceRecordSets")
+	assert.Contains(t, req.body, "<Value>203.0.113.10</Value>")
+	assert.Contains(t, req.body, "<Value>203.0.113.11</Value>")
+
+	// 只删除指定 SetIdentifier 的加权记录
+	_, err = s.DeleteRecordWithContext(context.Background(), "aws", "", model.DeleteRecordRequest{
+		Domain:        tea.String("example.com"),
+		SubDomain:     tea.String("api"),
+		RecordType:    tea.String("A"),
+		SetIdentifier: tea.String("green"),
+	})
+	assert.Nil(t, err)
+	req = f.lastRequest("ChangeResourceRecordSets")
+	assert.Contains(t, req.body, "<SetIdentifier>green</SetIdentifier>")
+	assert.NotContains(t, req.body, "<SetIdentifier>blue</SetIdentifier>")
+
+	_, err = s.DeleteRecordWithContext(context.Background(), "aws", "", model.DeleteRecordRequest{
+		Domain:        tea.String("example.com"),
+		SubDomain:     tea.String("api"),
+		RecordType:    tea.String("A"),
+		SetIdentifier: tea.String("missing"),
+	})
+	assert.True(t, errors.Is(err, model.ErrRecordNotFound))
+}
+
+func TestAwsAliasRecord(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	resp, err := s.DescribeRecordListWithContext(context.Background(), "aws", "", model.DescribeRecordListRequest{
+		Domain: tea.String("example.com"),
+	})
+	assert.Nil(t, err)
+	apex := resp.RecordList[1]
+	assert.Equal(t, "", tea.StringValue(apex.SubDomain))
+	assert.Equal(t, "web-lb-123456.cn-northwest-1.elb.amazonaws.com.cn.", tea.StringValue(apex.Value))
+	assert.Nil(t, apex.TTL)
+	assert.Equal(t, "ZM7IZAIOVVDZF", tea.StringValue(apex.AliasTarget.HostedZoneId))
+	assert.True(t, tea.BoolValue(apex.AliasTarget.EvaluateTargetHealth))
+
+	// 别名记录不能带 TTL，默认的 300 也不能加
+	_, err = s.CreateRecordWithContext(context.Background(), "aws", "", model.CreateRecordRequest{
+		Domain:     tea.String("example.com"),
+		SubDomain:  tea.String("cdn"),
+		RecordType: tea.String("A"),
+		AliasTarget: &model.AliasTarget{
+			DNSName:      tea.String("d111111abcdef8.cloudfront.net"),
+			HostedZoneId: tea.String("Z2FDTNDATAQYW2"),
+		},
+	})
+	assert.Nil(t, err)
+	req := f.lastRequest("ChangeResourceRecordSets")
+	assert.Contains(t, req.body, "<DNSName>d111111abcdef8.cloudfront.net</DNSName>")
+	assert.Contains(t, req.body, "<HostedZoneId>Z2FDTNDATAQYW2</HostedZoneId>")
+	assert.Contains(t, req.body, "<EvaluateTargetHealth>false</EvaluateTargetHealth>")
+	assert.NotContains(t, req.body, "<TTL>")
+	assert.NotContains(t, req.body, "<ResourceRecords>")
+}
+
+func TestAwsExportZone(t *testing.T) {
+	s, _ := newAwsFixtureService(t)
+	zone, err := s.ExportZoneWithContext(context.Background(), "aws", "", model.ExportZoneRequest{
+		Domain: tea.String("example.com"),
+	})
+	assert.Nil(t, err)
+	content := zone.String()
+	assert.Contains(t, content, "$ORIGIN example.com.\n")
+	// 公有域列表没有 SOA，用 NS 补一条
+	assert.Contains(t, content, "@\t600\tIN\tSOA\tns-1.awsdns-01.org. hostmaster.example.com. 1 7200 900 1209600 600\n")
+	assert.Contains(t, content, "www\t300\tIN\tA\t203.0.113.11\n")
+	assert.Contains(t, content, "; alias @ A -> web-lb-123456.cn-northwest-1.elb.amazonaws.com.cn.\n")
+
+	zone, err = s.ExportZoneWithContext(context.Background(), "aws", "", model.ExportZoneRequest{
+		Domain:  tea.String("corp.internal"),
+		Private: true,
+	})
+	assert.Nil(t, err)
+	content = zone.String()
+	assert.Contains(t, content, "@\t900\tIN\tSOA\tns-0.awsdns-00.com. awsdns-hostmaster.amazon.com. 1 7200 900 1209600 86400\n")
+	assert.Contains(t, content, "*\t300\tIN\tCNAME\tdb.corp.internal.\n")
+}
+
+func TestAwsImportZone(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	zoneFile := `$TTL 300
+@	IN	NS	ns1.other-dns.com.
+www	IN	A	203.0.113.99
+mail	IN	MX	10 mx1
+@	IN	TXT	"v=spf1 -all"
+`
+	resp, err := s.ImportZoneWithContext(context.Background(), "aws", "", model.ImportZoneRequest{
+		Domain:   tea.String("example.com"),
+		ZoneFile: tea.String(zoneFile),
+		DryRun:   true,
+	})
+	assert.Nil(t, err)
+	// 根域名 NS 和已经存在的 www A 跳过
+	assert.Len(t, resp.Skipped, 2)
+	assert.Len(t, resp.Created, 2)
+	assert.Nil(t, f.lastRequest("ChangeResourceRecordSets"))
+
+	resp, err = s.ImportZoneWithContext(context.Background(), "aws", "", model.ImportZoneRequest{
+		Domain:   tea.String("example.com"),
+		ZoneFile: tea.String(zoneFile),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, resp.Created, 2)
+	assert.Empty(t, resp.Failed)
+	req := f.lastRequest("ChangeResourceRecordSets")
+	assert.Contains(t, req.body, "<Name>example.com.</Name>")
+	assert.Contains(t, req.body, `<Value>&#34;v=spf1 -all&#34;</Value>`)
+}
+
+func TestAwsPlanAndApplyDnsZone(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	spec := model.DnsZoneSpec{
+		Domain: "example.com",
+		Prune:  true,
+		Records: []model.DnsRecordSpec{
+			{Name: "www", Type: "A", TTL: 300, Values: []string{"203.0.113.10", "203.0.113.12"}},
+			{Name: "api", Type: "A", Values: []string{"203.0.113.20"}, Weight: tea.Uint64(80), SetIdentifier: "blue"},
+			{Name: "@", Type: "TXT", Values: []string{"v=spf1 -all"}},
+		},
+	}
+	plan, err := s.PlanDnsZoneWithContext(context.Background(), "aws", "", spec)
+	assert.Nil(t, err)
+	// 根域名 NS 和别名记录不处理，api blue 没有变化
+	assert.Equal(t, `~ www A ttl=300 [203.0.113.10, 203.0.113.11] -> ttl=300 [203.0.113.10, 203.0.113.12]
++ @ TXT ttl=0 ["v=spf1 -all"]
+- api A set=green ttl=60 weight=20 [203.0.113.30]
+`, plan.String())
+
+	resp, err := s.ApplyDnsPlanWithContext(context.Background(), "aws", "", plan)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Applied, 3)
+	assert.Empty(t, resp.Failed)
+	req := f.lastRequest("ChangeResourceRecordSets")
+	assert.Contains(t, req.body, "<Action>DELETE</Action>")
+	assert.Contains(t, req.body, "<SetIdentifier>green</SetIdentifier>")
+}
+
+// 更新加权记录保留线上的权重和健康检查，别名记录按别名目标比较和 UPSERT
+func TestAwsPlanAndApplyDnsZoneRoutingPolicy(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	spec, err := model.ParseDnsZoneSpec([]byte(`
+domain: example.com
+records:
+  - name: api
+    type: A
+    set_identifier: blue
+    values: [203.0.113.21]
+  - name: "@"
+    type: A
+    alias_target:
+      dns_name: web-lb-123456.cn-northwest-1.elb.amazonaws.com.cn
+      hosted_zone_id: ZM7IZAIOVVDZF
+      evaluate_target_health: true
+  - name: static
+    type: A
+    alias_target:
+      dns_name: d111111abcdef8.cloudfront.net
+      hosted_zone_id: Z2FDTNDATAQYW2
+`))
+	assert.Nil(t, err)
+	plan, err := s.PlanDnsZoneWithContext(context.Background(), "aws", "", spec)
+	assert.Nil(t, err)
+	// 根域名的别名没有变化
+	assert.Equal(t, `~ api A set=blue ttl=60 weight=80 [203.0.113.20] -> ttl=60 weight=80 [203.0.113.21]
++ static A ttl=0 alias=d111111abcdef8.cloudfront.net
+`, plan.String())
+
+	resp, err := s.ApplyDnsPlanWithContext(context.Background(), "aws", "", plan)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Applied, 2)
+	assert.Empty(t, resp.Failed)
+	var bodies []string
+	for _, req := range f.requests {
+		if req.operation == "ChangeResourceRecordSets" {
+			bodies = append(bodies, req.body)
+		}
+	}
+	assert.Len(t, bodies, 2)
+	assert.Contains(t, bodies[0], "<Action>UPSERT</Action>")
+	assert.Contains(t, bodies[0], "<SetIdentifier>blue</SetIdentifier>")
+	assert.Contains(t, bodies[0], "<Weight>80</Weight>")
+	assert.Contains(t, bodies[0], "<HealthCheckId>hc-blue</HealthCheckId>")
+	assert.Contains(t, bodies[0], "<Value>203.0.113.21</Value>")
+	assert.Contains(t, bodies[1], "<DNSName>d111111abcdef8.cloudfront.net</DNSName>")
+	assert.Contains(t, bodies[1], "<HostedZoneId>Z2FDTNDATAQYW2</HostedZoneId>")
+	assert.NotContains(t, bodies[1], "<ResourceRecords>")
+}
+
+func TestAwsChangeRecordSet(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	_, err := s.ChangeRecordSetWithContext(context.Background(), "aws", "", model.ChangeRecordSetRequest{
+		Domain: tea.String("example.com"),
+		Changes: []model.RecordChange{
+			{Action: model.RecordChangeDelete, Record: model.Record{SubDomain: tea.String("www"), RecordType: tea.String("A")}},
+			{Action: model.RecordChangeCreate, Record: model.Record{SubDomain: tea.String("www"), RecordType: tea.String("CNAME"), Value: tea.String("lb.example.net.")}},
+		},
+		Comment: tea.String("switch www to lb"),
+	})
+	assert.Nil(t, err)
+	req := f.lastRequest("ChangeResourceRecordSets")
+	assert.Equal(t, "Z1PUBLIC", req.zoneId)
+	assert.Contains(t, req.body, "<Comment>switch www to lb</Comment>")
+	assert.Contains(t, req.body, "<Action>DELETE</Action>")
+	assert.Contains(t, req.body, "<Value>203.0.113.10</Value>")
+	assert.Contains(t, req.body, "<Value>203.0.113.11</Value>")
+	assert.Contains(t, req.body, "<Action>CREATE</Action>")
+	assert.Contains(t, req.body, "<Value>lb.example.net.</Value>")
+	assert.Contains(t, req.body, "<TTL>300</TTL>")
+	assert.True(t, strings.Index(req.body, "<Action>DELETE</Action>") < strings.Index(req.body, "<Action>CREATE</Action>"))
+}
+
+func TestAwsChangeRecordSetNotFound(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	_, err := s.ChangeRecordSetWithContext(context.Background(), "aws", "", model.ChangeRecordSetRequest{
+		Domain: tea.String("example.com"),
+		Changes: []model.RecordChange{
+			{Action: model.RecordChangeCreate, Record: model.Record{SubDomain: tea.String("new"), RecordType: tea.String("A"), Value: tea.String("203.0.113.50")}},
+			{Action: model.RecordChangeDelete, Record: model.Record{SubDomain: tea.String("missing"), RecordType: tea.String("A")}},
+		},
+	})
+	assert.True(t, errors.Is(err, model.ErrRecordNotFound))
+	assert.Nil(t, f.lastRequest("ChangeResourceRecordSets"))
+}
+
+func TestAwsPrivateChangeRecordSet(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	_, err := s.PrivateChangeRecordSetWithContext(context.Background(), "aws", model.ChangeRecordSetRequest{
+		Domain: tea.String("corp.internal"),
+		Changes: []model.RecordChange{
+			{Action: model.RecordChangeUpsert, Record: model.Record{SubDomain: tea.String("db"), RecordType: tea.String("A"), Value: tea.String("10.0.0.9"), TTL: tea.Uint64(60)}},
+		},
+	})
+	assert.Nil(t, err)
+	req := f.lastRequest("ChangeResourceRecordSets")
+	assert.Equal(t, "Z2PRIVATE", req.zoneId)
+	assert.Contains(t, req.body, "<Action>UPSERT</Action>")
+	assert.Contains(t, req.body, "<Name>db.corp.internal.</Name>")
+	assert.Contains(t, req.body, "<TTL>60</TTL>")
+}
+
+func TestAwsWaitForRecordChange(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	resp, err := s.WaitForRecordChangeWithContext(context.Background(), "aws", "", model.WaitForRecordChangeRequest{
+		Domain:     tea.String("example.com"),
+		SubDomain:  tea.String("www"),
+		RecordType: tea.String("A"),
+		Values:     []*string{tea.String("203.0.113.11")},
+		ChangeId:   tea.String("/change/C2682N5HXP0BZ4"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, model.RecordChangeInSync, resp.Status)
+	assert.Equal(t, "C2682N5HXP0BZ4", f.lastRequest("GetChange").zoneId)
+	assert.Len(t, resp.Record.Values, 2)
+
+	_, err = s.WaitForRecordChangeWithContext(context.Background(), "aws", "", model.WaitForRecordChangeRequest{
+		Domain:     tea.String("example.com"),
+		SubDomain:  tea.String("www"),
+		RecordType: tea.String("A"),
+		Values:     []*string{tea.String("203.0.113.99")},
+		Timeout:    50 * time.Millisecond,
+		Interval:   10 * time.Millisecond,
+	})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	_, err = s.WaitForRecordChangeWithContext(context.Background(), "aws", "", model.WaitForRecordChangeRequest{
+		Domain:           tea.String("corp.internal"),
+		SubDomain:        tea.String("db"),
+		RecordType:       tea.String("A"),
+		Private:          true,
+		CheckNameservers: true,
+	})
+	assert.True(t, errors.Is(err, model.ErrInvalidInput))
+}
+
+// newTestNameserver 本地的权威 DNS，只应答 answers 中的 A 记录
+func newTestNameserver(t *testing.T, answers map[string][]string) string {
+	return startTestNameserver(t, answers, false)
+}
+
+// newFlakyTestNameserver 第一次查询丢包，第二次返回 SERVFAIL，之后先返回一个 ID 不对的应答再正常应答
+func newFlakyTestNameserver(t *testing.T, answers map[string][]string) string {
+	return startTestNameserver(t, answers, true)
+}
+
+func startTestNameserver(t *testing.T, answers map[string][]string, flaky bool) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	go func() {
+		buf := make([]byte, 512)
+		for queries := 1; ; queries++ {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			var msg dnsmessage.Message
+			if msg.Unpack(buf[:n]) != nil || len(msg.Questions) == 0 {
+				continue
+			}
+			question := msg.Questions[0]
+			msg.Header.Response, msg.Header.Authoritative = true, true
+			if flaky && queries == 1 {
+				continue
+			}
+			if flaky && queries == 2 {
+				msg.Header.RCode = dnsmessage.RCodeServerFailure
+				data, _ := msg.Pack()
+				conn.WriteTo(data, addr)
+				continue
+			}
+			if flaky {
+				stale := msg
+				stale.Header.ID++
+				stale.Answers = []dnsmessage.Resource{{
+					Header: dnsmessage.ResourceHeader{Name: question.Name, Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: 300},
+					Body:   &dnsmessage.AResource{A: [4]byte{192, 0, 2, 1}},
+				}}
+				data, _ := stale.Pack()
+				conn.WriteTo(data, addr)
+			}
+			for _, ip := range answers[question.Name.String()] {
+				var a [4]byte
+				copy(a[:], net.ParseIP(ip).To4())
+				msg.Answers = append(msg.Answers, dnsmessage.Resource{
+					Header: dnsmessage.ResourceHeader{Name: question.Name, Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: 300},
+					Body:   &dnsmessage.AResource{A: a},
+				})
+			}
+			data, _ := msg.Pack()
+			conn.WriteTo(data, addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestAwsWaitForRecordChangeNameservers(t *testing.T) {
+	s, _ := newAwsFixtureService(t)
+	synced := newTestNameserver(t, map[string][]string{"www.example.com.": {"203.0.113.11", "203.0.113.10"}})
+	resp, err := s.WaitForRecordChangeWithContext(context.Background(), "aws", "", model.WaitForRecordChangeRequest{
+		Domain:           tea.String("example.com"),
+		SubDomain:        tea.String("www"),
+		RecordType:       tea.String("A"),
+		CheckNameservers: true,
+		Nameservers:      []string{synced},
+		Timeout:          5 * time.Second,
+		Interval:         10 * time.Millisecond,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, model.RecordChangeInSync, resp.Status)
+	assert.Equal(t, []string{synced}, resp.Nameservers)
+
+	// 权威 DNS 一直返回旧的值，超时
+	stale := newTestNameserver(t, map[string][]string{"www.example.com.": {"203.0.113.10"}})
+	resp, err = s.WaitForRecordChangeWithContext(context.Background(), "aws", "", model.WaitForRecordChangeRequest{
+		Domain:           tea.String("example.com"),
+		SubDomain:        tea.String("www"),
+		RecordType:       tea.String("A"),
+		CheckNameservers: true,
+		Nameservers:      []string{stale},
+		Timeout:          200 * time.Millisecond,
+		Interval:         10 * time.Millisecond,
+	})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, model.RecordChangePending, resp.Status)
+	assert.Empty(t, resp.Nameservers)
+
+	// 丢包、SERVFAIL 和 ID 不对的应答都继续重试
+	flaky := newFlakyTestNameserver(t, map[string][]string{"www.example.com.": {"203.0.113.11", "203.0.113.10"}})
+	resp, err = s.WaitForRecordChangeWithContext(context.Background(), "aws", "", model.WaitForRecordChangeRequest{
+		Domain:           tea.String("example.com"),
+		SubDomain:        tea.String("www"),
+		RecordType:       tea.String("A"),
+		CheckNameservers: true,
+		Nameservers:      []string{flaky},
+		Timeout:          5 * time.Second,
+		Interval:         10 * time.Millisecond,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, model.RecordChangeInSync, resp.Status)
+	assert.Equal(t, []string{flaky}, resp.Nameservers)
+}
+
+func TestAwsHealthCheck(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	_, err := s.CreateHealthCheckWithContext(context.Background(), "aws", model.CreateHealthCheckRequest{
+		Type:      model.HealthCheckTCP,
+		IPAddress: tea.String("203.0.113.30"),
+	})
+	assert.NotNil(t, err)
+	assert.Nil(t, f.lastRequest("CreateHealthCheck"))
+
+	resp, err := s.CreateHealthCheckWithContext(context.Background(), "aws", model.CreateHealthCheckRequest{
+		Name:      tea.String("api-green"),
+		Type:      model.HealthCheckHTTPS,
+		IPAddress: tea.String("203.0.113.30"),
+		Path:      tea.String("/healthz"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "hc-green", tea.StringValue(resp.Id))
+	req := f.lastRequest("CreateHealthCheck")
+	assert.Contains(t, req.body, "<Type>HTTPS</Type>")
+	assert.Contains(t, req.body, "<Port>443</Port>")
+	assert.Contains(t, req.body, "<RequestInterval>30</RequestInterval>")
+	assert.Contains(t, req.body, "<FailureThreshold>3</FailureThreshold>")
+	req = f.lastRequest("ChangeTagsForResource")
+	assert.Equal(t, "hc-green", req.zoneId)
+	assert.Contains(t, req.body, "<Value>api-green</Value>")
+
+	healthChecks, err := s.DescribeHealthChecksWithContext(context.Background(), "aws")
+	assert.Nil(t, err)
+	assert.Len(t, healthChecks, 2)
+	assert.Equal(t, "api-blue", tea.StringValue(healthChecks[0].Name))
+	assert.Equal(t, model.HealthCheckHTTP, healthChecks[0].Type)
+	assert.Equal(t, "api.example.com", tea.StringValue(healthChecks[0].Domain))
+	assert.Equal(t, int64(10), tea.Int64Value(healthChecks[0].Interval))
+	assert.Nil(t, healthChecks[1].Name)
+	assert.True(t, tea.BoolValue(healthChecks[1].Disabled))
+
+	err = s.ModifyHealthCheckWithContext(context.Background(), "aws", model.ModifyHealthCheckRequest{
+		Id:   tea.String("hc-blue"),
+		Path: tea.String("/ready"),
+	})
+	assert.Nil(t, err)
+	req = f.lastRequest("UpdateHealthCheck")
+	assert.Equal(t, "hc-blue", req.zoneId)
+	assert.Contains(t, req.body, "<ResourcePath>/ready</ResourcePath>")
+	assert.NotContains(t, req.body, "<Port>")
+
+	status, err := s.DescribeHealthCheckStatusWithContext(context.Background(), "aws", "hc-blue")
+	assert.Nil(t, err)
+	assert.Equal(t, model.HealthCheckHealthy, status.Status)
+	assert.Len(t, status.Observations, 2)
+	assert.False(t, status.Observations[1].Healthy)
+
+	assert.Nil(t, s.DeleteHealthCheckWithContext(context.Background(), "aws", "hc-blue"))
+	assert.Equal(t, "hc-blue", f.lastRequest("DeleteHealthCheck").zoneId)
+}
+
+func TestAwsRecordLine(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	lines, err := s.DescribeRecordLineListWithContext(context.Background(), "aws", "", model.DescribeRecordLineListRequest{Domain: tea.String("example.com")})
+	assert.Nil(t, err)
+	var lineIds []string
+	for _, line := range lines.Lines {
+		lineIds = append(lineIds, tea.StringValue(line.LineId))
+	}
+	assert.Equal(t, []string{"continent/AS", "*", "country/CN", "country/US/WA"}, lineIds)
+	assert.Equal(t, "United States/Washington", tea.StringValue(lines.Lines[3].Name))
+
+	_, err = s.CreateRecordWithContext(context.Background(), "aws", "", model.CreateRecordRequest{
+		Domain:       tea.String("example.com"),
+		SubDomain:    tea.String("www"),
+		RecordType:   tea.String("A"),
+		Value:        tea.String("203.0.113.60"),
+		RecordLineId: tea.String("country/CN"),
+	})
+	assert.Nil(t, err)
+	req := f.lastRequest("ChangeResourceRecordSets")
+	assert.Contains(t, req.body, "<GeoLocation><CountryCode>CN</CountryCode></GeoLocation>")
+	assert.Contains(t, req.body, "<SetIdentifier>country/CN</SetIdentifier>")
+
+	_, err = s.CreateRecordWithContext(context.Background(), "aws", "", model.CreateRecordRequest{
+		Domain:       tea.String("example.com"),
+		SubDomain:    tea.String("www"),
+		RecordType:   tea.String("A"),
+		Value:        tea.String("203.0.113.60"),
+		RecordLineId: tea.String("10=0"),
+	})
+	assert.NotNil(t, err)
+}
+
+func TestAwsRegisteredDomains(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	domains, err := s.DescribeRegisteredDomainsWithContext(context.Background(), "aws", model.DescribeRegisteredDomainsRequest{})
+	assert.Nil(t, err)
+	assert.Len(t, domains, 2)
+	assert.Equal(t, "forgotten.net", tea.StringValue(domains[1].Name))
+	assert.False(t, tea.BoolValue(domains[1].AutoRenew))
+	assert.Equal(t, "2025-01-01", domains[1].ExpirationDate.UTC().Format("2006-01-02"))
+	assert.Nil(t, domains[1].Registrant)
+	assert.Nil(t, f.lastRequest("GetDomainDetail"))
+
+	domain, err := s.DescribeRegisteredDomainWithContext(context.Background(), "aws", "example.com")
+	assert.Nil(t, err)
+	assert.Contains(t, f.lastRequest("GetDomainDetail").body, `"DomainName":"example.com"`)
+	assert.True(t, tea.BoolValue(domain.TransferLock))
+	assert.Equal(t, []string{"ns-1.awsdns-01.org", "ns-2.awsdns-02.com"}, domain.Nameservers)
+	assert.Equal(t, "Jane Doe", tea.StringValue(domain.Registrant.Name))
+	assert.Equal(t, "Example Inc", tea.StringValue(domain.Registrant.Organization))
+	assert.Equal(t, "2015-01-01", domain.CreationDate.UTC().Format("2006-01-02"))
+
+	domains, err = s.DescribeRegisteredDomainsWithContext(context.Background(), "aws", model.DescribeRegisteredDomainsRequest{Detail: true})
+	assert.Nil(t, err)
+	assert.Len(t, domains, 2)
+	assert.Equal(t, "jane@example.com", tea.StringValue(domains[0].Registrant.Email))
+}
+
+func TestAwsPrivateZoneLifecycle(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	vpc := model.PrivateZoneVpc{VpcId: tea.String("vpc-0a1b2c3d"), Region: tea.String("cn-northwest-1")}
+	shared := model.PrivateZoneVpc{VpcId: tea.String("vpc-0shared"), Region: tea.String("cn-north-1"), AccountId: tea.String("123456789012")}
+
+	// 第一个 VPC 必须是当前账号的
+	_, err := s.CreatePrivateZoneWithContext(context.Background(), "aws", model.CreatePrivateZoneRequest{
+		Domain: tea.String("staging.internal"),
+		Vpcs:   []model.PrivateZoneVpc{shared},
+	})
+	assert.NotNil(t, err)
+
+	resp, err := s.CreatePrivateZoneWithContext(context.Background(), "aws", model.CreatePrivateZoneRequest{
+		Domain: tea.String("staging.internal."),
+		Remark: tea.String("staging"),
+		Vpcs:   []model.PrivateZoneVpc{vpc},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "/hostedzone/Z3NEWPRIVATE", tea.StringValue(resp.DomainId))
+	assert.Equal(t, "staging.internal", tea.StringValue(resp.Name))
+	create := f.lastRequest("CreateHostedZone").body
+	assert.Contains(t, create, "<Name>staging.internal</Name>")
+	assert.Contains(t, create, "<PrivateZone>true</PrivateZone>")
+	assert.Contains(t, create, "<VPCId>vpc-0a1b2c3d</VPCId>")
+
+	// 只有 AccountId 时只授权，由对方账号完成关联
+	err = s.AddZoneVpcAssociationWithContext(context.Background(), "aws", model.ZoneVpcAssociationRequest{
+		Domain: tea.String("corp.internal"),
+		Vpcs:   []model.PrivateZoneVpc{shared},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Z2PRIVATE", f.lastRequest("CreateVPCAssociationAuthorization").zoneId)
+	assert.Nil(t, f.lastRequest("AssociateVPCWithHostedZone"))
+
+	shared.Profile = tea.String("aws-shared")
+	before := len(f.operations())
+	err = s.AddZoneVpcAssociationWithContext(context.Background(), "aws", model.ZoneVpcAssociationRequest{
+		Domain: tea.String("corp.internal"),
+		Vpcs:   []model.PrivateZoneVpc{shared},
+	})
+	assert.Nil(t, err)
+	// 按名称查找私有域只需要一个请求
+	assert.Equal(t, []string{"ListHostedZonesByName", "CreateVPCAssociationAuthorization", "AssociateVPCWithHostedZone", "DeleteVPCAssociationAuthorization"},
+		f.operations()[before:])
+	assert.Contains(t, f.lastRequest("AssociateVPCWithHostedZone").body, "<VPCId>vpc-0shared</VPCId>")
+
+	err = s.RemoveZoneVpcAssociationWithContext(context.Background(), "aws", model.ZoneVpcAssociationRequest{
+		Domain: tea.String("corp.internal"),
+		Vpcs:   []model.PrivateZoneVpc{shared},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Z2PRIVATE", f.lastRequest("DisassociateVPCFromHostedZone").zoneId)
+
+	err = s.RemoveZoneVpcAssociationWithContext(context.Background(), "aws", model.ZoneVpcAssociationRequest{Domain: tea.String("corp.internal")})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "vpcs is required")
+
+	err = s.DeletePrivateZoneWithContext(context.Background(), "aws", model.DeletePrivateZoneRequest{Domain: tea.String("corp.internal")})
+	assert.Nil(t, err)
+	assert.Equal(t, "Z2PRIVATE", f.lastRequest("DeleteHostedZone").zoneId)
+}
+
+func TestAwsListRegionsAndZones(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	regions, err := s.ListRegionsWithContext(context.Background(), "aws")
+	assert.Nil(t, err)
+	assert.Len(t, regions, 2)
+	assert.Equal(t, "cn-northwest-1", regions[0].ID)
+	assert.Equal(t, "aws-cn", regions[0].Partition)
+	assert.Equal(t, "China (Ningxia)", regions[0].Name)
+	assert.Equal(t, model.AvailabilityAvailable, regions[0].State)
+	assert.Contains(t, f.lastRequest("DescribeRegions").body, "AllRegions=true")
+
+	zones, err := s.ListZonesWithContext(context.Background(), "aws", "cn-northwest-1")
+	assert.Nil(t, err)
+	assert.Len(t, zones, 2)
+	assert.Equal(t, model.Zone{
+		ID:     "cn-northwest-1b",
+		Name:   "cn-northwest-1b",
+		Region: "cn-northwest-1",
+		State:  model.AvailabilityImpaired,
+		Meta:   zones[1].Meta,
+	}, zones[1])
+
+	_, err = s.ListZonesWithContext(context.Background(), "aws", "")
+	assert.NotNil(t, err)
+}
+
+func TestAwsDescribeInstanceTypes(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	types, err := s.DescribeInstanceTypesWithContext(context.Background(), "aws", "cn-northwest-1", model.InstanceTypeFilter{})
+	assert.Nil(t, err)
+	assert.Len(t, types, 4)
+	m5 := types[0]
+	assert.Equal(t, "m5.large", m5.InstanceType)
+	assert.Equal(t, "m5", m5.Family)
+	assert.Equal(t, int64(2), m5.CPU)
+	assert.Equal(t, 8.0, m5.MemoryGiB)
+	assert.Equal(t, model.ArchitectureX86, m5.Architecture)
+	assert.Equal(t, 10.0, m5.NetworkBandwidthGbps)
+	assert.Equal(t, "Up to 10 Gigabit", m5.NetworkPerformance)
+	assert.True(t, m5.Available("cn-northwest-1b"))
+	assert.Equal(t, model.ArchitectureArm64, types[2].Architecture)
+	assert.Equal(t, int64(1), types[3].GPU)
+	assert.Equal(t, "NVIDIA T4", types[3].GPUModel)
+
+	// 可用区过滤传给 DescribeInstanceTypeOfferings，规格在本地过滤
+	types, err = s.DescribeInstanceTypesWithContext(context.Background(), "aws", "cn-northwest-1", model.InstanceTypeFilter{
+		Zone:         tea.String("cn-northwest-1a"),
+		Architecture: tea.String(model.ArchitectureX86),
+		GPU:          tea.Bool(false),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, types, 2)
+	assert.Contains(t, f.lastRequest("DescribeInstanceTypeOfferings").body, "Filter.1.Name=location")
+
+	// 腾讯云 S5.MEDIUM4 对应的 AWS 机型
+	spec := model.InstanceType{InstanceType: "S5.MEDIUM4", CPU: 2, MemoryGiB: 4, Architecture: model.ArchitectureX86}
+	closest, err := s.EquivalentInstanceTypeWithContext(context.Background(), "aws", "cn-northwest-1", spec, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "m5.large", closest.InstanceType)
+
+	spec.CPU = 64
+	_, err = s.EquivalentInstanceTypeWithContext(context.Background(), "aws", "cn-northwest-1", spec, "")
+	assert.True(t, errors.Is(err, model.ErrNotFound))
+}
+
+func TestAwsImages(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	ctx := context.Background()
+	private := model.ImagePrivate
+	images, err := s.DescribeImagesWithContext(ctx, "aws", "cn-northwest-1", model.DescribeImagesRequest{
+		Visibility: &private,
+		Name:       tea.String("golden-*"),
+		OSName:     tea.String("ubuntu"),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, images, 1)
+	image := images[0]
+	assert.Equal(t, "ami-0golden2204", tea.StringValue(image.ImageId))
+	assert.Equal(t, model.ImagePrivate, image.Visibility)
+	assert.Equal(t, model.ImageStateAvailable, image.State)
+	assert.Equal(t, "linux", tea.StringValue(image.Platform))
+	assert.Equal(t, int64(120), image.SizeGiB)
+	assert.Equal(t, "2024-05-01", image.CreatedTime.Format("2006-01-02"))
+	assert.Equal(t, "infra", (*image.Tags)[0].Value)
+	req := f.lastRequest("DescribeImages")
+	assert.Contains(t, req.body, "Owner.1=self")
+	assert.Contains(t, req.body, "Filter.1.Name=name")
+
+	image, err = s.DescribeImageWithContext(ctx, "aws", "cn-northwest-1", "ami-0win2022")
+	assert.Nil(t, err)
+	assert.Equal(t, "windows", tea.StringValue(image.Platform))
+	assert.Equal(t, model.ImageStatePending, image.State)
+	_, err = s.DescribeImageWithContext(ctx, "aws", "cn-northwest-1", "ami-0missing")
+	assert.True(t, errors.Is(err, model.ErrNotFound))
+
+	created, err := s.CreateImageWithContext(ctx, "aws", "cn-northwest-1", model.CreateImageRequest{
+		InstanceId: tea.String("i-0abc"),
+		Name:       tea.String("golden-ubuntu-2204-20240601"),
+		Tags:       model.Tags{{Key: "Team", Value: "infra"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "ami-0new", tea.StringValue(created.ImageId))
+	req = f.lastRequest("CreateImage")
+	assert.Contains(t, req.body, "NoReboot=false")
+	assert.Contains(t, req.body, "TagSpecification.2.ResourceType=snapshot")
+	_, err = s.CreateImageWithContext(ctx, "aws", "cn-northwest-1", model.CreateImageRequest{InstanceId: tea.String("i-0abc")})
+	assert.True(t, errors.Is(err, model.ErrInvalidInput))
+
+	// 没有指定名称时使用源镜像的名称
+	copied, err := s.CopyImageWithContext(ctx, "aws", "cn-northwest-1", model.CopyImageRequest{
+		ImageId:            tea.String("ami-0golden2204"),
+		DestinationRegions: []string{"cn-north-1"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []model.CopiedImage{{Region: "cn-north-1", ImageId: tea.String("ami-0new")}}, copied.Images)
+	req = f.lastRequest("CopyImage")
+	assert.Contains(t, req.body, "SourceRegion=cn-northwest-1")
+	assert.Contains(t, req.body, "Name=golden-ubuntu-2204-20240501")
+
+	err = s.ShareImageWithContext(ctx, "aws", "cn-northwest-1", model.ShareImageRequest{
+		ImageId:    tea.String("ami-0golden2204"),
+		AccountIds: []string{"210987654321"},
+	})
+	assert.Nil(t, err)
+	assert.Contains(t, f.lastRequest("ModifyImageAttribute").body, "LaunchPermission.Add.1.UserId=210987654321")
+
+	err = s.DeleteImageWithContext(ctx, "aws", "cn-northwest-1", model.DeleteImageRequest{ImageId: tea.String("ami-0golden2204"), DeleteSnapshots: true})
+	assert.Nil(t, err)
+	operations := f.operations()
+	assert.Equal(t, []string{"DescribeImages", "DeregisterImage", "DeleteSnapshot", "DeleteSnapshot"}, operations[len(operations)-4:])
+	assert.Contains(t, f.lastRequest("DeleteSnapshot").body, "SnapshotId=snap-0data")
+}
+
+func TestAwsKeyPairs(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	ctx := context.Background()
+	keys, err := s.DescribeKeyPairsWithContext(ctx, "aws", "cn-northwest-1", model.DescribeKeyPairsRequest{Name: tea.String("alice")})
+	assert.Nil(t, err)
+	assert.Len(t, keys, 1)
+	assert.Equal(t, "key-0alice", tea.StringValue(keys[0].KeyId))
+	assert.Equal(t, "2024-05-01", keys[0].CreatedTime.Format("2006-01-02"))
+	assert.Equal(t, "infra", (*keys[0].Tags)[0].Value)
+	req := f.lastRequest("DescribeKeyPairs")
+	assert.Contains(t, req.body, "KeyName.1=alice")
+	assert.Contains(t, req.body, "IncludePublicKey=true")
+
+	created, err := s.CreateKeyPairWithContext(ctx, "aws", "cn-northwest-1", model.CreateKeyPairRequest{
+		Name:    tea.String("deploy"),
+		KeyType: tea.String("ed25519"),
+		Tags:    model.Tags{{Key: "Team", Value: "infra"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "key-0deploy", tea.StringValue(created.KeyPair.KeyId))
+	assert.Contains(t, tea.StringValue(created.PrivateKey), "BEGIN RSA PRIVATE KEY")
+	req = f.lastRequest("CreateKeyPair")
+	assert.Contains(t, req.body, "KeyType=ed25519")
+	assert.Contains(t, req.body, "TagSpecification.1.ResourceType=key-pair")
+
+	publicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBob bob@example.com"
+	imported, err := s.ImportKeyPairWithContext(ctx, "aws", "cn-northwest-1", model.ImportKeyPairRequest{Name: tea.String("bob"), PublicKey: tea.String(publicKey)})
+	assert.Nil(t, err)
+	assert.Equal(t, "key-0bob", tea.StringValue(imported.KeyId))
+	assert.Equal(t, publicKey, tea.StringValue(imported.PublicKey))
+	_, err = s.ImportKeyPairWithContext(ctx, "aws", "cn-northwest-1", model.ImportKeyPairRequest{Name: tea.String("bob"), PublicKey: tea.String("not a key")})
+	assert.True(t, errors.Is(err, model.ErrInvalidInput))
+
+	err = s.DeleteKeyPairWithContext(ctx, "aws", "cn-northwest-1", model.DeleteKeyPairRequest{KeyId: tea.String("key-0bob")})
+	assert.Nil(t, err)
+	assert.Contains(t, f.lastRequest("DeleteKeyPair").body, "KeyPairId=key-0bob")
+
+	// AWS 只能在创建实例时指定密钥对
+	err = s.AssociateKeyPairsWithContext(ctx, "aws", "cn-northwest-1", model.KeyPairAssociationRequest{
+		KeyIds:      []*string{tea.String("key-0bob")},
+		InstanceIds: []*string{tea.String("i-0abc")},
+	})
+	assert.True(t, errors.Is(err, model.ErrNotImplemented))
+}
+
+func TestAwsVolumes(t *testing.T) {
+	s, f := newAwsFixtureService(t)
+	ctx := context.Background()
+	volumes, err := s.DescribeVolumesWithContext(ctx, "aws", "cn-northwest-1", model.DescribeVolumesRequest{InstanceId: tea.String("i-0abc")})
+	assert.Nil(t, err)
+	assert.Len(t, volumes, 1)
+	volume := volumes[0]
+	assert.Equal(t, "vol-0data", tea.StringValue(volume.VolumeId))
+	assert.Equal(t, "web-1-data", tea.StringValue(volume.Name))
+	assert.Equal(t, model.VolumeStateInUse, volume.State)
+	assert.Equal(t, int64(100), volume.SizeGiB)
+	assert.Equal(t, int64(3000), volume.Iops)
+	assert.True(t, volume.Encrypted)
+	assert.Equal(t, []model.VolumeAttachment{{InstanceId: tea.String("i-0abc"), Device: tea.String("/dev/sdf")}}, volume.Attachments)
+	assert.Contains(t, f.lastRequest("DescribeVolumes").body, "Filter.1.Name=attachment.instance-id")
+
+	created, err := s.CreateVolumeWithContext(ctx, "aws", "cn-northwest-1", model.CreateVolumeRequest{
+		Zone:      tea.String("cn-northwest-1a"),
+		SizeGiB:   200,
+		Type:      tea.String("io2"),
+		Iops:      tea.Int64(5000),
+		Encrypted: true,
+		Name:      tea.String("web-1-logs"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "vol-0new", tea.StringValue(created.VolumeId))
+	req := f.lastRequest("CreateVolume")
+	assert.Contains(t, req.body, "Iops=5000")
+	assert.Contains(t, req.body, "Encrypted=true")
+	assert.Contains(t, req.body, "TagSpecification.1.Tag.1.Value=web-1-logs")
+	_, err = s.CreateVolumeWithContext(ctx, "aws", "cn-northwest-1", model.CreateVolumeRequest{SizeGiB: 200})
+	assert.True(t, errors.Is(err, model.ErrInvalidInput))
+
+	// 不能缩容，扩容前先查询当前大小
+	err = s.ResizeVolumeWithContext(ctx, "aws", "cn-northwest-1", model.ResizeVolumeRequest{VolumeId: tea.String("vol-0data"), SizeGiB: 50})
+	assert.True(t, errors.Is(err, model.ErrInvalidInput))
+	err = s.ResizeVolumeWithContext(ctx, "aws", "cn-northwest-1", model.ResizeVolumeRequest{VolumeId: tea.String("vol-0data"), SizeGiB: 200})
+	assert.Nil(t, err)
+	assert.Contains(t, f.lastRequest("ModifyVolume").body, "Size=200")
+
+	// 没有指定设备名时选择第一个空闲的设备名
+	err = s.AttachVolumeWithContext(ctx, "aws", "cn-northwest-1", model.AttachVolumeRequest{VolumeId: tea.String("vol-0new"), InstanceId: tea.String("i-0abc")})
+	assert.Nil(t, err)
+	assert.Contains(t, f.lastRequest("AttachVolume").body, "Device=%2Fdev%2Fsdg")
+
+	err = s.DetachVolumeWithContext(ctx, "aws", "cn-northwest-1", model.DetachVolumeRequest{VolumeId: tea.String("vol-0new")})
+	assert.Nil(t, err)
+	assert.Contains(t, f.lastRequest("DetachVolume").body, "Force=false")
+	err = s.DeleteVolumeWithContext(ctx, "aws", "cn-northwest-1", model.DeleteVolumeRequest{VolumeId: tea.String("vol-0new")})
+	assert.Nil(t, err)
+	operations := f.operations()
+	assert.Equal(t, []string{"DescribeInstances", "AttachVolume", "DetachVolume", "DeleteVolume"}, operations[len(operations)-4:])
+}
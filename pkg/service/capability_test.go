@@ -0,0 +1,87 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/io"
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+	"github.com/xops-infra/multi-cloud-sdk/pkg/service"
+)
+
+func newCapabilityService() (model.CommonContract, map[model.Cloud]model.CloudIO) {
+	clientIo := io.NewCloudClient(nil)
+	providers := map[model.Cloud]model.CloudIO{
+		model.AWS:     io.NewAwsClient(clientIo),
+		model.TENCENT: io.NewTencentClient(clientIo),
+		model.ALIYUN:  io.NewAliyunClient(clientIo),
+	}
+	registry := service.NewRegistry()
+	for cloud, provider := range providers {
+		registry.Register(cloud, provider)
+	}
+	return service.NewCommonServiceWithRegistry(nil, registry), providers
+}
+
+func TestCapabilities(t *testing.T) {
+	s, _ := newCapabilityService()
+
+	aws, err := s.Capabilities(model.AWS)
+	assert.Nil(t, err)
+	assert.Equal(t, model.AWS, aws.Cloud)
+	assert.True(t, aws.Supports("DescribeInstances"))
+	assert.True(t, aws.Supports("DescribePrivateDomainList"))
+	assert.False(t, aws.Supports("CommonOCR"))
+	assert.Equal(t, len(model.CloudIOOperations()), len(aws.Supported)+len(aws.NotImplemented))
+
+	tencent, err := s.Capabilities(model.TENCENT)
+	assert.Nil(t, err)
+	assert.True(t, tencent.Supports("DescribePrivateDomainList"))
+	assert.False(t, tencent.Supports("GetBucketLifecycle"))
+	// D 监控的健康检查暂缓实现
+	for _, operation := range []string{"DescribeHealthChecks", "CreateHealthCheck", "ModifyHealthCheck", "DeleteHealthCheck", "DescribeHealthCheckStatus"} {
+		assert.True(t, aws.Supports(operation), operation)
+		assert.False(t, tencent.Supports(operation), operation)
+	}
+	// 腾讯云密钥对所有地域通用，aws 按地域隔离
+	assert.True(t, tencent.IsRegionless("ImportKeyPair"))
+	assert.False(t, aws.IsRegionless("ImportKeyPair"))
+	for _, operation := range tencent.Regionless {
+		assert.True(t, tencent.Supports(operation), operation)
+	}
+
+	_, err = s.Capabilities(model.Cloud("gcp"))
+	assert.True(t, errors.Is(err, model.ErrCloudNotSupported))
+}
+
+// 上报为未实现的操作必须是 CloudIO 的方法，并且调用时返回 ErrNotImplemented 而不是 panic
+func TestNotImplementedOperations(t *testing.T) {
+	s, providers := newCapabilityService()
+	for cloud, provider := range providers {
+		capabilities, err := s.Capabilities(cloud)
+		assert.Nil(t, err)
+
+		reporter, ok := provider.(model.NotImplementedReporter)
+		if !assert.True(t, ok, cloud) {
+			continue
+		}
+		assert.ElementsMatch(t, reporter.NotImplemented(), capabilities.NotImplemented, cloud)
+
+		value := reflect.ValueOf(provider)
+		for _, operation := range capabilities.NotImplemented {
+			method := value.MethodByName(operation)
+			args := []reflect.Value{reflect.ValueOf(context.Background())}
+			for i := 1; i < method.Type().NumIn(); i++ {
+				args = append(args, reflect.Zero(method.Type().In(i)))
+			}
+			out := method.Call(args)
+			err, _ := out[len(out)-1].Interface().(error)
+			assert.True(t, errors.Is(err, model.ErrNotImplemented), "%s %s: %v", cloud, operation, err)
+			assert.True(t, errors.Is(err, model.ErrUnsupported), "%s %s: %v", cloud, operation, err)
+		}
+	}
+}
@@ -1,21 +1,66 @@
 package service
 
-import "github.com/xops-infra/multi-cloud-sdk/pkg/model"
+import (
+	"fmt"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
 
 type CommonService struct {
-	Profiles     map[string]model.ProfileConfig
-	Aws, Tencent model.CloudIO
+	Profiles map[string]model.ProfileConfig
+	Registry *Registry
 }
 
+// NewCommonService 兼容原有的 aws/tencent 构造方式，内部注册到 Registry；阿里云等其他云使用 NewCommonServiceWithRegistry
 func NewCommonService(profiles []model.ProfileConfig, aws, tencent model.CloudIO) model.CommonContract {
+	registry := NewRegistry()
+	registry.Register(model.AWS, aws)
+	registry.Register(model.TENCENT, tencent)
+	return NewCommonServiceWithRegistry(profiles, registry)
+}
+
+// NewCommonServiceWithRegistry 使用自定义的 Registry，可以接入任意实现了 model.CloudIO 的云
+func NewCommonServiceWithRegistry(profiles []model.ProfileConfig, registry *Registry) model.CommonContract {
 	_profiles := make(map[string]model.ProfileConfig)
 	for _, p := range profiles {
 		_profiles[p.Name] = p
-
+	}
+	if registry == nil {
+		registry = NewRegistry()
 	}
 	return &CommonService{
 		Profiles: _profiles,
-		Aws:      aws,
-		Tencent:  tencent,
+		Registry: registry,
+	}
+}
+
+// getProvider 根据 profile 找到对应云的实现，profile 不存在或者云未注册都在这里统一返回错误
+func (s *CommonService) getProvider(profile string) (model.CloudIO, error) {
+	p, ok := s.Profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("%s %w", profile, model.ErrProfileNotFound)
+	}
+	provider, ok := s.Registry.Get(p.Cloud)
+	if !ok {
+		return nil, fmt.Errorf("%s %w", profile, model.ErrCloudNotSupported)
+	}
+	return provider, nil
+}
+
+// getCloudProvider 不依赖 profile，直接按云查找实现，用于 AKSK 等直接传凭证的场景
+func (s *CommonService) getCloudProvider(cloud model.Cloud) (model.CloudIO, error) {
+	provider, ok := s.Registry.Get(cloud)
+	if !ok {
+		return nil, fmt.Errorf("%s %w", cloud, model.ErrCloudNotSupported)
+	}
+	return provider, nil
+}
+
+// Capabilities 按 Registry 中注册的实现计算支持的操作
+func (s *CommonService) Capabilities(cloud model.Cloud) (model.Capabilities, error) {
+	provider, err := s.getCloudProvider(cloud)
+	if err != nil {
+		return model.Capabilities{}, err
 	}
+	return model.NewCapabilities(cloud, provider), nil
 }
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// 兼容旧的无 context 调用方式，等价于传入 context.Background()，新代码请直接使用 WithContext 方法。
+
+func (s *CommonService) QueryOcr(profile, region string, input model.OcrRequest) (model.OcrResponse, error) {
+	return s.QueryOcrWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) CreatePicture(profile, region string, input model.CreatePictureRequest) (model.CreatePictureResponse, error) {
+	return s.CreatePictureWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) GetPictureByName(profile, region string, input model.CommonPictureRequest) (model.GetPictureByNameResponse, error) {
+	return s.GetPictureByNameWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) QueryPicture(profile, region string, input model.QueryPictureRequest) (model.QueryPictureResponse, error) {
+	return s.QueryPictureWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) DeletePicture(profile, region string, input model.CommonPictureRequest) (model.CommonPictureResponse, error) {
+	return s.DeletePictureWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) UpdatePicture(profile, region string, input model.UpdatePictureRequest) (model.CommonPictureResponse, error) {
+	return s.UpdatePictureWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) SearchPicture(profile, region string, input model.SearchPictureRequest) (model.SearchPictureResponse, error) {
+	return s.SearchPictureWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) PrivateDomainList(profile string, req model.DescribeDomainListRequest) (model.DescribePrivateDomainListResponse, error) {
+	return s.PrivateDomainListWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) PrivateRecordList(profile string, req model.DescribePrivateRecordListRequest) (model.DescribePrivateRecordListResponse, error) {
+	return s.PrivateRecordListWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) PrivateRecordListWithPages(profile string, req model.DescribePrivateDnsRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
+	return s.PrivateRecordListWithPagesWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) PrivateCreateRecord(profile string, req model.CreateRecordRequest) (model.CreateRecordResponse, error) {
+	return s.PrivateCreateRecordWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) PrivateModifyRecord(profile string, req model.ModifyRecordRequest) error {
+	return s.PrivateModifyRecordWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) PrivateDeleteRecord(profile string, req model.DeletePrivateRecordRequest) error {
+	return s.PrivateDeleteRecordWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) PrivateChangeRecordSet(profile string, req model.ChangeRecordSetRequest) (model.ChangeRecordSetResponse, error) {
+	return s.PrivateChangeRecordSetWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) CreatePrivateZone(profile string, req model.CreatePrivateZoneRequest) (model.CreatePrivateZoneResponse, error) {
+	return s.CreatePrivateZoneWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) DeletePrivateZone(profile string, req model.DeletePrivateZoneRequest) error {
+	return s.DeletePrivateZoneWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) AddZoneVpcAssociation(profile string, req model.ZoneVpcAssociationRequest) error {
+	return s.AddZoneVpcAssociationWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) RemoveZoneVpcAssociation(profile string, req model.ZoneVpcAssociationRequest) error {
+	return s.RemoveZoneVpcAssociationWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) DescribeDomainList(profile, region string, req model.DescribeDomainListRequest) (model.DescribeDomainListResponse, error) {
+	return s.DescribeDomainListWithContext(context.Background(), profile, region, req)
+}
+
+func (s *CommonService) DescribeRecordList(profile, region string, req model.DescribeRecordListRequest) (model.DescribeRecordListResponse, error) {
+	return s.DescribeRecordListWithContext(context.Background(), profile, region, req)
+}
+
+func (s *CommonService) DescribeRecordLineList(profile, region string, req model.DescribeRecordLineListRequest) (model.DescribeRecordLineListResponse, error) {
+	return s.DescribeRecordLineListWithContext(context.Background(), profile, region, req)
+}
+
+func (s *CommonService) DescribeRecordListWithPages(profile, region string, req model.DescribeRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
+	return s.DescribeRecordListWithPagesWithContext(context.Background(), profile, region, req)
+}
+
+func (s *CommonService) DescribeRecord(profile, region string, req model.DescribeRecordRequest) (model.Record, error) {
+	return s.DescribeRecordWithContext(context.Background(), profile, region, req)
+}
+
+func (s *CommonService) CreateRecord(profile, region string, req model.CreateRecordRequest) (model.CreateRecordResponse, error) {
+	return s.CreateRecordWithContext(context.Background(), profile, region, req)
+}
+
+func (s *CommonService) ModifyRecord(profile, region string, ignoreType bool, req model.ModifyRecordRequest) error {
+	return s.ModifyRecordWithContext(context.Background(), profile, region, ignoreType, req)
+}
+
+func (s *CommonService) DeleteRecord(profile, region string, req model.DeleteRecordRequest) (model.CommonDnsResponse, error) {
+	return s.DeleteRecordWithContext(context.Background(), profile, region, req)
+}
+
+func (s *CommonService) ChangeRecordSet(profile, region string, req model.ChangeRecordSetRequest) (model.ChangeRecordSetResponse, error) {
+	return s.ChangeRecordSetWithContext(context.Background(), profile, region, req)
+}
+
+func (s *CommonService) ExportZone(profile, region string, req model.ExportZoneRequest) (model.ZoneFile, error) {
+	return s.ExportZoneWithContext(context.Background(), profile, region, req)
+}
+
+func (s *CommonService) ImportZone(profile, region string, req model.ImportZoneRequest) (model.ImportZoneResponse, error) {
+	return s.ImportZoneWithContext(context.Background(), profile, region, req)
+}
+
+func (s *CommonService) PlanDnsZone(profile, region string, spec model.DnsZoneSpec) (model.DnsPlan, error) {
+	return s.PlanDnsZoneWithContext(context.Background(), profile, region, spec)
+}
+
+func (s *CommonService) ApplyDnsPlan(profile, region string, plan model.DnsPlan) (model.ApplyDnsPlanResponse, error) {
+	return s.ApplyDnsPlanWithContext(context.Background(), profile, region, plan)
+}
+
+func (s *CommonService) WaitForRecordChange(profile, region string, req model.WaitForRecordChangeRequest) (model.WaitForRecordChangeResponse, error) {
+	return s.WaitForRecordChangeWithContext(context.Background(), profile, region, req)
+}
+
+func (s *CommonService) DescribeHealthChecks(profile string) ([]model.HealthCheck, error) {
+	return s.DescribeHealthChecksWithContext(context.Background(), profile)
+}
+
+func (s *CommonService) CreateHealthCheck(profile string, req model.CreateHealthCheckRequest) (model.CreateHealthCheckResponse, error) {
+	return s.CreateHealthCheckWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) ModifyHealthCheck(profile string, req model.ModifyHealthCheckRequest) error {
+	return s.ModifyHealthCheckWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) DeleteHealthCheck(profile, id string) error {
+	return s.DeleteHealthCheckWithContext(context.Background(), profile, id)
+}
+
+func (s *CommonService) DescribeHealthCheckStatus(profile, id string) (model.HealthCheckStatus, error) {
+	return s.DescribeHealthCheckStatusWithContext(context.Background(), profile, id)
+}
+
+func (s *CommonService) DescribeRegisteredDomains(profile string, req model.DescribeRegisteredDomainsRequest) ([]model.RegisteredDomain, error) {
+	return s.DescribeRegisteredDomainsWithContext(context.Background(), profile, req)
+}
+
+func (s *CommonService) DescribeRegisteredDomain(profile, domain string) (model.RegisteredDomain, error) {
+	return s.DescribeRegisteredDomainWithContext(context.Background(), profile, domain)
+}
+
+func (s *CommonService) DomainExpiryReport(req model.DomainExpiryReportRequest) (model.DomainExpiryReport, error) {
+	return s.DomainExpiryReportWithContext(context.Background(), req)
+}
+
+func (s *CommonService) DescribeEmrCluster(input model.DescribeInput) ([]model.DescribeEmrCluster, error) {
+	return s.DescribeEmrClusterWithContext(context.Background(), input)
+}
+
+func (s *CommonService) QueryEmrCluster(filter model.EmrFilter) (model.FilterEmrResponse, error) {
+	return s.QueryEmrClusterWithContext(context.Background(), filter)
+}
+
+func (s *CommonService) DescribeInstances(profile, region string, input model.InstanceFilter) (model.InstanceResponse, error) {
+	return s.DescribeInstancesWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) CreateInstance(profile, region string, input model.CreateInstanceInput) (model.CreateInstanceResponse, error) {
+	return s.CreateInstanceWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) ModifyInstance(profile, region string, input model.ModifyInstanceInput) (model.ModifyInstanceResponse, error) {
+	return s.ModifyInstanceWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) DeleteInstance(profile, region string, input model.DeleteInstanceInput) (model.DeleteInstanceResponse, error) {
+	return s.DeleteInstanceWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) WaitForInstanceStatus(profile, region string, ids []*string, target model.InstanceStatus, opts model.WaitOptions) ([]model.Instance, error) {
+	return s.WaitForInstanceStatusWithContext(context.Background(), profile, region, ids, target, opts)
+}
+
+func (s *CommonService) ListRegions(profile string) ([]model.Region, error) {
+	return s.ListRegionsWithContext(context.Background(), profile)
+}
+
+func (s *CommonService) ListZones(profile, region string) ([]model.Zone, error) {
+	return s.ListZonesWithContext(context.Background(), profile, region)
+}
+
+func (s *CommonService) DescribeInstanceTypes(profile, region string, filter model.InstanceTypeFilter) ([]model.InstanceType, error) {
+	return s.DescribeInstanceTypesWithContext(context.Background(), profile, region, filter)
+}
+
+func (s *CommonService) DescribeImages(profile, region string, input model.DescribeImagesRequest) ([]model.Image, error) {
+	return s.DescribeImagesWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) DescribeImage(profile, region, imageId string) (model.Image, error) {
+	return s.DescribeImageWithContext(context.Background(), profile, region, imageId)
+}
+
+func (s *CommonService) CreateImage(profile, region string, input model.CreateImageRequest) (model.CreateImageResponse, error) {
+	return s.CreateImageWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) CopyImage(profile, region string, input model.CopyImageRequest) (model.CopyImageResponse, error) {
+	return s.CopyImageWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) ShareImage(profile, region string, input model.ShareImageRequest) error {
+	return s.ShareImageWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) DeleteImage(profile, region string, input model.DeleteImageRequest) error {
+	return s.DeleteImageWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) DescribeKeyPairs(profile, region string, input model.DescribeKeyPairsRequest) ([]model.KeyPair, error) {
+	return s.DescribeKeyPairsWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) CreateKeyPair(profile, region string, input model.CreateKeyPairRequest) (model.CreateKeyPairResponse, error) {
+	return s.CreateKeyPairWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) ImportKeyPair(profile, region string, input model.ImportKeyPairRequest) (model.KeyPair, error) {
+	return s.ImportKeyPairWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) DeleteKeyPair(profile, region string, input model.DeleteKeyPairRequest) error {
+	return s.DeleteKeyPairWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) AssociateKeyPairs(profile, region string, input model.KeyPairAssociationRequest) error {
+	return s.AssociateKeyPairsWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) DisassociateKeyPairs(profile, region string, input model.KeyPairAssociationRequest) error {
+	return s.DisassociateKeyPairsWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) DescribeVolumes(profile, region string, input model.DescribeVolumesRequest) ([]model.Volume, error) {
+	return s.DescribeVolumesWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) DescribeVolume(profile, region, volumeId string) (model.Volume, error) {
+	return s.DescribeVolumeWithContext(context.Background(), profile, region, volumeId)
+}
+
+func (s *CommonService) CreateVolume(profile, region string, input model.CreateVolumeRequest) (model.CreateVolumeResponse, error) {
+	return s.CreateVolumeWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) ResizeVolume(profile, region string, input model.ResizeVolumeRequest) error {
+	return s.ResizeVolumeWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) AttachVolume(profile, region string, input model.AttachVolumeRequest) error {
+	return s.AttachVolumeWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) DetachVolume(profile, region string, input model.DetachVolumeRequest) error {
+	return s.DetachVolumeWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) DeleteVolume(profile, region string, input model.DeleteVolumeRequest) error {
+	return s.DeleteVolumeWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) QueryVPCs(profile, region string, input model.CommonFilter) ([]model.VPC, error) {
+	return s.QueryVPCsWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) QuerySubnets(profile, region string, input model.CommonFilter) ([]model.Subnet, error) {
+	return s.QuerySubnetsWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) QueryEIPs(profile, region string, input model.CommonFilter) ([]model.EIP, error) {
+	return s.QueryEIPsWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) QueryNATs(profile, region string, input model.CommonFilter) ([]model.NAT, error) {
+	return s.QueryNATsWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) CreateBucket(profile, region string, input model.CreateBucketRequest) error {
+	return s.CreateBucketWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) DeleteBucket(profile, region string, input model.DeleteBucketRequest) (model.DeleteBucketResponse, error) {
+	return s.DeleteBucketWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) ListBuckets(profile, region string, input model.ListBucketRequest) (model.ListBucketResponse, error) {
+	return s.ListBucketsWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) CreateBucketLifecycle(profile, region string, input model.CreateBucketLifecycleRequest) error {
+	return s.CreateBucketLifecycleWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) GetBucketLifecycle(profile, region string, input model.GetBucketLifecycleRequest) (model.GetBucketLifecycleResponse, error) {
+	return s.GetBucketLifecycleWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) GetObjectPregisn(profile, region string, input model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
+	return s.GetObjectPregisnWithContext(context.Background(), profile, region, input)
+}
+
+func (s *CommonService) GetObjectPregisnWithAKSK(cloud model.Cloud, ak, sk, region string, input model.ObjectPregisnRequest) (model.ObjectPregisnResponse, error) {
+	return s.GetObjectPregisnWithAKSKWithContext(context.Background(), cloud, ak, sk, region, input)
+}
@@ -1,212 +1,202 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
-// PrivateDomainList
-func (s *CommonService) PrivateDomainList(profile string, req model.DescribeDomainListRequest) (model.DescribePrivateDomainListResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.DescribePrivateDomainList(profile, req)
-		case model.TENCENT:
-			return s.Tencent.DescribePrivateDomainList(profile, req)
-		default:
-			return model.DescribePrivateDomainListResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// PrivateDomainListWithContext
+func (s *CommonService) PrivateDomainListWithContext(ctx context.Context, profile string, req model.DescribeDomainListRequest) (model.DescribePrivateDomainListResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.DescribePrivateDomainListResponse{}, err
 	}
-	return model.DescribePrivateDomainListResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.DescribePrivateDomainList(ctx, profile, req)
 }
 
-// PrivateRecordList
-func (s *CommonService) PrivateRecordList(profile string, req model.DescribePrivateRecordListRequest) (model.DescribePrivateRecordListResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.DescribePrivateRecordList(profile, req)
-		case model.TENCENT:
-			return s.Tencent.DescribePrivateRecordList(profile, req)
-		default:
-			return model.DescribePrivateRecordListResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// PrivateRecordListWithContext
+func (s *CommonService) PrivateRecordListWithContext(ctx context.Context, profile string, req model.DescribePrivateRecordListRequest) (model.DescribePrivateRecordListResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.DescribePrivateRecordListResponse{}, err
 	}
-	return model.DescribePrivateRecordListResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.DescribePrivateRecordList(ctx, profile, req)
 }
 
-// PrivateRecordListWithPages
-func (s *CommonService) PrivateRecordListWithPages(profile string, req model.DescribePrivateDnsRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.DescribePrivateRecordListWithPages(profile, req)
-		case model.TENCENT:
-			return s.Tencent.DescribePrivateRecordListWithPages(profile, req)
-		default:
-			return model.ListRecordsPageResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// PrivateRecordListWithPagesWithContext
+func (s *CommonService) PrivateRecordListWithPagesWithContext(ctx context.Context, profile string, req model.DescribePrivateDnsRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.ListRecordsPageResponse{}, err
 	}
-	return model.ListRecordsPageResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.DescribePrivateRecordListWithPages(ctx, profile, req)
+}
 
+// PrivateCreateRecordWithContext
+func (s *CommonService) PrivateCreateRecordWithContext(ctx context.Context, profile string, request model.CreateRecordRequest) (model.CreateRecordResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.CreateRecordResponse{}, err
+	}
+	return provider.CreatePrivateRecord(ctx, profile, request)
 }
 
-// PrivateCreateRecord
-func (s *CommonService) PrivateCreateRecord(profile string, request model.CreateRecordRequest) (model.CreateRecordResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.CreatePrivateRecord(profile, request)
-		case model.TENCENT:
-			return s.Tencent.CreatePrivateRecord(profile, request)
-		default:
-			return model.CreateRecordResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// PrivateModifyRecordWithContext
+func (s *CommonService) PrivateModifyRecordWithContext(ctx context.Context, profile string, request model.ModifyRecordRequest) error {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
 	}
-	return model.CreateRecordResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.ModifyPrivateRecord(ctx, profile, request)
 }
 
-// PrivateModifyRecord
-func (s *CommonService) PrivateModifyRecord(profile string, request model.ModifyRecordRequest) error {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.ModifyPrivateRecord(profile, request)
-		case model.TENCENT:
-			return s.Tencent.ModifyPrivateRecord(profile, request)
-		default:
-			return fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// PrivateDeleteRecordWithContext
+func (s *CommonService) PrivateDeleteRecordWithContext(ctx context.Context, profile string, request model.DeletePrivateRecordRequest) error {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
 	}
-	return fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.DeletePrivateRecord(ctx, profile, request)
 }
 
-// PrivateDeleteRecord
-func (s *CommonService) PrivateDeleteRecord(profile string, request model.DeletePrivateRecordRequest) error {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.DeletePrivateRecord(profile, request)
-		case model.TENCENT:
-			return s.Tencent.DeletePrivateRecord(profile, request)
-		default:
-			return fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// CreatePrivateZoneWithContext
+func (s *CommonService) CreatePrivateZoneWithContext(ctx context.Context, profile string, req model.CreatePrivateZoneRequest) (model.CreatePrivateZoneResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.CreatePrivateZoneResponse{}, err
 	}
-	return fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.CreatePrivateZone(ctx, profile, req)
 }
 
-// DescribeDomainList
-func (s *CommonService) DescribeDomainList(profile, region string, req model.DescribeDomainListRequest) (model.DescribeDomainListResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.DescribeDomainList(profile, region, req)
-		case model.TENCENT:
-			return s.Tencent.DescribeDomainList(profile, region, req)
-		default:
-			return model.DescribeDomainListResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// DeletePrivateZoneWithContext
+func (s *CommonService) DeletePrivateZoneWithContext(ctx context.Context, profile string, req model.DeletePrivateZoneRequest) error {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
 	}
-	return model.DescribeDomainListResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.DeletePrivateZone(ctx, profile, req)
 }
 
-// DescribeRecordList
-func (s *CommonService) DescribeRecordList(profile, region string, req model.DescribeRecordListRequest) (model.DescribeRecordListResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.DescribeRecordList(profile, region, req)
-		case model.TENCENT:
-			return s.Tencent.DescribeRecordList(profile, region, req)
-		default:
-			return model.DescribeRecordListResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// AddZoneVpcAssociationWithContext
+func (s *CommonService) AddZoneVpcAssociationWithContext(ctx context.Context, profile string, req model.ZoneVpcAssociationRequest) error {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
 	}
-	return model.DescribeRecordListResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.AddZoneVpcAssociation(ctx, profile, req)
 }
 
-// DescribeRecordListWithPages
-func (s *CommonService) DescribeRecordListWithPages(profile, region string, req model.DescribeRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.DescribeRecordListWithPages(profile, region, req)
-		case model.TENCENT:
-			return s.Tencent.DescribeRecordListWithPages(profile, region, req)
-		default:
-			return model.ListRecordsPageResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// RemoveZoneVpcAssociationWithContext
+func (s *CommonService) RemoveZoneVpcAssociationWithContext(ctx context.Context, profile string, req model.ZoneVpcAssociationRequest) error {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
 	}
+	return provider.RemoveZoneVpcAssociation(ctx, profile, req)
+}
 
-	return model.ListRecordsPageResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
-}
-
-// DescribeRecord
-func (s *CommonService) DescribeRecord(profile, region string, req model.DescribeRecordRequest) (model.Record, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.DescribeRecord(profile, region, req)
-		case model.TENCENT:
-			resp, err := s.Tencent.DescribeRecord(profile, region, req)
-			if err != nil {
-				if strings.Contains(err.Error(), "ResourceNotFound") {
-					return model.Record{}, nil
-				}
-			}
-			return resp, err
-		default:
-			return model.Record{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// DescribeDomainListWithContext
+func (s *CommonService) DescribeDomainListWithContext(ctx context.Context, profile, region string, req model.DescribeDomainListRequest) (model.DescribeDomainListResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.DescribeDomainListResponse{}, err
 	}
-	return model.Record{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.DescribeDomainList(ctx, profile, region, req)
 }
 
-// CreateRecord
-func (s *CommonService) CreateRecord(profile, region string, request model.CreateRecordRequest) (model.CreateRecordResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.CreateRecord(profile, region, request)
-		case model.TENCENT:
-			return s.Tencent.CreateRecord(profile, region, request)
-		default:
-			return model.CreateRecordResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// DescribeRecordListWithContext
+func (s *CommonService) DescribeRecordListWithContext(ctx context.Context, profile, region string, req model.DescribeRecordListRequest) (model.DescribeRecordListResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.DescribeRecordListResponse{}, err
+	}
+	resp, err := provider.DescribeRecordList(ctx, profile, region, req)
+	if err != nil {
+		return resp, err
+	}
+	resp.LineGroups = model.GroupRecordsByLine(resp.RecordList)
+	return resp, nil
+}
+
+// DescribeRecordLineListWithContext 返回的 LineId 用于创建记录的 RecordLineId
+func (s *CommonService) DescribeRecordLineListWithContext(ctx context.Context, profile, region string, req model.DescribeRecordLineListRequest) (model.DescribeRecordLineListResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.DescribeRecordLineListResponse{}, err
 	}
-	return model.CreateRecordResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.DescribeRecordLineList(ctx, profile, region, req)
 }
 
-func (s *CommonService) ModifyRecord(profile, region string, ignoreType bool, request model.ModifyRecordRequest) error {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.ModifyRecord(profile, region, ignoreType, request)
-		case model.TENCENT:
-			return s.Tencent.ModifyRecord(profile, region, ignoreType, request)
-		default:
-			return fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
+// DescribeRecordListWithPagesWithContext
+func (s *CommonService) DescribeRecordListWithPagesWithContext(ctx context.Context, profile, region string, req model.DescribeRecordListWithPageRequest) (model.ListRecordsPageResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.ListRecordsPageResponse{}, err
+	}
+	return provider.DescribeRecordListWithPages(ctx, profile, region, req)
+}
+
+// DescribeRecordWithContext
+func (s *CommonService) DescribeRecordWithContext(ctx context.Context, profile, region string, req model.DescribeRecordRequest) (model.Record, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.Record{}, err
+	}
+	resp, err := provider.DescribeRecord(ctx, profile, region, req)
+	if err != nil {
+		// 记录不存在时返回空记录
+		if errors.Is(err, model.ErrRecordNotFound) {
+			return model.Record{}, nil
 		}
 	}
-	return fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return resp, err
 }
 
-func (s *CommonService) DeleteRecord(profile, region string, request model.DeleteRecordRequest) (model.CommonDnsResponse, error) {
+// CreateRecordWithContext
+func (s *CommonService) CreateRecordWithContext(ctx context.Context, profile, region string, request model.CreateRecordRequest) (model.CreateRecordResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.CreateRecordResponse{}, err
+	}
+	return provider.CreateRecord(ctx, profile, region, request)
+}
+
+func (s *CommonService) ModifyRecordWithContext(ctx context.Context, profile, region string, ignoreType bool, request model.ModifyRecordRequest) error {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
+	}
+	return provider.ModifyRecord(ctx, profile, region, ignoreType, request)
+}
+
+func (s *CommonService) DeleteRecordWithContext(ctx context.Context, profile, region string, request model.DeleteRecordRequest) (model.CommonDnsResponse, error) {
 	if request.Domain == nil || request.SubDomain == nil || request.RecordType == nil {
 		return model.CommonDnsResponse{}, fmt.Errorf("domain, subDomain, recordType is required")
 	}
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.DeleteRecord(profile, region, request)
-		case model.TENCENT:
-			return s.Tencent.DeleteRecord(profile, region, request)
-		default:
-			return model.CommonDnsResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.CommonDnsResponse{}, err
+	}
+	return provider.DeleteRecord(ctx, profile, region, request)
+}
+
+// ChangeRecordSetWithContext 一次提交多个记录变更
+func (s *CommonService) ChangeRecordSetWithContext(ctx context.Context, profile, region string, req model.ChangeRecordSetRequest) (model.ChangeRecordSetResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.ChangeRecordSetResponse{}, err
+	}
+	return provider.ChangeRecordSet(ctx, profile, region, req)
+}
+
+// PrivateChangeRecordSetWithContext
+func (s *CommonService) PrivateChangeRecordSetWithContext(ctx context.Context, profile string, req model.ChangeRecordSetRequest) (model.ChangeRecordSetResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.ChangeRecordSetResponse{}, err
 	}
-	return model.CommonDnsResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.ChangePrivateRecordSet(ctx, profile, req)
 }
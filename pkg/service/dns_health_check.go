@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// DescribeHealthChecksWithContext
+func (s *CommonService) DescribeHealthChecksWithContext(ctx context.Context, profile string) ([]model.HealthCheck, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return nil, err
+	}
+	return provider.DescribeHealthChecks(ctx, profile)
+}
+
+// CreateHealthCheckWithContext 返回的 Id 设置到记录的 RoutingPolicy.HealthCheckId 即可关联
+func (s *CommonService) CreateHealthCheckWithContext(ctx context.Context, profile string, req model.CreateHealthCheckRequest) (model.CreateHealthCheckResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.CreateHealthCheckResponse{}, err
+	}
+	return provider.CreateHealthCheck(ctx, profile, req)
+}
+
+// ModifyHealthCheckWithContext
+func (s *CommonService) ModifyHealthCheckWithContext(ctx context.Context, profile string, req model.ModifyHealthCheckRequest) error {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
+	}
+	return provider.ModifyHealthCheck(ctx, profile, req)
+}
+
+// DeleteHealthCheckWithContext 还有记录关联时 aws 会拒绝删除
+func (s *CommonService) DeleteHealthCheckWithContext(ctx context.Context, profile, id string) error {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
+	}
+	return provider.DeleteHealthCheck(ctx, profile, id)
+}
+
+// DescribeHealthCheckStatusWithContext
+func (s *CommonService) DescribeHealthCheckStatusWithContext(ctx context.Context, profile, id string) (model.HealthCheckStatus, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.HealthCheckStatus{}, err
+	}
+	return provider.DescribeHealthCheckStatus(ctx, profile, id)
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/spf13/cast"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// PlanDnsZoneWithContext 对比期望的记录和线上记录，返回需要执行的变更，不会修改任何记录
+func (s *CommonService) PlanDnsZoneWithContext(ctx context.Context, profile, region string, spec model.DnsZoneSpec) (model.DnsPlan, error) {
+	if err := spec.Validate(); err != nil {
+		return model.DnsPlan{}, err
+	}
+	p, ok := s.Profiles[profile]
+	if !ok {
+		return model.DnsPlan{}, fmt.Errorf("%s %w", profile, model.ErrProfileNotFound)
+	}
+	live, err := s.listZoneRecords(ctx, profile, region, spec.Domain, spec.Private)
+	if err != nil {
+		return model.DnsPlan{}, err
+	}
+	return model.NewDnsPlan(p.Cloud, spec, live)
+}
+
+// ApplyDnsPlanWithContext 按顺序执行 plan 中的变更，遇到第一个失败就停止，失败的在 Failed 中返回，没有执行的在 Skipped 中返回
+func (s *CommonService) ApplyDnsPlanWithContext(ctx context.Context, profile, region string, plan model.DnsPlan) (model.ApplyDnsPlanResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.ApplyDnsPlanResponse{}, err
+	}
+	cloud := s.Profiles[profile].Cloud
+	var resp model.ApplyDnsPlanResponse
+	for i, change := range plan.Changes {
+		if err := ctx.Err(); err != nil {
+			resp.Skipped = append(resp.Skipped, plan.Changes[i:]...)
+			return resp, err
+		}
+		if partial, err := applyDnsChange(ctx, provider, cloud, profile, region, plan, change); err != nil {
+			resp.Failed = append(resp.Failed, model.DnsChangeFailed{Change: change, Error: err.Error(), PartiallyApplied: partial})
+			resp.Skipped = append(resp.Skipped, plan.Changes[i+1:]...)
+			break
+		}
+		resp.Applied = append(resp.Applied, change)
+	}
+	return resp, nil
+}
+
+// applyDnsChange partial 为 true 表示旧记录已经删除但是新记录没有创建成功
+func applyDnsChange(ctx context.Context, provider model.CloudIO, cloud model.Cloud, profile, region string, plan model.DnsPlan, change model.DnsChange) (partial bool, err error) {
+	domain := tea.String(plan.Domain)
+	switch change.Action {
+	case model.DnsChangeCreate:
+		return false, createDnsPlanRecord(ctx, provider, profile, region, plan, *change.After)
+	case model.DnsChangeUpdate:
+		after := change.After
+		// aws 按记录集整体 UPSERT；其他云一条记录一个值，只有单值改单值时可以直接修改，否则删除后重建
+		if cloud == model.AWS || (len(change.Before) == 1 && len(after.Values) == 1) {
+			before := change.Before[0]
+			input := model.ModifyRecordRequest{
+				Domain:        domain,
+				SubDomain:     before.SubDomain,
+				RecordType:    after.RecordType,
+				Value:         after.Value,
+				Values:        after.Values,
+				TTL:           after.TTL,
+				Weight:        after.Weight,
+				RecordLine:    before.RecordLine,
+				RecordLineId:  after.RecordLineId,
+				SetIdentifier: after.SetIdentifier,
+				RoutingPolicy: after.RoutingPolicy,
+				AliasTarget:   after.AliasTarget,
+			}
+			if plan.Private {
+				// 腾讯云私有域按 RecordId 修改
+				if recordId, err := cast.ToUint64E(tea.StringValue(before.RecordId)); err == nil {
+					input.RecordId = tea.Uint64(recordId)
+				}
+				return false, provider.ModifyPrivateRecord(ctx, profile, input)
+			}
+			return false, provider.ModifyRecord(ctx, profile, region, false, input)
+		}
+		if err := deleteDnsPlanRecords(ctx, provider, profile, region, plan, change.Before); err != nil {
+			return false, err
+		}
+		if err := createDnsPlanRecord(ctx, provider, profile, region, plan, *after); err != nil {
+			return true, fmt.Errorf("records deleted but not recreated: %w", err)
+		}
+		return false, nil
+	case model.DnsChangeDelete:
+		return false, deleteDnsPlanRecords(ctx, provider, profile, region, plan, change.Before)
+	}
+	return false, fmt.Errorf("unknown dns change action %s", change.Action)
+}
+
+func createDnsPlanRecord(ctx context.Context, provider model.CloudIO, profile, region string, plan model.DnsPlan, record model.Record) error {
+	input := model.CreateRecordRequest{
+		Domain:        tea.String(plan.Domain),
+		SubDomain:     record.SubDomain,
+		RecordType:    record.RecordType,
+		Value:         record.Value,
+		Values:        record.Values,
+		TTL:           record.TTL,
+		Weight:        record.Weight,
+		RecordLine:    record.RecordLine,
+		RecordLineId:  record.RecordLineId,
+		SetIdentifier: record.SetIdentifier,
+		RoutingPolicy: record.RoutingPolicy,
+		AliasTarget:   record.AliasTarget,
+	}
+	var err error
+	if plan.Private {
+		_, err = provider.CreatePrivateRecord(ctx, profile, input)
+	} else {
+		_, err = provider.CreateRecord(ctx, profile, region, input)
+	}
+	return err
+}
+
+// deleteDnsPlanRecords records 为同一组的线上记录
+func deleteDnsPlanRecords(ctx context.Context, provider model.CloudIO, profile, region string, plan model.DnsPlan, records []model.Record) error {
+	if plan.Private {
+		var recordIds []*string
+		for _, record := range records {
+			recordIds = append(recordIds, record.RecordId)
+		}
+		return provider.DeletePrivateRecord(ctx, profile, model.DeletePrivateRecordRequest{
+			Domain:    tea.String(plan.Domain),
+			RecordIds: recordIds,
+		})
+	}
+	record := records[0]
+	_, err := provider.DeleteRecord(ctx, profile, region, model.DeleteRecordRequest{
+		Domain:        tea.String(plan.Domain),
+		SubDomain:     record.SubDomain,
+		RecordType:    record.RecordType,
+		SetIdentifier: record.SetIdentifier,
+		RecordLine:    record.RecordLine,
+	})
+	return err
+}
@@ -0,0 +1,326 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+const (
+	defaultWaitRecordTimeout  = 5 * time.Minute
+	defaultWaitRecordInterval = 5 * time.Second
+	maxDnsQueryTimeout        = 5 * time.Second
+)
+
+// errNameserverNotReady 权威 DNS 暂时没有正常应答，比如 udp 丢包、SERVFAIL，等待时继续重试
+var errNameserverNotReady = errors.New("nameserver not ready")
+
+// WaitForRecordChangeWithContext 等待记录变更生效，有 ChangeId 且云支持 GetRecordChange 时先等待变更 INSYNC，然后等待记录列表中出现期望的值；
+// CheckNameservers 时再逐个查询权威 DNS。超时返回 context.DeadlineExceeded
+func (s *CommonService) WaitForRecordChangeWithContext(ctx context.Context, profile, region string, req model.WaitForRecordChangeRequest) (model.WaitForRecordChangeResponse, error) {
+	resp := model.WaitForRecordChangeResponse{Status: model.RecordChangePending}
+	if req.Domain == nil || req.SubDomain == nil || req.RecordType == nil {
+		return resp, fmt.Errorf("domain, sub domain and record type are required")
+	}
+	if req.Private && req.CheckNameservers {
+		return resp, fmt.Errorf("%w: private zone can not be checked from nameservers", model.ErrInvalidInput)
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return resp, err
+	}
+	timeout, interval := req.Timeout, req.Interval
+	if timeout <= 0 {
+		timeout = defaultWaitRecordTimeout
+	}
+	if interval <= 0 {
+		interval = defaultWaitRecordInterval
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// 没有变更 ID 的云返回 ErrNotImplemented，直接查询记录
+	if req.ChangeId != nil {
+		err = pollUntil(ctx, interval, func() (bool, error) {
+			status, err := provider.GetRecordChange(ctx, profile, *req.ChangeId)
+			return status == model.RecordChangeInSync, err
+		})
+		if err != nil && !errors.Is(err, model.ErrNotImplemented) {
+			return resp, fmt.Errorf("wait for change %s: %w", *req.ChangeId, err)
+		}
+	}
+
+	key := zoneRecordKey(model.Record{SubDomain: req.SubDomain, RecordType: req.RecordType})
+	err = pollUntil(ctx, interval, func() (bool, error) {
+		records, err := s.listZoneRecords(ctx, profile, region, *req.Domain, req.Private)
+		if err != nil {
+			return false, err
+		}
+		var matched []model.Record
+		for _, record := range records {
+			if zoneRecordKey(record) == key {
+				matched = append(matched, record)
+			}
+		}
+		if len(matched) == 0 {
+			return false, nil
+		}
+		resp.Record = model.MergeRecords(matched)
+		return recordValuesContain(*req.RecordType, resp.Record.GetValues(), req.Values), nil
+	})
+	if err != nil {
+		return resp, fmt.Errorf("wait for record %s %s: %w", key, *req.Domain, err)
+	}
+	if !req.CheckNameservers {
+		resp.Status = model.RecordChangeInSync
+		return resp, nil
+	}
+
+	nameservers := req.Nameservers
+	if len(nameservers) == 0 {
+		records, err := s.listZoneRecords(ctx, profile, region, *req.Domain, false)
+		if err != nil {
+			return resp, err
+		}
+		for _, record := range records {
+			if zoneRecordKey(record) == "@ NS" {
+				for _, value := range record.GetValues() {
+					nameservers = append(nameservers, tea.StringValue(value))
+				}
+			}
+		}
+		if len(nameservers) == 0 {
+			return resp, fmt.Errorf("no nameserver found for %s", *req.Domain)
+		}
+	}
+	// 别名记录权威 DNS 返回的是目标的地址，只要求有应答
+	expected := req.Values
+	if len(expected) == 0 && resp.Record.AliasTarget == nil {
+		expected = resp.Record.GetValues()
+	}
+	name := *req.Domain
+	if subDomain := tea.StringValue(req.SubDomain); subDomain != "" && subDomain != "@" {
+		name = subDomain + "." + name
+	}
+	// 单次查询最多等待 Interval（1 到 5 秒），丢包后尽快重试
+	queryTimeout := interval
+	if queryTimeout < time.Second {
+		queryTimeout = time.Second
+	}
+	if queryTimeout > maxDnsQueryTimeout {
+		queryTimeout = maxDnsQueryTimeout
+	}
+	for _, nameserver := range nameservers {
+		var lastErr error
+		err = pollUntil(ctx, interval, func() (bool, error) {
+			values, err := queryNameserver(ctx, nameserver, name, strings.ToUpper(*req.RecordType), queryTimeout)
+			if errors.Is(err, errNameserverNotReady) {
+				lastErr = err
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+			return len(values) > 0 && recordValuesContain(*req.RecordType, values, expected), nil
+		})
+		if err != nil && lastErr != nil {
+			return resp, fmt.Errorf("wait for nameserver %s: %w, last error: %v", nameserver, err, lastErr)
+		}
+		if err != nil {
+			return resp, fmt.Errorf("wait for nameserver %s: %w", nameserver, err)
+		}
+		resp.Nameservers = append(resp.Nameservers, nameserver)
+	}
+	resp.Status = model.RecordChangeInSync
+	return resp, nil
+}
+
+// pollUntil 立即调用一次 done，之后每隔 interval 调用，直到返回 true、出错或者 ctx 结束
+func pollUntil(ctx context.Context, interval time.Duration, done func() (bool, error)) error {
+	for {
+		ok, err := done()
+		if err != nil {
+			// 请求因为超时被取消时返回 ctx 的错误，方便判断是否超时
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		if ok {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// recordValuesContain expected 中的值都在 values 中
+func recordValuesContain(recordType string, values, expected []*string) bool {
+	recordType = strings.ToUpper(recordType)
+	for _, want := range expected {
+		found := false
+		for _, value := range values {
+			if model.RecordValuesEqual(recordType, []*string{want}, []*string{value}) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+var dnsQueryTypes = map[string]dnsmessage.Type{
+	"A":     dnsmessage.TypeA,
+	"AAAA":  dnsmessage.TypeAAAA,
+	"CNAME": dnsmessage.TypeCNAME,
+	"NS":    dnsmessage.TypeNS,
+	"MX":    dnsmessage.TypeMX,
+	"TXT":   dnsmessage.TypeTXT,
+	"SRV":   dnsmessage.TypeSRV,
+	"PTR":   dnsmessage.TypePTR,
+}
+
+// queryNameserver 不经过本地递归 DNS，直接向 nameserver 查询，返回值的格式和 model.Record 一致；
+// 超时、应答 ID 不匹配和 NXDOMAIN 以外的错误码返回 errNameserverNotReady
+func queryNameserver(ctx context.Context, nameserver, name, recordType string, timeout time.Duration) ([]*string, error) {
+	qtype, ok := dnsQueryTypes[recordType]
+	if !ok {
+		return nil, fmt.Errorf("%w: query %s record from nameserver", model.ErrUnsupported, recordType)
+	}
+	qname, err := dnsmessage.NewName(strings.TrimSuffix(name, ".") + ".")
+	if err != nil {
+		return nil, err
+	}
+	address := strings.TrimSuffix(nameserver, ".")
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, "53")
+	}
+
+	id := uint16(rand.Intn(1 << 16))
+	msg := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: id},
+		Questions: []dnsmessage.Question{{Name: qname, Type: qtype, Class: dnsmessage.ClassINET}},
+	}
+	query, err := msg.Pack()
+	if err != nil {
+		return nil, err
+	}
+	answer, err := exchangeDns(ctx, "udp", address, query, timeout)
+	if err != nil {
+		return nil, dnsQueryError(name, recordType, nameserver, err)
+	}
+	if err = msg.Unpack(answer); err != nil {
+		return nil, err
+	}
+	// 应答被截断时改用 tcp
+	if msg.Header.Truncated {
+		if answer, err = exchangeDns(ctx, "tcp", address, query, timeout); err != nil {
+			return nil, dnsQueryError(name, recordType, nameserver, err)
+		}
+		if err = msg.Unpack(answer); err != nil {
+			return nil, err
+		}
+	}
+	if msg.Header.ID != id {
+		return nil, fmt.Errorf("%w: query %s %s from %s: reply id %d does not match %d", errNameserverNotReady, name, recordType, nameserver, msg.Header.ID, id)
+	}
+	if msg.Header.RCode != dnsmessage.RCodeSuccess && msg.Header.RCode != dnsmessage.RCodeNameError {
+		return nil, fmt.Errorf("%w: query %s %s from %s: %s", errNameserverNotReady, name, recordType, nameserver, msg.Header.RCode)
+	}
+
+	var values []*string
+	for _, rr := range msg.Answers {
+		if rr.Header.Type != qtype {
+			continue
+		}
+		var value string
+		switch body := rr.Body.(type) {
+		case *dnsmessage.AResource:
+			value = net.IP(body.A[:]).String()
+		case *dnsmessage.AAAAResource:
+			value = net.IP(body.AAAA[:]).String()
+		case *dnsmessage.CNAMEResource:
+			value = body.CNAME.String()
+		case *dnsmessage.NSResource:
+			value = body.NS.String()
+		case *dnsmessage.PTRResource:
+			value = body.PTR.String()
+		case *dnsmessage.MXResource:
+			value = fmt.Sprintf("%d %s", body.Pref, body.MX.String())
+		case *dnsmessage.SRVResource:
+			value = fmt.Sprintf("%d %d %d %s", body.Priority, body.Weight, body.Port, body.Target.String())
+		case *dnsmessage.TXTResource:
+			value = strings.Join(body.TXT, "")
+		}
+		values = append(values, tea.String(value))
+	}
+	return values, nil
+}
+
+// dnsQueryError 超时视为权威 DNS 暂时没有应答
+func dnsQueryError(name, recordType, nameserver string, err error) error {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return fmt.Errorf("%w: query %s %s from %s: %v", errNameserverNotReady, name, recordType, nameserver, err)
+	}
+	return err
+}
+
+// exchangeDns tcp 的请求和应答前面有两个字节的长度；udp 忽略 ID 和请求不一致的应答
+func exchangeDns(ctx context.Context, network, address string, query []byte, timeout time.Duration) ([]byte, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	deadline, ok := ctx.Deadline()
+	if !ok || time.Until(deadline) > timeout {
+		deadline = time.Now().Add(timeout)
+	}
+	conn.SetDeadline(deadline)
+
+	if network == "tcp" {
+		query = append([]byte{byte(len(query) >> 8), byte(len(query))}, query...)
+	}
+	if _, err = conn.Write(query); err != nil {
+		return nil, err
+	}
+	if network == "udp" {
+		buf := make([]byte, 65535)
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				return nil, err
+			}
+			if n >= 2 && buf[0] == query[0] && buf[1] == query[1] {
+				return buf[:n], nil
+			}
+		}
+	}
+	var length [2]byte
+	if _, err = io.ReadFull(conn, length[:]); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, int(length[0])<<8|int(length[1]))
+	if _, err = io.ReadFull(conn, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alibabacloud-go/tea/tea"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// ExportZoneWithContext 导出域名的全部记录为 zone file，公有域和私有域都支持
+func (s *CommonService) ExportZoneWithContext(ctx context.Context, profile, region string, req model.ExportZoneRequest) (model.ZoneFile, error) {
+	if req.Domain == nil {
+		return model.ZoneFile{}, fmt.Errorf("domain is required")
+	}
+	records, err := s.listZoneRecords(ctx, profile, region, *req.Domain, req.Private)
+	if err != nil {
+		return model.ZoneFile{}, err
+	}
+	return model.NewZoneFile(*req.Domain, records), nil
+}
+
+// ImportZoneWithContext 把 zone file 导入到目标域名，只创建目标域名中还没有的同名同类型记录，已有的记录不会修改
+// 单条记录创建失败不影响其他记录，失败的记录在 Failed 中返回
+func (s *CommonService) ImportZoneWithContext(ctx context.Context, profile, region string, req model.ImportZoneRequest) (model.ImportZoneResponse, error) {
+	if req.Domain == nil || req.ZoneFile == nil {
+		return model.ImportZoneResponse{}, fmt.Errorf("domain and zone file are required")
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.ImportZoneResponse{}, err
+	}
+	zone, err := model.ParseZoneFile(strings.NewReader(*req.ZoneFile), *req.Domain)
+	if err != nil {
+		return model.ImportZoneResponse{}, err
+	}
+	existing, err := s.listZoneRecords(ctx, profile, region, *req.Domain, req.Private)
+	if err != nil {
+		return model.ImportZoneResponse{}, err
+	}
+	exists := map[string]bool{}
+	for _, record := range existing {
+		exists[zoneRecordKey(record)] = true
+	}
+
+	var resp model.ImportZoneResponse
+	for _, record := range zone.Records {
+		recordType := tea.StringValue(record.RecordType)
+		// SOA 和根域名的 NS 由云厂商管理
+		if recordType == "SOA" || (recordType == "NS" && tea.StringValue(record.SubDomain) == "@") || exists[zoneRecordKey(record)] {
+			resp.Skipped = append(resp.Skipped, record)
+			continue
+		}
+		if recordType == "TXT" && s.Profiles[profile].Cloud != model.AWS {
+			for i, value := range record.Values {
+				record.Values[i] = tea.String(model.UnquoteTxtValue(tea.StringValue(value)))
+			}
+			record.Value = record.Values[0]
+		}
+		if req.DryRun {
+			resp.Created = append(resp.Created, record)
+			continue
+		}
+		input := model.CreateRecordRequest{
+			Domain:     req.Domain,
+			SubDomain:  record.SubDomain,
+			RecordType: record.RecordType,
+			Value:      record.Value,
+			Values:     record.Values,
+			TTL:        record.TTL,
+		}
+		if req.Private {
+			_, err = provider.CreatePrivateRecord(ctx, profile, input)
+		} else {
+			_, err = provider.CreateRecord(ctx, profile, region, input)
+		}
+		if err != nil {
+			resp.Failed = append(resp.Failed, model.ImportZoneFailed{Record: record, Error: err.Error()})
+			continue
+		}
+		resp.Created = append(resp.Created, record)
+	}
+	return resp, nil
+}
+
+func (s *CommonService) listZoneRecords(ctx context.Context, profile, region, domain string, private bool) ([]model.Record, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return nil, err
+	}
+	if private {
+		resp, err := provider.DescribePrivateRecordList(ctx, profile, model.DescribePrivateRecordListRequest{Domain: &domain})
+		if err != nil {
+			return nil, err
+		}
+		return resp.RecordList, nil
+	}
+	resp, err := provider.DescribeRecordList(ctx, profile, region, model.DescribeRecordListRequest{Domain: &domain})
+	if err != nil {
+		return nil, err
+	}
+	return resp.RecordList, nil
+}
+
+// zoneRecordKey 根域名 aws 为空，腾讯云为 @，统一后再比较
+func zoneRecordKey(record model.Record) string {
+	subDomain := strings.ToLower(tea.StringValue(record.SubDomain))
+	if subDomain == "" {
+		subDomain = "@"
+	}
+	return subDomain + " " + strings.ToUpper(tea.StringValue(record.RecordType))
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// 默认提前 60 天提醒
+const defaultDomainExpiryWithin = 60 * 24 * time.Hour
+
+// DescribeRegisteredDomainsWithContext
+func (s *CommonService) DescribeRegisteredDomainsWithContext(ctx context.Context, profile string, req model.DescribeRegisteredDomainsRequest) ([]model.RegisteredDomain, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return nil, err
+	}
+	return provider.DescribeRegisteredDomains(ctx, profile, req)
+}
+
+// DescribeRegisteredDomainWithContext 包含 NS 和注册人
+func (s *CommonService) DescribeRegisteredDomainWithContext(ctx context.Context, profile, domain string) (model.RegisteredDomain, error) {
+	if domain == "" {
+		return model.RegisteredDomain{}, fmt.Errorf("domain is required")
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.RegisteredDomain{}, err
+	}
+	return provider.DescribeRegisteredDomain(ctx, profile, domain)
+}
+
+// DomainExpiryReportWithContext 逐个账号查询，单个账号失败不影响其他账号
+func (s *CommonService) DomainExpiryReportWithContext(ctx context.Context, req model.DomainExpiryReportRequest) (model.DomainExpiryReport, error) {
+	profiles := req.Profiles
+	if len(profiles) == 0 {
+		for name := range s.Profiles {
+			profiles = append(profiles, name)
+		}
+		sort.Strings(profiles)
+	}
+	within := req.Within
+	if within == 0 {
+		within = defaultDomainExpiryWithin
+	}
+
+	report := model.DomainExpiryReport{GeneratedAt: time.Now()}
+	for _, profile := range profiles {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+		domains, err := s.DescribeRegisteredDomainsWithContext(ctx, profile, model.DescribeRegisteredDomainsRequest{})
+		if err != nil {
+			if errors.Is(err, model.ErrNotImplemented) {
+				continue
+			}
+			report.Failed = append(report.Failed, model.DomainExpiryFailed{Profile: profile, Error: err.Error()})
+			continue
+		}
+		for _, domain := range domains {
+			if within > 0 && (domain.ExpirationDate == nil || domain.ExpirationDate.After(report.GeneratedAt.Add(within))) {
+				continue
+			}
+			item := model.DomainExpiryItem{
+				Profile:  profile,
+				Cloud:    s.Profiles[profile].Cloud,
+				Domain:   domain,
+				DaysLeft: domain.DaysLeft(report.GeneratedAt),
+			}
+			if domain.ExpirationDate != nil {
+				item.Expired = domain.ExpirationDate.Before(report.GeneratedAt)
+			}
+			report.Items = append(report.Items, item)
+		}
+	}
+	report.SortItems()
+	return report, nil
+}
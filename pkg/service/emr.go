@@ -1,43 +1,27 @@
 package service
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
-func (s *CommonService) DescribeEmrCluster(input model.DescribeInput) ([]model.DescribeEmrCluster, error) {
-	if p, ok := s.Profiles[*input.Profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.DescribeEmrCluster(model.DescribeInput{
-				Profile: input.Profile,
-				Region:  input.Region,
-				IDS:     input.IDS,
-			})
-		case model.TENCENT:
-			return s.Tencent.DescribeEmrCluster(model.DescribeInput{
-				Profile: input.Profile,
-				Region:  input.Region,
-				IDS:     input.IDS,
-			})
-		default:
-			return nil, fmt.Errorf("%s %s", *input.Profile, model.ErrCloudNotSupported.Error())
-		}
+func (s *CommonService) DescribeEmrClusterWithContext(ctx context.Context, input model.DescribeInput) ([]model.DescribeEmrCluster, error) {
+	provider, err := s.getProvider(*input.Profile)
+	if err != nil {
+		return nil, err
 	}
-	return nil, fmt.Errorf("%s %s", *input.Profile, model.ErrProfileNotFound.Error())
+	return provider.DescribeEmrCluster(ctx, model.DescribeInput{
+		Profile: input.Profile,
+		Region:  input.Region,
+		IDS:     input.IDS,
+	})
 }
 
-func (s *CommonService) QueryEmrCluster(input model.EmrFilter) (model.FilterEmrResponse, error) {
-	if p, ok := s.Profiles[*input.Profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.QueryEmrCluster(input)
-		case model.TENCENT:
-			return s.Tencent.QueryEmrCluster(input)
-		default:
-			return model.FilterEmrResponse{}, fmt.Errorf("%s %s", *input.Profile, model.ErrCloudNotSupported.Error())
-		}
+func (s *CommonService) QueryEmrClusterWithContext(ctx context.Context, input model.EmrFilter) (model.FilterEmrResponse, error) {
+	provider, err := s.getProvider(*input.Profile)
+	if err != nil {
+		return model.FilterEmrResponse{}, err
 	}
-	return model.FilterEmrResponse{}, fmt.Errorf("%s %s", *input.Profile, model.ErrProfileNotFound.Error())
+	return provider.QueryEmrCluster(ctx, input)
 }
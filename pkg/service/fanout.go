@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/alibabacloud-go/tea/tea"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+const defaultFanOutConcurrency = 8
+
+// fanOutScopes 展开要查询的账号和地域，账号不存在或者没有地域时直接记录为失败
+func (s *CommonService) fanOutScopes(req model.FanOutRequest, perProfile bool) ([]model.Scope, []model.ScopeError) {
+	profiles := req.Profiles
+	if len(profiles) == 0 {
+		for name := range s.Profiles {
+			profiles = append(profiles, name)
+		}
+		sort.Strings(profiles)
+	}
+	var scopes []model.Scope
+	var failed []model.ScopeError
+	for _, profile := range profiles {
+		p, ok := s.Profiles[profile]
+		if !ok {
+			failed = append(failed, model.ScopeError{Scope: model.Scope{Profile: profile}, Err: fmt.Errorf("%s %w", profile, model.ErrProfileNotFound)})
+			continue
+		}
+		regions := req.Regions
+		if len(regions) == 0 {
+			regions = p.Regions
+		}
+		if len(regions) == 0 && p.Region != "" {
+			regions = []string{p.Region}
+		}
+		if len(regions) == 0 {
+			failed = append(failed, model.ScopeError{Scope: model.Scope{Profile: profile}, Err: fmt.Errorf("%w: profile %s has no regions", model.ErrInvalidInput, profile)})
+			continue
+		}
+		if perProfile {
+			// 按账号查询的资源只用第一个地域的客户端
+			scopes = append(scopes, model.Scope{Profile: profile, Region: regions[0]})
+			continue
+		}
+		for _, region := range regions {
+			scopes = append(scopes, model.Scope{Profile: profile, Region: region})
+		}
+	}
+	return scopes, failed
+}
+
+// fanOut 最多 concurrency 个范围同时查询，结果按 scopes 的顺序合并
+func fanOut[T any](ctx context.Context, scopes []model.Scope, failed []model.ScopeError, concurrency int,
+	fetch func(ctx context.Context, scope model.Scope) ([]model.ScopedItem[T], error)) model.FanOutResult[T] {
+	if concurrency <= 0 {
+		concurrency = defaultFanOutConcurrency
+	}
+	items := make([][]model.ScopedItem[T], len(scopes))
+	errs := make([]error, len(scopes))
+	sem := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+	for i, scope := range scopes {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int, scope model.Scope) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			items[i], errs[i] = fetch(ctx, scope)
+		}(i, scope)
+	}
+	wg.Wait()
+
+	result := model.FanOutResult[T]{Errors: failed}
+	for i, scope := range scopes {
+		if errs[i] != nil {
+			result.Errors = append(result.Errors, model.ScopeError{Scope: scope, Err: errs[i]})
+			continue
+		}
+		result.Items = append(result.Items, items[i]...)
+	}
+	return result
+}
+
+func scoped[T any](scope model.Scope, values []T) []model.ScopedItem[T] {
+	items := make([]model.ScopedItem[T], 0, len(values))
+	for _, value := range values {
+		items = append(items, model.ScopedItem[T]{Profile: scope.Profile, Region: scope.Region, Item: value})
+	}
+	return items
+}
+
+// DescribeInstancesFanOut 每个地域查询全部分页，input.NextMarker 被忽略
+func (s *CommonService) DescribeInstancesFanOut(ctx context.Context, req model.FanOutRequest, input model.InstanceFilter) model.FanOutResult[model.Instance] {
+	input.NextMarker = nil
+	scopes, failed := s.fanOutScopes(req, false)
+	return fanOut(ctx, scopes, failed, req.Concurrency, func(ctx context.Context, scope model.Scope) ([]model.ScopedItem[model.Instance], error) {
+		var instances []model.Instance
+		err := s.InstancePager(scope.Profile, scope.Region, input).ForEach(ctx, func(instance model.Instance) error {
+			instances = append(instances, instance)
+			return nil
+		})
+		return scoped(scope, instances), err
+	})
+}
+
+func (s *CommonService) QueryVPCsFanOut(ctx context.Context, req model.FanOutRequest, input model.CommonFilter) model.FanOutResult[model.VPC] {
+	scopes, failed := s.fanOutScopes(req, false)
+	return fanOut(ctx, scopes, failed, req.Concurrency, func(ctx context.Context, scope model.Scope) ([]model.ScopedItem[model.VPC], error) {
+		vpcs, err := s.QueryVPCsWithContext(ctx, scope.Profile, scope.Region, input)
+		return scoped(scope, vpcs), err
+	})
+}
+
+func (s *CommonService) QuerySubnetsFanOut(ctx context.Context, req model.FanOutRequest, input model.CommonFilter) model.FanOutResult[model.Subnet] {
+	scopes, failed := s.fanOutScopes(req, false)
+	return fanOut(ctx, scopes, failed, req.Concurrency, func(ctx context.Context, scope model.Scope) ([]model.ScopedItem[model.Subnet], error) {
+		subnets, err := s.QuerySubnetsWithContext(ctx, scope.Profile, scope.Region, input)
+		return scoped(scope, subnets), err
+	})
+}
+
+func (s *CommonService) QueryEIPsFanOut(ctx context.Context, req model.FanOutRequest, input model.CommonFilter) model.FanOutResult[model.EIP] {
+	scopes, failed := s.fanOutScopes(req, false)
+	return fanOut(ctx, scopes, failed, req.Concurrency, func(ctx context.Context, scope model.Scope) ([]model.ScopedItem[model.EIP], error) {
+		eips, err := s.QueryEIPsWithContext(ctx, scope.Profile, scope.Region, input)
+		return scoped(scope, eips), err
+	})
+}
+
+func (s *CommonService) QueryNATsFanOut(ctx context.Context, req model.FanOutRequest, input model.CommonFilter) model.FanOutResult[model.NAT] {
+	scopes, failed := s.fanOutScopes(req, false)
+	return fanOut(ctx, scopes, failed, req.Concurrency, func(ctx context.Context, scope model.Scope) ([]model.ScopedItem[model.NAT], error) {
+		nats, err := s.QueryNATsWithContext(ctx, scope.Profile, scope.Region, input)
+		return scoped(scope, nats), err
+	})
+}
+
+// ListBucketsFanOut 桶列表按账号返回全部地域，每个账号只查询一次，Region 为桶所在的地域；
+// 指定了 req.Regions 时只保留这些地域的桶
+func (s *CommonService) ListBucketsFanOut(ctx context.Context, req model.FanOutRequest, input model.ListBucketRequest) model.FanOutResult[model.Bucket] {
+	regions := make(map[string]bool)
+	for _, region := range req.Regions {
+		regions[region] = true
+	}
+	scopes, failed := s.fanOutScopes(req, true)
+	return fanOut(ctx, scopes, failed, req.Concurrency, func(ctx context.Context, scope model.Scope) ([]model.ScopedItem[model.Bucket], error) {
+		resp, err := s.ListBucketsWithContext(ctx, scope.Profile, scope.Region, input)
+		if err != nil {
+			return nil, err
+		}
+		var items []model.ScopedItem[model.Bucket]
+		for _, bucket := range resp.Buckets {
+			if bucket == nil || (len(regions) > 0 && !regions[bucket.Location]) {
+				continue
+			}
+			items = append(items, model.ScopedItem[model.Bucket]{Profile: scope.Profile, Region: bucket.Location, Item: *bucket})
+		}
+		return items, nil
+	})
+}
+
+// QueryEmrClustersFanOut filter 的 Profile、Region、NextMarker 被忽略，每个地域查询全部分页
+func (s *CommonService) QueryEmrClustersFanOut(ctx context.Context, req model.FanOutRequest, filter model.EmrFilter) model.FanOutResult[model.EmrCluster] {
+	filter.NextMarker = nil
+	scopes, failed := s.fanOutScopes(req, false)
+	return fanOut(ctx, scopes, failed, req.Concurrency, func(ctx context.Context, scope model.Scope) ([]model.ScopedItem[model.EmrCluster], error) {
+		scopeFilter := filter
+		scopeFilter.Profile = tea.String(scope.Profile)
+		scopeFilter.Region = tea.String(scope.Region)
+		var clusters []model.EmrCluster
+		err := s.EmrClusterPager(scopeFilter).ForEach(ctx, func(cluster model.EmrCluster) error {
+			clusters = append(clusters, cluster)
+			return nil
+		})
+		return scoped(scope, clusters), err
+	})
+}
+
+// ImportKeyPairFanOut 把同一个公钥导入到多个账号，AWS 每个地域导入一次，
+// 密钥对所有地域通用的云（Capabilities.IsRegionless，比如腾讯云）每个账号只导入一次
+func (s *CommonService) ImportKeyPairFanOut(ctx context.Context, req model.FanOutRequest, input model.ImportKeyPairRequest) model.FanOutResult[model.KeyPair] {
+	if err := input.Validate(); err != nil {
+		return model.FanOutResult[model.KeyPair]{Errors: []model.ScopeError{{Err: err}}}
+	}
+	all, failed := s.fanOutScopes(req, false)
+	var scopes []model.Scope
+	imported := make(map[string]bool)
+	regionless := make(map[model.Cloud]bool)
+	for _, scope := range all {
+		cloud := s.Profiles[scope.Profile].Cloud
+		if _, ok := regionless[cloud]; !ok {
+			// 没有注册的云在执行时返回错误，这里按地域区分处理
+			capabilities, err := s.Capabilities(cloud)
+			regionless[cloud] = err == nil && capabilities.IsRegionless("ImportKeyPair")
+		}
+		if regionless[cloud] {
+			if imported[scope.Profile] {
+				continue
+			}
+			imported[scope.Profile] = true
+		}
+		scopes = append(scopes, scope)
+	}
+	return fanOut(ctx, scopes, failed, req.Concurrency, func(ctx context.Context, scope model.Scope) ([]model.ScopedItem[model.KeyPair], error) {
+		key, err := s.ImportKeyPairWithContext(ctx, scope.Profile, scope.Region, input)
+		if err != nil {
+			return nil, err
+		}
+		return scoped(scope, []model.KeyPair{key}), nil
+	})
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alibabacloud-go/tea/tea"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+func (s *CommonService) DescribeImagesWithContext(ctx context.Context, profile, region string, input model.DescribeImagesRequest) ([]model.Image, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return nil, err
+	}
+	return provider.DescribeImages(ctx, profile, region, input)
+}
+
+// DescribeImageWithContext 不存在时返回 model.ErrNotFound
+func (s *CommonService) DescribeImageWithContext(ctx context.Context, profile, region, imageId string) (model.Image, error) {
+	if imageId == "" {
+		return model.Image{}, fmt.Errorf("image_id is required")
+	}
+	images, err := s.DescribeImagesWithContext(ctx, profile, region, model.DescribeImagesRequest{ImageIds: []string{imageId}})
+	if err != nil {
+		return model.Image{}, err
+	}
+	for _, image := range images {
+		if tea.StringValue(image.ImageId) == imageId {
+			return image, nil
+		}
+	}
+	return model.Image{}, fmt.Errorf("image %s %w", imageId, model.ErrNotFound)
+}
+
+func (s *CommonService) CreateImageWithContext(ctx context.Context, profile, region string, input model.CreateImageRequest) (model.CreateImageResponse, error) {
+	if err := input.Validate(); err != nil {
+		return model.CreateImageResponse{}, err
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.CreateImageResponse{}, err
+	}
+	return provider.CreateImage(ctx, profile, region, input)
+}
+
+func (s *CommonService) CopyImageWithContext(ctx context.Context, profile, region string, input model.CopyImageRequest) (model.CopyImageResponse, error) {
+	if err := input.Validate(); err != nil {
+		return model.CopyImageResponse{}, err
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.CopyImageResponse{}, err
+	}
+	return provider.CopyImage(ctx, profile, region, input)
+}
+
+func (s *CommonService) ShareImageWithContext(ctx context.Context, profile, region string, input model.ShareImageRequest) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
+	}
+	return provider.ShareImage(ctx, profile, region, input)
+}
+
+func (s *CommonService) DeleteImageWithContext(ctx context.Context, profile, region string, input model.DeleteImageRequest) error {
+	if tea.StringValue(input.ImageId) == "" {
+		return fmt.Errorf("%w: image_id is required", model.ErrInvalidInput)
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
+	}
+	return provider.DeleteImage(ctx, profile, region, input)
+}
@@ -1,64 +1,74 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
-func (s *CommonService) DescribeInstances(profile, region string, input model.InstanceFilter) (model.InstanceResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.DescribeInstances(profile, region, input.ToAwsDescribeInstancesInput())
-		case model.TENCENT:
-			return s.Tencent.DescribeInstances(profile, region, input.ToTxDescribeInstancesInput())
-		default:
-			return model.InstanceResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+func (s *CommonService) DescribeInstancesWithContext(ctx context.Context, profile, region string, input model.InstanceFilter) (model.InstanceResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.InstanceResponse{}, err
 	}
-	return model.InstanceResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.DescribeInstances(ctx, profile, region, input.ToDescribeInstancesInput(s.Profiles[profile].Cloud))
 }
 
-// CreateInstance
-func (s *CommonService) CreateInstance(profile, region string, input model.CreateInstanceInput) (model.CreateInstanceResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.CreateInstance(profile, region, input)
-		case model.TENCENT:
-			return s.Tencent.CreateInstance(profile, region, input)
-		default:
-			return model.CreateInstanceResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// CreateInstanceWithContext
+func (s *CommonService) CreateInstanceWithContext(ctx context.Context, profile, region string, input model.CreateInstanceInput) (model.CreateInstanceResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.CreateInstanceResponse{}, err
 	}
-	return model.CreateInstanceResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.CreateInstance(ctx, profile, region, input)
 }
 
-func (s *CommonService) ModifyInstance(profile, region string, input model.ModifyInstanceInput) (model.ModifyInstanceResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.ModifyInstance(profile, region, input)
-		case model.TENCENT:
-			return s.Tencent.ModifyInstance(profile, region, input)
-		default:
-			return model.ModifyInstanceResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+func (s *CommonService) ModifyInstanceWithContext(ctx context.Context, profile, region string, input model.ModifyInstanceInput) (model.ModifyInstanceResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.ModifyInstanceResponse{}, err
 	}
-	return model.ModifyInstanceResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.ModifyInstance(ctx, profile, region, input)
 }
 
-func (s *CommonService) DeleteInstance(profile, region string, input model.DeleteInstanceInput) (model.DeleteInstanceResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.DeleteInstance(profile, region, input)
-		case model.TENCENT:
-			return s.Tencent.DeleteInstance(profile, region, input)
-		default:
-			return model.DeleteInstanceResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+func (s *CommonService) DeleteInstanceWithContext(ctx context.Context, profile, region string, input model.DeleteInstanceInput) (model.DeleteInstanceResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.DeleteInstanceResponse{}, err
 	}
-	return model.DeleteInstanceResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.DeleteInstance(ctx, profile, region, input)
+}
+
+func (s *CommonService) DescribeInstanceTypesWithContext(ctx context.Context, profile, region string, filter model.InstanceTypeFilter) ([]model.InstanceType, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return nil, err
+	}
+	return provider.DescribeInstanceTypes(ctx, profile, region, filter)
+}
+
+// EquivalentInstanceTypeWithContext 按 spec 的 CPU、内存、GPU、架构过滤后选择最接近的机型
+func (s *CommonService) EquivalentInstanceTypeWithContext(ctx context.Context, profile, region string, spec model.InstanceType, zone string) (model.InstanceType, error) {
+	gpu := spec.GPU > 0
+	filter := model.InstanceTypeFilter{
+		MinCPU:       spec.CPU,
+		MinMemoryGiB: spec.MemoryGiB,
+		GPU:          &gpu,
+	}
+	if spec.Architecture != "" {
+		filter.Architecture = &spec.Architecture
+	}
+	if zone != "" {
+		filter.Zone = &zone
+	}
+	candidates, err := s.DescribeInstanceTypesWithContext(ctx, profile, region, filter)
+	if err != nil {
+		return model.InstanceType{}, err
+	}
+	closest, ok := model.ClosestInstanceType(spec, candidates, zone)
+	if !ok {
+		return model.InstanceType{}, fmt.Errorf("%w: no instance type in %s %s matches %d vCPU %.1f GiB", model.ErrNotFound, profile, region, spec.CPU, spec.MemoryGiB)
+	}
+	return closest, nil
 }
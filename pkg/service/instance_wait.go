@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// WaitForInstanceStatusWithContext 等待 ids 都到达 target，返回最后一次查询到的实例。
+// 创建失败或者等待其他状态时实例被销毁，立即返回 model.ErrTerminalState；超时或者 ctx 取消返回 ctx 的错误
+func (s *CommonService) WaitForInstanceStatusWithContext(ctx context.Context, profile, region string, ids []*string, target model.InstanceStatus, opts model.WaitOptions) ([]model.Instance, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: instance ids are required", model.ErrInvalidInput)
+	}
+	if len(ids) > 100 {
+		return nil, fmt.Errorf("%w: at most 100 instances", model.ErrInvalidInput)
+	}
+	if _, err := s.getProvider(profile); err != nil {
+		return nil, err
+	}
+	opts = opts.WithDefaults()
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	var instances []model.Instance
+	err := pollWithBackoff(ctx, opts.Interval, opts.MaxInterval, func() (bool, error) {
+		resp, err := s.DescribeInstancesWithContext(ctx, profile, region, model.InstanceFilter{IDs: ids})
+		if err != nil {
+			// 刚创建的实例可能还查不到，被限流时也继续等待
+			if errors.Is(err, model.ErrNotFound) || errors.Is(err, model.ErrThrottled) {
+				return false, nil
+			}
+			return false, err
+		}
+		instances = resp.Instances
+		found := make(map[string]model.Instance)
+		for _, instance := range instances {
+			found[tea.StringValue(instance.InstanceID)] = instance
+		}
+		done := true
+		for _, id := range ids {
+			instance, ok := found[tea.StringValue(id)]
+			if !ok {
+				// 腾讯云销毁的实例过一段时间后查不到
+				if target != model.InstanceStatusTerminated {
+					done = false
+				}
+				continue
+			}
+			if instance.Status == target {
+				continue
+			}
+			if instance.Status == model.InstanceStatusLaunchFailed || instance.Status == model.InstanceStatusTerminated {
+				return false, fmt.Errorf("instance %s is %s: %w", tea.StringValue(id), instance.Status, model.ErrTerminalState)
+			}
+			done = false
+		}
+		return done, nil
+	})
+	if err != nil {
+		return instances, fmt.Errorf("wait for instances %s: %w", target, err)
+	}
+	return instances, nil
+}
+
+// pollWithBackoff 和 pollUntil 一样立即调用一次 done，之后的间隔每次翻倍，最长为 maxInterval
+func pollWithBackoff(ctx context.Context, interval, maxInterval time.Duration, done func() (bool, error)) error {
+	for {
+		ok, err := done()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		if ok {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
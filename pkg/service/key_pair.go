@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alibabacloud-go/tea/tea"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+func (s *CommonService) DescribeKeyPairsWithContext(ctx context.Context, profile, region string, input model.DescribeKeyPairsRequest) ([]model.KeyPair, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return nil, err
+	}
+	return provider.DescribeKeyPairs(ctx, profile, region, input)
+}
+
+func (s *CommonService) CreateKeyPairWithContext(ctx context.Context, profile, region string, input model.CreateKeyPairRequest) (model.CreateKeyPairResponse, error) {
+	if err := input.Validate(); err != nil {
+		return model.CreateKeyPairResponse{}, err
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.CreateKeyPairResponse{}, err
+	}
+	return provider.CreateKeyPair(ctx, profile, region, input)
+}
+
+func (s *CommonService) ImportKeyPairWithContext(ctx context.Context, profile, region string, input model.ImportKeyPairRequest) (model.KeyPair, error) {
+	if err := input.Validate(); err != nil {
+		return model.KeyPair{}, err
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.KeyPair{}, err
+	}
+	return provider.ImportKeyPair(ctx, profile, region, input)
+}
+
+func (s *CommonService) DeleteKeyPairWithContext(ctx context.Context, profile, region string, input model.DeleteKeyPairRequest) error {
+	if tea.StringValue(input.KeyId) == "" {
+		return fmt.Errorf("%w: key_id is required", model.ErrInvalidInput)
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
+	}
+	return provider.DeleteKeyPair(ctx, profile, region, input)
+}
+
+func (s *CommonService) AssociateKeyPairsWithContext(ctx context.Context, profile, region string, input model.KeyPairAssociationRequest) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
+	}
+	return provider.AssociateKeyPairs(ctx, profile, region, input)
+}
+
+func (s *CommonService) DisassociateKeyPairsWithContext(ctx context.Context, profile, region string, input model.KeyPairAssociationRequest) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
+	}
+	return provider.DisassociateKeyPairs(ctx, profile, region, input)
+}
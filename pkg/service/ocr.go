@@ -1,101 +1,69 @@
 package service
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
 )
 
-func (s *CommonService) QueryOcr(profile, region string, request model.OcrRequest) (model.OcrResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.CommonOCR(profile, region, request)
-		case model.TENCENT:
-			return s.Tencent.CommonOCR(profile, region, request)
-		default:
-			return model.OcrResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+func (s *CommonService) QueryOcrWithContext(ctx context.Context, profile, region string, request model.OcrRequest) (model.OcrResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.OcrResponse{}, err
 	}
-	return model.OcrResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.CommonOCR(ctx, profile, region, request)
 }
 
-// tiia CreatePicture
-func (s *CommonService) CreatePicture(profile, region string, request model.CreatePictureRequest) (model.CreatePictureResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.AWS:
-			return s.Aws.CreatePicture(profile, region, request)
-		case model.TENCENT:
-			return s.Tencent.CreatePicture(profile, region, request)
-		default:
-			return model.CreatePictureResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// tiia CreatePictureWithContext
+func (s *CommonService) CreatePictureWithContext(ctx context.Context, profile, region string, request model.CreatePictureRequest) (model.CreatePictureResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.CreatePictureResponse{}, err
 	}
-	return model.CreatePictureResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.CreatePicture(ctx, profile, region, request)
 }
 
-// tiia GetPictureByName
-func (s *CommonService) GetPictureByName(profile, region string, input model.CommonPictureRequest) (model.GetPictureByNameResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.TENCENT:
-			return s.Tencent.GetPictureByName(profile, region, input)
-		default:
-			return model.GetPictureByNameResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// tiia GetPictureByNameWithContext
+func (s *CommonService) GetPictureByNameWithContext(ctx context.Context, profile, region string, input model.CommonPictureRequest) (model.GetPictureByNameResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.GetPictureByNameResponse{}, err
 	}
-	return model.GetPictureByNameResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.GetPictureByName(ctx, profile, region, input)
 }
 
-// tiia QueryPicture
-func (s *CommonService) QueryPicture(profile, region string, input model.QueryPictureRequest) (model.QueryPictureResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.TENCENT:
-			return s.Tencent.QueryPicture(profile, region, input)
-		default:
-			return model.QueryPictureResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// tiia QueryPictureWithContext
+func (s *CommonService) QueryPictureWithContext(ctx context.Context, profile, region string, input model.QueryPictureRequest) (model.QueryPictureResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.QueryPictureResponse{}, err
 	}
-	return model.QueryPictureResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.QueryPicture(ctx, profile, region, input)
 }
 
-// tiia DeletePicture
-func (s *CommonService) DeletePicture(profile, region string, input model.CommonPictureRequest) (model.CommonPictureResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.TENCENT:
-			return s.Tencent.DeletePicture(profile, region, input)
-		default:
-			return model.CommonPictureResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// tiia DeletePictureWithContext
+func (s *CommonService) DeletePictureWithContext(ctx context.Context, profile, region string, input model.CommonPictureRequest) (model.CommonPictureResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.CommonPictureResponse{}, err
 	}
-	return model.CommonPictureResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.DeletePicture(ctx, profile, region, input)
 }
 
-// tiia UpdatePicture
-func (s *CommonService) UpdatePicture(profile, region string, input model.UpdatePictureRequest) (model.CommonPictureResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.TENCENT:
-			return s.Tencent.UpdatePicture(profile, region, input)
-		default:
-			return model.CommonPictureResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// tiia UpdatePictureWithContext
+func (s *CommonService) UpdatePictureWithContext(ctx context.Context, profile, region string, input model.UpdatePictureRequest) (model.CommonPictureResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.CommonPictureResponse{}, err
 	}
-	return model.CommonPictureResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.UpdatePicture(ctx, profile, region, input)
 }
 
-// tiia SearchPicture
-func (s *CommonService) SearchPicture(profile, region string, input model.SearchPictureRequest) (model.SearchPictureResponse, error) {
-	if p, ok := s.Profiles[profile]; ok {
-		switch p.Cloud {
-		case model.TENCENT:
-			return s.Tencent.SearchPicture(profile, region, input)
-		default:
-			return model.SearchPictureResponse{}, fmt.Errorf("%s %s", profile, model.ErrCloudNotSupported.Error())
-		}
+// tiia SearchPictureWithContext
+func (s *CommonService) SearchPictureWithContext(ctx context.Context, profile, region string, input model.SearchPictureRequest) (model.SearchPictureResponse, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.SearchPictureResponse{}, err
 	}
-	return model.SearchPictureResponse{}, fmt.Errorf("%s %s", profile, model.ErrProfileNotFound.Error())
+	return provider.SearchPicture(ctx, profile, region, input)
 }
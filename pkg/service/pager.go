@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alibabacloud-go/tea/tea"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// InstancePager 每页 input.Size 个，默认 100，Size 不大于 0 时第一次查询返回 ErrInvalidInput
+func (s *CommonService) InstancePager(profile, region string, input model.InstanceFilter) *model.Pager[model.Instance] {
+	if input.Size == nil {
+		input.Size = tea.Int64(100)
+	}
+	return model.NewPager(input.NextMarker, func(ctx context.Context, marker *string) ([]model.Instance, *string, error) {
+		if *input.Size <= 0 {
+			return nil, nil, fmt.Errorf("%w: invalid size %d", model.ErrInvalidInput, *input.Size)
+		}
+		input.NextMarker = marker
+		resp, err := s.DescribeInstancesWithContext(ctx, profile, region, input)
+		return resp.Instances, resp.NextMarker, err
+	})
+}
+
+// RecordPager 忽略 req.Page，每页 req.Limit 个
+func (s *CommonService) RecordPager(profile, region string, req model.DescribeRecordListWithPageRequest) *model.Pager[model.Record] {
+	req.Page = nil
+	return model.NewPager(req.NextMarker, func(ctx context.Context, marker *string) ([]model.Record, *string, error) {
+		req.NextMarker = marker
+		resp, err := s.DescribeRecordListWithPagesWithContext(ctx, profile, region, req)
+		return resp.RecordList, resp.NextMarker, err
+	})
+}
+
+// PrivateRecordPager 忽略 req.Page，每页 req.Limit 个
+func (s *CommonService) PrivateRecordPager(profile string, req model.DescribePrivateDnsRecordListWithPageRequest) *model.Pager[model.Record] {
+	req.Page = nil
+	return model.NewPager(req.NextMarker, func(ctx context.Context, marker *string) ([]model.Record, *string, error) {
+		req.NextMarker = marker
+		resp, err := s.PrivateRecordListWithPagesWithContext(ctx, profile, req)
+		return resp.RecordList, resp.NextMarker, err
+	})
+}
+
+// EmrClusterPager 按 ClusterStates 过滤后的页可能为空，会继续查询下一页
+func (s *CommonService) EmrClusterPager(filter model.EmrFilter) *model.Pager[model.EmrCluster] {
+	return model.NewPager(filter.NextMarker, func(ctx context.Context, marker *string) ([]model.EmrCluster, *string, error) {
+		filter.NextMarker = marker
+		resp, err := s.QueryEmrClusterWithContext(ctx, filter)
+		return resp.Clusters, resp.NextMarker, err
+	})
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// ListRegionsWithContext 优先使用账号配置的 Region，其次是 Regions 中的第一个
+func (s *CommonService) ListRegionsWithContext(ctx context.Context, profile string) ([]model.Region, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return nil, err
+	}
+	p := s.Profiles[profile]
+	region := p.Region
+	if region == "" && len(p.Regions) > 0 {
+		region = p.Regions[0]
+	}
+	return provider.ListRegions(ctx, profile, region)
+}
+
+func (s *CommonService) ListZonesWithContext(ctx context.Context, profile, region string) ([]model.Zone, error) {
+	if region == "" {
+		return nil, fmt.Errorf("region is required")
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return nil, err
+	}
+	return provider.ListZones(ctx, profile, region)
+}
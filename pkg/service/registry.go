@@ -0,0 +1,52 @@
+package service
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+// Registry 维护 model.Cloud 到 model.CloudIO 实现的映射，
+// 第三方云厂商实现 model.CloudIO 后通过 Register 注册即可接入 CommonService。
+type Registry struct {
+	lock      sync.RWMutex
+	providers map[model.Cloud]model.CloudIO
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: make(map[model.Cloud]model.CloudIO),
+	}
+}
+
+// Register 注册云实现，同一个 cloud 重复注册会覆盖之前的实现，传入 nil 表示移除
+func (r *Registry) Register(cloud model.Cloud, provider model.CloudIO) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if provider == nil {
+		delete(r.providers, cloud)
+		return
+	}
+	r.providers[cloud] = provider
+}
+
+// Get 返回 cloud 对应的实现，未注册返回 false
+func (r *Registry) Get(cloud model.Cloud) (model.CloudIO, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	provider, ok := r.providers[cloud]
+	return provider, ok
+}
+
+// Clouds 返回已注册的云，按名称排序
+func (r *Registry) Clouds() []model.Cloud {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	clouds := make([]model.Cloud, 0, len(r.providers))
+	for cloud := range r.providers {
+		clouds = append(clouds, cloud)
+	}
+	sort.Slice(clouds, func(i, j int) bool { return clouds[i] < clouds[j] })
+	return clouds
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alibabacloud-go/tea/tea"
+
+	"github.com/xops-infra/multi-cloud-sdk/pkg/model"
+)
+
+func (s *CommonService) DescribeVolumesWithContext(ctx context.Context, profile, region string, input model.DescribeVolumesRequest) ([]model.Volume, error) {
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return nil, err
+	}
+	return provider.DescribeVolumes(ctx, profile, region, input)
+}
+
+// DescribeVolumeWithContext 不存在时返回 model.ErrNotFound
+func (s *CommonService) DescribeVolumeWithContext(ctx context.Context, profile, region, volumeId string) (model.Volume, error) {
+	if volumeId == "" {
+		return model.Volume{}, fmt.Errorf("%w: volume_id is required", model.ErrInvalidInput)
+	}
+	volumes, err := s.DescribeVolumesWithContext(ctx, profile, region, model.DescribeVolumesRequest{VolumeIds: []string{volumeId}})
+	if err != nil {
+		return model.Volume{}, err
+	}
+	for _, volume := range volumes {
+		if tea.StringValue(volume.VolumeId) == volumeId {
+			return volume, nil
+		}
+	}
+	return model.Volume{}, fmt.Errorf("volume %s %w", volumeId, model.ErrNotFound)
+}
+
+func (s *CommonService) CreateVolumeWithContext(ctx context.Context, profile, region string, input model.CreateVolumeRequest) (model.CreateVolumeResponse, error) {
+	if err := input.Validate(); err != nil {
+		return model.CreateVolumeResponse{}, err
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return model.CreateVolumeResponse{}, err
+	}
+	return provider.CreateVolume(ctx, profile, region, input)
+}
+
+// ResizeVolumeWithContext 先查询当前大小，不能缩容
+func (s *CommonService) ResizeVolumeWithContext(ctx context.Context, profile, region string, input model.ResizeVolumeRequest) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+	volume, err := s.DescribeVolumeWithContext(ctx, profile, region, tea.StringValue(input.VolumeId))
+	if err != nil {
+		return err
+	}
+	if input.SizeGiB <= volume.SizeGiB {
+		return fmt.Errorf("%w: volume %s is %d GiB, size_gib must be larger", model.ErrInvalidInput, *input.VolumeId, volume.SizeGiB)
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
+	}
+	return provider.ResizeVolume(ctx, profile, region, input)
+}
+
+func (s *CommonService) AttachVolumeWithContext(ctx context.Context, profile, region string, input model.AttachVolumeRequest) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
+	}
+	return provider.AttachVolume(ctx, profile, region, input)
+}
+
+func (s *CommonService) DetachVolumeWithContext(ctx context.Context, profile, region string, input model.DetachVolumeRequest) error {
+	if tea.StringValue(input.VolumeId) == "" {
+		return fmt.Errorf("%w: volume_id is required", model.ErrInvalidInput)
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
+	}
+	return provider.DetachVolume(ctx, profile, region, input)
+}
+
+func (s *CommonService) DeleteVolumeWithContext(ctx context.Context, profile, region string, input model.DeleteVolumeRequest) error {
+	if tea.StringValue(input.VolumeId) == "" {
+		return fmt.Errorf("%w: volume_id is required", model.ErrInvalidInput)
+	}
+	provider, err := s.getProvider(profile)
+	if err != nil {
+		return err
+	}
+	return provider.DeleteVolume(ctx, profile, region, input)
+}